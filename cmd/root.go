@@ -104,7 +104,14 @@ func run(cmd *cobra.Command, args []string) error {
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: file})
 	zerolog.SetGlobalLevel(parseLevel(*k9sFlags.LogLevel))
 
-	cfg, err := loadConfiguration()
+	var cfg *config.Config
+	if *k9sFlags.Snapshot != "" {
+		if cfg, err = loadSnapshotConfiguration(*k9sFlags.Snapshot); cfg == nil {
+			return err
+		}
+	} else {
+		cfg, err = loadConfiguration()
+	}
 	if err != nil {
 		log.Error().Err(err).Msgf("Fail to load global/context configuration")
 	}
@@ -244,6 +251,12 @@ func initK9sFlags() {
 		"",
 		"Sets a path to a dir for a screen dumps",
 	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.Snapshot,
+		"snapshot",
+		"",
+		"Browse a cluster snapshot directory or archive offline",
+	)
 	rootCmd.Flags()
 }
 
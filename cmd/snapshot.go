@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/rs/zerolog/log"
+)
+
+// loadSnapshotConfiguration loads a cluster snapshot and browses it offline.
+// Snapshots are served in process, are read only and no context configuration
+// is persisted.
+func loadSnapshotConfiguration(path string) (*config.Config, error) {
+	log.Info().Msgf("🐶 K9s browsing snapshot %q...", path)
+
+	snap, err := snapshot.Load(path)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot %q load failed: %w", path, err)
+	}
+	conn := snapshot.NewConnection(snap)
+	k9sCfg := config.NewConfig(conn.Config())
+	k9sCfg.SetConnection(conn)
+	k9sCfg.K9s.SetOffline(true)
+
+	var errs error
+	if err := k9sCfg.Load(config.AppConfigFile, false); err != nil {
+		errs = errors.Join(errs, err)
+	}
+	k9sCfg.K9s.Override(k9sFlags)
+	if !k9sCfg.K9s.Snapshot.IncludeSecrets {
+		snap.Redact()
+	}
+
+	flags := *conn.Config().Flags()
+	flags.Namespace = k8sFlags.Namespace
+	if err := k9sCfg.Refine(&flags, k9sFlags, conn.Config()); err != nil {
+		log.Error().Err(err).Msgf("config refine failed")
+		errs = errors.Join(errs, err)
+	}
+
+	return k9sCfg, errs
+}
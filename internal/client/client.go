@@ -18,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/disk"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -455,11 +456,17 @@ func (a *APIClient) Dial() (kubernetes.Interface, error) {
 
 // RestConfig returns a rest api client.
 func (a *APIClient) RestConfig() (*restclient.Config, error) {
-	return a.config.RESTConfig()
+	cfg, err := a.config.RESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	cfg.Wrap(Diag.WrapTransport)
+
+	return cfg, nil
 }
 
 // CachedDiscovery returns a cached discovery client.
-func (a *APIClient) CachedDiscovery() (*disk.CachedDiscoveryClient, error) {
+func (a *APIClient) CachedDiscovery() (discovery.CachedDiscoveryInterface, error) {
 	if !a.getConnOK() {
 		return nil, errors.New("no connection to cached dial")
 	}
@@ -135,6 +135,13 @@ func TestIsValidNamespace(t *testing.T) {
 				DefaultNamespace: {},
 			},
 		},
+		"multi-ns": {
+			ns: "fred,blee",
+			cache: NamespaceNames{
+				"fred": {},
+				"blee": {},
+			},
+		},
 	}
 
 	expiry := 1 * time.Millisecond
@@ -25,8 +25,9 @@ const (
 
 // Config tracks a kubernetes configuration.
 type Config struct {
-	flags *genericclioptions.ConfigFlags
-	mx    sync.RWMutex
+	flags  *genericclioptions.ConfigFlags
+	loader clientcmd.ClientConfig
+	mx     sync.RWMutex
 }
 
 // NewConfig returns a new k8s config or an error if the flags are invalid.
@@ -36,6 +37,15 @@ func NewConfig(f *genericclioptions.ConfigFlags) *Config {
 	}
 }
 
+// NewConfigFromAPI returns a k8s config backed by an in-memory kubeconfig.
+// Such configurations are never written back to disk.
+func NewConfigFromAPI(cfg *api.Config, f *genericclioptions.ConfigFlags) *Config {
+	return &Config{
+		flags:  f,
+		loader: clientcmd.NewDefaultClientConfig(*cfg, &clientcmd.ConfigOverrides{}),
+	}
+}
+
 // CallTimeout returns the call timeout if set or the default if not set.
 func (c *Config) CallTimeout() time.Duration {
 	if !isSet(c.flags.Timeout) {
@@ -63,6 +73,10 @@ func (c *Config) RawConfig() (api.Config, error) {
 }
 
 func (c *Config) clientConfig() clientcmd.ClientConfig {
+	if c.loader != nil {
+		return c.loader
+	}
+
 	return c.flags.ToRawKubeConfigLoader()
 }
 
@@ -319,6 +333,10 @@ func (c *Config) ConfigAccess() (clientcmd.ConfigAccess, error) {
 	c.mx.RLock()
 	defer c.mx.RUnlock()
 
+	if c.loader != nil {
+		return nil, errors.New("in-memory configurations can not be modified")
+	}
+
 	return c.clientConfig().ConfigAccess(), nil
 }
 
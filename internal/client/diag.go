@@ -0,0 +1,366 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/tools/metrics"
+)
+
+const (
+	// NonResourceGVR tracks requests not targeting a resource ie discovery, version...
+	NonResourceGVR = "non-resource"
+
+	listVerb  = "list"
+	watchVerb = "watch"
+)
+
+// LatencyBuckets tracks latency histograms upper bounds.
+var LatencyBuckets = []time.Duration{
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	1 * time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// Diag tracks the api client diagnostics.
+var Diag = NewDiagnostics()
+
+var diagOnce sync.Once
+
+// Histogram represents a latency histogram.
+type Histogram struct {
+	// Counts tracks samples per LatencyBuckets. The last entry tracks overflows.
+	Counts []int
+	Total  int
+	Sum    time.Duration
+	Max    time.Duration
+}
+
+// NewHistogram returns a new histogram.
+func NewHistogram() Histogram {
+	return Histogram{Counts: make([]int, len(LatencyBuckets)+1)}
+}
+
+// Observe records a new sample.
+func (h *Histogram) Observe(d time.Duration) {
+	i := sort.Search(len(LatencyBuckets), func(i int) bool { return d <= LatencyBuckets[i] })
+	h.Counts[i]++
+	h.Total++
+	h.Sum += d
+	if d > h.Max {
+		h.Max = d
+	}
+}
+
+// Quantile returns the estimated quantile ie the upper bound of the bucket holding it.
+func (h Histogram) Quantile(q float64) time.Duration {
+	if h.Total == 0 {
+		return 0
+	}
+	rank := int(q*float64(h.Total) + 0.5)
+	if rank < 1 {
+		rank = 1
+	}
+	var n int
+	for i, c := range h.Counts {
+		if n += c; n >= rank {
+			if i < len(LatencyBuckets) {
+				return min(LatencyBuckets[i], h.Max)
+			}
+			break
+		}
+	}
+
+	return h.Max
+}
+
+// Avg returns the samples average.
+func (h Histogram) Avg() time.Duration {
+	if h.Total == 0 {
+		return 0
+	}
+
+	return h.Sum / time.Duration(h.Total)
+}
+
+func (h Histogram) clone() Histogram {
+	c := h
+	c.Counts = make([]int, len(h.Counts))
+	copy(c.Counts, h.Counts)
+
+	return c
+}
+
+// RequestStats tracks api requests diagnostics for a given resource.
+type RequestStats struct {
+	GVR           string
+	Requests      int
+	Errors        int
+	Lists         int
+	Relists       int
+	Watches       int
+	WatchRestarts int
+	WatchErrors   int
+	ActiveWatches int
+	ListLatency   Histogram
+	WatchLatency  Histogram
+	ThrottleWait  Histogram
+	LastError     string
+
+	informerLists map[string]int
+	watchStarts   map[string]int
+	pendingLists  map[string]struct{}
+}
+
+func newRequestStats(gvr string) *RequestStats {
+	return &RequestStats{
+		GVR:           gvr,
+		ListLatency:   NewHistogram(),
+		WatchLatency:  NewHistogram(),
+		ThrottleWait:  NewHistogram(),
+		informerLists: make(map[string]int),
+		watchStarts:   make(map[string]int),
+		pendingLists:  make(map[string]struct{}),
+	}
+}
+
+func (s *RequestStats) clone() RequestStats {
+	c := *s
+	c.ListLatency, c.WatchLatency, c.ThrottleWait = s.ListLatency.clone(), s.WatchLatency.clone(), s.ThrottleWait.clone()
+	c.informerLists, c.watchStarts, c.pendingLists = nil, nil, nil
+
+	return c
+}
+
+// Diagnostics tracks api client requests latencies, throttling and watches health.
+type Diagnostics struct {
+	stats map[string]*RequestStats
+	since time.Time
+	mx    sync.RWMutex
+}
+
+// NewDiagnostics returns a new instance.
+func NewDiagnostics() *Diagnostics {
+	return &Diagnostics{
+		stats: make(map[string]*RequestStats),
+		since: time.Now(),
+	}
+}
+
+// Reset clears out all diagnostics.
+func (d *Diagnostics) Reset() {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	d.stats, d.since = make(map[string]*RequestStats), time.Now()
+}
+
+// Since returns the time diagnostics started.
+func (d *Diagnostics) Since() time.Time {
+	d.mx.RLock()
+	defer d.mx.RUnlock()
+
+	return d.since
+}
+
+// Stats returns a snapshot of all resources requests diagnostics.
+func (d *Diagnostics) Stats() []RequestStats {
+	d.mx.RLock()
+	defer d.mx.RUnlock()
+
+	ss := make([]RequestStats, 0, len(d.stats))
+	for _, s := range d.stats {
+		ss = append(ss, s.clone())
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i].GVR < ss[j].GVR
+	})
+
+	return ss
+}
+
+// WrapTransport instruments an api client transport.
+func (d *Diagnostics) WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	diagOnce.Do(func() {
+		metrics.Register(metrics.RegisterOpts{RateLimiterLatency: throttleMetric{}})
+	})
+
+	return &diagTransport{diag: d, rt: rt}
+}
+
+// observeThrottle records client side rate limiter waits.
+func (d *Diagnostics) observeThrottle(u url.URL, latency time.Duration) {
+	gvr, _, _ := parseRequestPath(u.Path)
+
+	d.mx.Lock()
+	defer d.mx.Unlock()
+	d.statsFor(gvr).ThrottleWait.Observe(latency)
+}
+
+func (d *Diagnostics) statsFor(gvr string) *RequestStats {
+	s, ok := d.stats[gvr]
+	if !ok {
+		s = newRequestStats(gvr)
+		d.stats[gvr] = s
+	}
+
+	return s
+}
+
+func (d *Diagnostics) record(req *http.Request, resp *http.Response, err error, latency time.Duration) {
+	gvr, ns, collection := parseRequestPath(req.URL.Path)
+	verb := requestVerb(req, collection)
+	failed := err != nil || resp.StatusCode >= http.StatusBadRequest
+
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	s := d.statsFor(gvr)
+	s.Requests++
+	if failed {
+		s.Errors++
+		if err != nil {
+			s.LastError = err.Error()
+		} else {
+			s.LastError = resp.Status
+		}
+	}
+	switch verb {
+	case listVerb:
+		s.Lists++
+		s.ListLatency.Observe(latency)
+		// Informers list then watch. Lists are paired with the next watch to tell them apart.
+		if !failed && isInformerList(req) {
+			s.pendingLists[ns] = struct{}{}
+		}
+	case watchVerb:
+		s.Watches++
+		s.WatchLatency.Observe(latency)
+		if _, ok := s.pendingLists[ns]; ok {
+			delete(s.pendingLists, ns)
+			if s.informerLists[ns]++; s.informerLists[ns] > 1 {
+				s.Relists++
+			}
+		}
+		if s.watchStarts[ns]++; s.watchStarts[ns] > 1 {
+			s.WatchRestarts++
+		}
+		if failed {
+			s.WatchErrors++
+			return
+		}
+		s.ActiveWatches++
+		resp.Body = &watchBody{ReadCloser: resp.Body, done: func() { d.watchDone(gvr) }}
+	}
+}
+
+func (d *Diagnostics) watchDone(gvr string) {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	if s, ok := d.stats[gvr]; ok && s.ActiveWatches > 0 {
+		s.ActiveWatches--
+	}
+}
+
+type diagTransport struct {
+	diag *Diagnostics
+	rt   http.RoundTripper
+}
+
+// RoundTrip records request latency and outcome.
+func (t *diagTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t0 := time.Now()
+	resp, err := t.rt.RoundTrip(req)
+	t.diag.record(req, resp, err, time.Since(t0))
+
+	return resp, err
+}
+
+type watchBody struct {
+	io.ReadCloser
+
+	done func()
+	once sync.Once
+}
+
+// Close closes the watch stream.
+func (w *watchBody) Close() error {
+	w.once.Do(w.done)
+
+	return w.ReadCloser.Close()
+}
+
+type throttleMetric struct{}
+
+// Observe records a rate limiter wait.
+func (throttleMetric) Observe(_ context.Context, _ string, u url.URL, latency time.Duration) {
+	Diag.observeThrottle(u, latency)
+}
+
+// parseRequestPath extracts the gvr and namespace from an api request path.
+// It also reports if the request targets a resource collection.
+func parseRequestPath(p string) (gvr, ns string, collection bool) {
+	tt := strings.Split(strings.Trim(p, "/"), "/")
+	for i, t := range tt {
+		if t == "api" || t == "apis" {
+			tt = tt[i:]
+			break
+		}
+	}
+
+	var gv []string
+	switch {
+	case len(tt) >= 3 && tt[0] == "api":
+		gv, tt = tt[1:2], tt[2:]
+	case len(tt) >= 4 && tt[0] == "apis":
+		gv, tt = tt[1:3], tt[3:]
+	default:
+		return NonResourceGVR, "", false
+	}
+	if len(tt) >= 3 && tt[0] == "namespaces" {
+		ns, tt = tt[1], tt[2:]
+	}
+
+	return strings.Join(append(gv, tt[0]), "/"), ns, len(tt) == 1
+}
+
+// isInformerList checks if a list request may originate from an informer.
+// Informers lists specify a resource version and do not request server side tables.
+func isInformerList(req *http.Request) bool {
+	if !req.URL.Query().Has("resourceVersion") {
+		return false
+	}
+
+	return !strings.Contains(req.Header.Get("Accept"), "as=Table")
+}
+
+func requestVerb(req *http.Request, collection bool) string {
+	if req.Method != http.MethodGet {
+		return strings.ToLower(req.Method)
+	}
+	if req.URL.Query().Get("watch") == "true" || req.URL.Query().Get("watch") == "1" {
+		return watchVerb
+	}
+	if collection {
+		return listVerb
+	}
+
+	return GetVerb
+}
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRequestPath(t *testing.T) {
+	uu := map[string]struct {
+		path       string
+		gvr, ns    string
+		collection bool
+	}{
+		"core-all": {
+			path:       "/api/v1/pods",
+			gvr:        "v1/pods",
+			collection: true,
+		},
+		"core-ns": {
+			path:       "/api/v1/namespaces/fred/pods",
+			gvr:        "v1/pods",
+			ns:         "fred",
+			collection: true,
+		},
+		"core-named": {
+			path: "/api/v1/namespaces/fred/pods/p1",
+			gvr:  "v1/pods",
+			ns:   "fred",
+		},
+		"namespaces": {
+			path:       "/api/v1/namespaces",
+			gvr:        "v1/namespaces",
+			collection: true,
+		},
+		"namespace": {
+			path: "/api/v1/namespaces/fred",
+			gvr:  "v1/namespaces",
+		},
+		"group": {
+			path:       "/apis/apps/v1/namespaces/fred/deployments",
+			gvr:        "apps/v1/deployments",
+			ns:         "fred",
+			collection: true,
+		},
+		"proxied": {
+			path:       "/k8s/clusters/c1/apis/apps/v1/deployments",
+			gvr:        "apps/v1/deployments",
+			collection: true,
+		},
+		"template": {
+			path:       "/api/v1/namespaces/{namespace}/pods",
+			gvr:        "v1/pods",
+			ns:         "{namespace}",
+			collection: true,
+		},
+		"discovery": {
+			path: "/apis/apps/v1",
+			gvr:  NonResourceGVR,
+		},
+		"version": {
+			path: "/version",
+			gvr:  NonResourceGVR,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			gvr, ns, collection := parseRequestPath(u.path)
+			assert.Equal(t, u.gvr, gvr)
+			assert.Equal(t, u.ns, ns)
+			assert.Equal(t, u.collection, collection)
+		})
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	h := NewHistogram()
+	assert.Equal(t, time.Duration(0), h.Quantile(0.99))
+
+	for i := 0; i < 98; i++ {
+		h.Observe(5 * time.Millisecond)
+	}
+	h.Observe(300 * time.Millisecond)
+	h.Observe(20 * time.Second)
+
+	assert.Equal(t, 100, h.Total)
+	assert.Equal(t, 98, h.Counts[0])
+	assert.Equal(t, 1, h.Counts[len(h.Counts)-1])
+	assert.Equal(t, 10*time.Millisecond, h.Quantile(0.5))
+	assert.Equal(t, 500*time.Millisecond, h.Quantile(0.99))
+	assert.Equal(t, 20*time.Second, h.Quantile(1))
+	assert.Equal(t, 20*time.Second, h.Max)
+}
+
+func TestDiagnosticsTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/namespaces/fred/secrets" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	d := NewDiagnostics()
+	c := http.Client{Transport: d.WrapTransport(http.DefaultTransport)}
+	send := func(path, accept string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, srv.URL+path, http.NoBody)
+		assert.NoError(t, err)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		resp, err := c.Do(req)
+		assert.NoError(t, err)
+		return resp
+	}
+	get := func(path string) *http.Response {
+		return send(path, "")
+	}
+
+	// Table lists are not informer lists.
+	for i := 0; i < 2; i++ {
+		send("/api/v1/namespaces/fred/pods?resourceVersion=0", "application/json;as=Table;v=v1;g=meta.k8s.io, application/json").Body.Close()
+	}
+	get("/api/v1/namespaces/fred/pods?resourceVersion=0").Body.Close()
+	w1 := get("/api/v1/namespaces/fred/pods?watch=true")
+	get("/api/v1/namespaces/fred/pods?watch=true").Body.Close()
+	get("/api/v1/namespaces/fred/pods?resourceVersion=10").Body.Close()
+	w2 := get("/api/v1/namespaces/fred/pods?watch=true")
+	get("/api/v1/namespaces/fred/secrets").Body.Close()
+	get("/version").Body.Close()
+
+	_, _ = io.ReadAll(w1.Body)
+	assert.NoError(t, w1.Body.Close())
+
+	ss := d.Stats()
+	assert.Equal(t, 3, len(ss))
+	assert.Equal(t, NonResourceGVR, ss[0].GVR)
+	assert.Equal(t, 1, ss[0].Requests)
+
+	po := ss[1]
+	assert.Equal(t, "v1/pods", po.GVR)
+	assert.Equal(t, 7, po.Requests)
+	assert.Equal(t, 4, po.Lists)
+	assert.Equal(t, 1, po.Relists)
+	assert.Equal(t, 4, po.ListLatency.Total)
+	assert.Equal(t, 3, po.Watches)
+	assert.Equal(t, 2, po.WatchRestarts)
+	assert.Equal(t, 1, po.ActiveWatches)
+	assert.Equal(t, 0, po.Errors)
+
+	sec := ss[2]
+	assert.Equal(t, "v1/secrets", sec.GVR)
+	assert.Equal(t, 1, sec.Errors)
+	assert.Equal(t, "403 Forbidden", sec.LastError)
+
+	assert.NoError(t, w2.Body.Close())
+	d.Reset()
+	assert.Empty(t, d.Stats())
+}
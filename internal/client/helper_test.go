@@ -172,6 +172,54 @@ func TestIsAllNamespace(t *testing.T) {
 	}
 }
 
+func TestIsMultiNamespace(t *testing.T) {
+	uu := map[string]struct {
+		ns string
+		e  bool
+	}{
+		"empty":  {},
+		"single": {ns: "ns1"},
+		"all":    {ns: client.NamespaceAll},
+		"multi": {
+			ns: "ns1,ns2",
+			e:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, client.IsMultiNamespace(u.ns))
+		})
+	}
+}
+
+func TestNamespaces(t *testing.T) {
+	uu := map[string]struct {
+		ns string
+		e  []string
+	}{
+		"empty": {
+			e: []string{},
+		},
+		"single": {
+			ns: "ns1",
+			e:  []string{"ns1"},
+		},
+		"multi": {
+			ns: "ns1, ns2,,ns3",
+			e:  []string{"ns1", "ns2", "ns3"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, client.Namespaces(u.ns))
+		})
+	}
+}
+
 func TestCleanseNamespace(t *testing.T) {
 	uu := map[string]struct {
 		ns, e string
@@ -20,6 +20,23 @@ func IsClusterWide(ns string) bool {
 	return ns == NamespaceAll || ns == BlankNamespace || ns == ClusterScope
 }
 
+// IsMultiNamespace returns true if ns spans several namespaces.
+func IsMultiNamespace(ns string) bool {
+	return strings.Contains(ns, NamespaceSep)
+}
+
+// Namespaces returns the namespaces of a multi namespaces spec.
+func Namespaces(ns string) []string {
+	nn := make([]string, 0, strings.Count(ns, NamespaceSep)+1)
+	for _, n := range strings.Split(ns, NamespaceSep) {
+		if n = strings.TrimSpace(n); n != "" {
+			nn = append(nn, n)
+		}
+	}
+
+	return nn
+}
+
 // CleanseNamespace ensures all ns maps to blank.
 func CleanseNamespace(ns string) string {
 	if IsAllNamespace(ns) {
@@ -5,7 +5,7 @@ package client
 
 import (
 	"k8s.io/apimachinery/pkg/version"
-	"k8s.io/client-go/discovery/cached/disk"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
@@ -32,6 +32,9 @@ const (
 	// NotNamespaced designates a non resource namespace.
 	NotNamespaced = "*"
 
+	// NamespaceSep separates namespaces in a multi namespaces spec ie ns1,ns2.
+	NamespaceSep = ","
+
 	// CreateVerb represents create access on a resource.
 	CreateVerb = "create"
 
@@ -106,7 +109,7 @@ type Connection interface {
 	SwitchContext(ctx string) error
 
 	// CachedDiscovery connects to discovery client.
-	CachedDiscovery() (*disk.CachedDiscoveryClient, error)
+	CachedDiscovery() (discovery.CachedDiscoveryInterface, error)
 
 	// RestConfig connects to rest client.
 	RestConfig() (*restclient.Config, error)
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"regexp"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"gopkg.in/yaml.v2"
+)
+
+// AllGVRs represents a rule matching any resources.
+const AllGVRs = "*"
+
+// Alerts represents a collection of watch alert rules.
+type Alerts struct {
+	Alert map[string]AlertRule `yaml:"alerts"`
+}
+
+// AlertRule describes a resource state transition to be notified about.
+type AlertRule struct {
+	GVR    string `yaml:"gvr"`
+	State  string `yaml:"state"`
+	Bell   bool   `yaml:"bell,omitempty"`
+	Notify bool   `yaml:"notify,omitempty"`
+	Exec   string `yaml:"exec,omitempty"`
+}
+
+// NewAlerts returns a new alert rules collection.
+func NewAlerts() Alerts {
+	return Alerts{
+		Alert: make(map[string]AlertRule),
+	}
+}
+
+// DefaultAlerts returns the alert rules used when none are configured.
+func DefaultAlerts() Alerts {
+	return Alerts{
+		Alert: map[string]AlertRule{
+			"podFailed": {
+				GVR:   "v1/pods",
+				State: "^(CrashLoopBackOff|Error|OOMKilled|ErrImagePull|ImagePullBackOff)$",
+			},
+			"deploymentAvailable": {
+				GVR:   "apps/v1/deployments",
+				State: "^Available$",
+			},
+			"jobDone": {
+				GVR:   "batch/v1/jobs",
+				State: "^(Complete|Failed)$",
+			},
+		},
+	}
+}
+
+// Load loads the shared alert rules and the context specific ones if any.
+// Falls back to the default rules when none are configured.
+func (a Alerts) Load(path string) error {
+	if err := a.LoadAlerts(AppAlertsFile); err != nil {
+		return err
+	}
+	if err := a.LoadAlerts(path); err != nil {
+		return err
+	}
+	if len(a.Alert) == 0 {
+		for k, v := range DefaultAlerts().Alert {
+			a.Alert[k] = v
+		}
+	}
+
+	return nil
+}
+
+// LoadAlerts loads alert rules from a given file.
+func (a Alerts) LoadAlerts(path string) error {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := data.JSONValidator.Validate(json.AlertsSchema, bb); err != nil {
+		return fmt.Errorf("validation failed for %q: %w", path, err)
+	}
+
+	var aa Alerts
+	if err := yaml.Unmarshal(bb, &aa); err != nil {
+		return err
+	}
+	var errs error
+	for k, v := range aa.Alert {
+		if _, err := regexp.Compile(v.State); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("invalid state expression for alert %q: %w", k, err))
+			continue
+		}
+		a.Alert[k] = v
+	}
+
+	return errs
+}
+
+// Matches checks if the rule applies to a resource transitioning to a given state.
+func (r AlertRule) Matches(gvr, state string) bool {
+	if r.GVR != AllGVRs && r.GVR != gvr {
+		return false
+	}
+	rx, err := regexp.Compile(r.State)
+	if err != nil {
+		return false
+	}
+
+	return rx.MatchString(state)
+}
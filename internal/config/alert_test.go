@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertsLoad(t *testing.T) {
+	a := config.NewAlerts()
+	assert.NoError(t, a.LoadAlerts("testdata/alerts/alerts.yaml"))
+
+	assert.Equal(t, 2, len(a.Alert))
+	r, ok := a.Alert["crashed"]
+	assert.True(t, ok)
+	assert.Equal(t, config.AlertRule{GVR: "v1/pods", State: "CrashLoopBackOff", Bell: true, Notify: true}, r)
+	assert.Equal(t, `notify-send "$NAME completed"`, a.Alert["done"].Exec)
+}
+
+func TestAlertsLoadDefaults(t *testing.T) {
+	a := config.NewAlerts()
+	assert.NoError(t, a.Load("testdata/alerts/none.yaml"))
+
+	assert.Equal(t, config.DefaultAlerts(), a)
+}
+
+func TestAlertRuleMatches(t *testing.T) {
+	uu := map[string]struct {
+		r          config.AlertRule
+		gvr, state string
+		e          bool
+	}{
+		"match": {
+			r:     config.AlertRule{GVR: "v1/pods", State: "CrashLoopBackOff"},
+			gvr:   "v1/pods",
+			state: "CrashLoopBackOff",
+			e:     true,
+		},
+		"rx": {
+			r:     config.AlertRule{GVR: "batch/v1/jobs", State: "^(Complete|Failed)$"},
+			gvr:   "batch/v1/jobs",
+			state: "Failed",
+			e:     true,
+		},
+		"gvr": {
+			r:     config.AlertRule{GVR: "v1/pods", State: "Failed"},
+			gvr:   "batch/v1/jobs",
+			state: "Failed",
+		},
+		"all": {
+			r:     config.AlertRule{GVR: config.AllGVRs, State: "Deleted"},
+			gvr:   "apps/v1/deployments",
+			state: "Deleted",
+			e:     true,
+		},
+		"state": {
+			r:     config.AlertRule{GVR: "v1/pods", State: "^Error$"},
+			gvr:   "v1/pods",
+			state: "Running",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.r.Matches(u.gvr, u.state))
+		})
+	}
+}
@@ -188,8 +188,11 @@ func (a *Aliases) loadDefaultAliases() {
 	a.declare("benchmarks", "benchmark", "bench")
 	a.declare("screendumps", "screendump", "sd")
 	a.declare("pulses", "pulse", "pu", "hz")
+	a.declare("timelines", "timeline", "tl")
 	a.declare("xrays", "xray", "x")
 	a.declare("workloads", "workload", "wk")
+	a.declare("rightsizings", "rightsizing", "rz")
+	a.declare("queries", "query", "qy")
 }
 
 // Save alias to disk.
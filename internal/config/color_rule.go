@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ColorRule represents a row coloring rule. A rule matches when all of its
+// conditions hold for the given column value.
+type ColorRule struct {
+	Column  string   `yaml:"column"`
+	Equals  string   `yaml:"equals,omitempty"`
+	Matches string   `yaml:"matches,omitempty"`
+	GT      *float64 `yaml:"gt,omitempty"`
+	LT      *float64 `yaml:"lt,omitempty"`
+	Color   string   `yaml:"color"`
+
+	rx *regexp.Regexp
+}
+
+// Validate checks the rule is well formed.
+func (r *ColorRule) Validate() error {
+	if r.Matches == "" {
+		return nil
+	}
+	rx, err := regexp.Compile(r.Matches)
+	if err != nil {
+		return fmt.Errorf("invalid color rule regex %q on column %s: %w", r.Matches, r.Column, err)
+	}
+	r.rx = rx
+
+	return nil
+}
+
+// Match checks if a column value satisfies the rule conditions.
+func (r ColorRule) Match(v string) bool {
+	if r.Equals != "" && r.Equals != v {
+		return false
+	}
+	if r.Matches != "" {
+		rx := r.rx
+		if rx == nil {
+			var err error
+			if rx, err = regexp.Compile(r.Matches); err != nil {
+				return false
+			}
+		}
+		if !rx.MatchString(v) {
+			return false
+		}
+	}
+	if r.GT == nil && r.LT == nil {
+		return true
+	}
+	n, ok := toNumber(v)
+	if !ok {
+		return false
+	}
+	if r.GT != nil && n <= *r.GT {
+		return false
+	}
+	if r.LT != nil && n >= *r.LT {
+		return false
+	}
+
+	return true
+}
+
+// Equal checks if two rules are identical.
+func (r ColorRule) Equal(o ColorRule) bool {
+	return r.Column == o.Column &&
+		r.Equals == o.Equals &&
+		r.Matches == o.Matches &&
+		floatPtrEqual(r.GT, o.GT) &&
+		floatPtrEqual(r.LT, o.LT) &&
+		r.Color == o.Color
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func floatPtrEqual(f1, f2 *float64) bool {
+	if f1 == nil || f2 == nil {
+		return f1 == nil && f2 == nil
+	}
+
+	return *f1 == *f2
+}
+
+// toNumber extracts a numeric value from a column value ie 5, 5 (2m ago), 45%
+// or a resource quantity like 128Mi.
+func toNumber(v string) (float64, bool) {
+	v = strings.TrimSpace(v)
+	if i := strings.IndexAny(v, " %("); i > 0 {
+		v = v[:i]
+	}
+	if n, err := strconv.ParseFloat(v, 64); err == nil {
+		return n, true
+	}
+	q, err := resource.ParseQuantity(v)
+	if err != nil {
+		return 0, false
+	}
+
+	return q.AsApproximateFloat64(), true
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColorRuleMatch(t *testing.T) {
+	five, hundred := 5.0, 100.0
+	uu := map[string]struct {
+		r config.ColorRule
+		v string
+		e bool
+	}{
+		"equals": {
+			r: config.ColorRule{Equals: "Running"},
+			v: "Running",
+			e: true,
+		},
+		"equals-miss": {
+			r: config.ColorRule{Equals: "Running"},
+			v: "Pending",
+		},
+		"regex": {
+			r: config.ColorRule{Matches: "NoSchedule|NoExecute"},
+			v: "node.kubernetes.io/unreachable:NoExecute",
+			e: true,
+		},
+		"regex-miss": {
+			r: config.ColorRule{Matches: "^Err"},
+			v: "Running",
+		},
+		"gt": {
+			r: config.ColorRule{GT: &five},
+			v: "6",
+			e: true,
+		},
+		"gt-boundary": {
+			r: config.ColorRule{GT: &five},
+			v: "5",
+		},
+		"gt-restarts-ago": {
+			r: config.ColorRule{GT: &five},
+			v: "12 (3m ago)",
+			e: true,
+		},
+		"gt-percent": {
+			r: config.ColorRule{GT: &five},
+			v: "45%",
+			e: true,
+		},
+		"lt-capacity": {
+			r: config.ColorRule{LT: &hundred},
+			v: "64Mi",
+		},
+		"range": {
+			r: config.ColorRule{GT: &five, LT: &hundred},
+			v: "50",
+			e: true,
+		},
+		"not-a-number": {
+			r: config.ColorRule{GT: &five},
+			v: "n/a",
+		},
+		"and": {
+			r: config.ColorRule{Matches: "^p", GT: &five},
+			v: "10",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.r.Match(u.v))
+		})
+	}
+}
+
+func TestColorRuleValidate(t *testing.T) {
+	r := config.ColorRule{Column: "STATUS", Matches: "(Err"}
+
+	assert.Error(t, r.Validate())
+}
@@ -43,6 +43,60 @@ func (c *Config) ContextHotkeysPath() string {
 	return AppContextHotkeysFile(ct.ClusterName, c.K9s.activeContextName)
 }
 
+// ContextAlertsPath returns a context specific alerts file spec.
+func (c *Config) ContextAlertsPath() string {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return ""
+	}
+
+	return AppContextAlertsFile(ct.ClusterName, c.K9s.activeContextName)
+}
+
+// ContextKeyMapPath returns a context specific key bindings file spec.
+func (c *Config) ContextKeyMapPath() string {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return ""
+	}
+
+	return AppContextKeyMapFile(ct.ClusterName, c.K9s.activeContextName)
+}
+
+// ContextQueriesPath returns a context specific queries file spec.
+func (c *Config) ContextQueriesPath() string {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return ""
+	}
+
+	return AppContextQueriesFile(ct.GetClusterName(), c.K9s.activeContextName)
+}
+
+// ContextLayoutPath returns a context specific layout file spec.
+func (c *Config) ContextLayoutPath() string {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return ""
+	}
+
+	return AppContextLayoutFile(ct.GetClusterName(), c.K9s.activeContextName)
+}
+
+// ContextSessionPath returns a context specific session file spec.
+// Offline sessions are not recorded.
+func (c *Config) ContextSessionPath() string {
+	if c.K9s.IsOffline() {
+		return ""
+	}
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return ""
+	}
+
+	return AppContextSessionFile(ct.GetClusterName(), c.K9s.activeContextName)
+}
+
 // ContextAliasesPath returns a context specific aliases file spec.
 func (c *Config) ContextAliasesPath() string {
 	ct, err := c.K9s.ActiveContext()
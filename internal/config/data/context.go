@@ -20,6 +20,7 @@ type Context struct {
 	View               *View        `yaml:"view"`
 	FeatureGates       FeatureGates `yaml:"featureGates"`
 	PortForwardAddress string       `yaml:"portForwardAddress"`
+	Prometheus         *Prometheus  `yaml:"prometheus,omitempty"`
 	mx                 sync.RWMutex
 }
 
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data
+
+// promKeyLabels tracks the default series labels identifying a resource.
+var promKeyLabels = map[string][]string{
+	"v1/pods":              {"namespace", "pod"},
+	"v1/nodes":             {"node"},
+	"v1/namespaces":        {"namespace"},
+	"v1/services":          {"namespace", "service"},
+	"apps/v1/deployments":  {"namespace", "deployment"},
+	"apps/v1/statefulsets": {"namespace", "statefulset"},
+	"apps/v1/daemonsets":   {"namespace", "daemonset"},
+	"batch/v1/jobs":        {"namespace", "job_name"},
+}
+
+// Prometheus tracks a Prometheus compatible metrics endpoint.
+type Prometheus struct {
+	URL         string           `yaml:"url,omitempty"`
+	PortForward *PromPortForward `yaml:"portForward,omitempty"`
+	Queries     []PromQuery      `yaml:"queries,omitempty"`
+}
+
+// PromPortForward tracks a port-forward to a Prometheus service.
+type PromPortForward struct {
+	Namespace string `yaml:"namespace"`
+	Service   string `yaml:"service"`
+	Port      int    `yaml:"port"`
+	LocalPort int    `yaml:"localPort,omitempty"`
+}
+
+// PromQuery describes a PromQL template populating a resource column and history chart.
+type PromQuery struct {
+	GVR    string   `yaml:"gvr"`
+	Name   string   `yaml:"name"`
+	Expr   string   `yaml:"expr"`
+	Labels []string `yaml:"labels,omitempty"`
+	Format string   `yaml:"format,omitempty"`
+}
+
+// IsEnabled checks if an endpoint is configured.
+func (p *Prometheus) IsEnabled() bool {
+	return p != nil && (p.URL != "" || p.PortForward != nil)
+}
+
+// QueriesFor returns the queries for a given resource.
+func (p *Prometheus) QueriesFor(gvr string) []PromQuery {
+	if !p.IsEnabled() {
+		return nil
+	}
+	qq := make([]PromQuery, 0, len(p.Queries))
+	for _, q := range p.Queries {
+		if q.GVR == gvr {
+			qq = append(qq, q)
+		}
+	}
+
+	return qq
+}
+
+// KeyLabels returns the series labels identifying a resource.
+func (q PromQuery) KeyLabels() []string {
+	if len(q.Labels) > 0 {
+		return q.Labels
+	}
+	if ll, ok := promKeyLabels[q.GVR]; ok {
+		return ll
+	}
+
+	return []string{"namespace", "name"}
+}
+
+// ValueFormat returns the column value format.
+func (q PromQuery) ValueFormat() string {
+	if q.Format != "" {
+		return q.Format
+	}
+
+	return "%.2f"
+}
+
+// LocalPortOrDefault returns the local port to forward to.
+func (p *PromPortForward) LocalPortOrDefault() int {
+	if p.LocalPort != 0 {
+		return p.LocalPort
+	}
+
+	return p.Port
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrometheusQueriesFor(t *testing.T) {
+	uu := map[string]struct {
+		p   *data.Prometheus
+		gvr string
+		e   int
+	}{
+		"none": {
+			gvr: "v1/pods",
+		},
+		"no-endpoint": {
+			p: &data.Prometheus{
+				Queries: []data.PromQuery{{GVR: "v1/pods", Name: "RPS"}},
+			},
+			gvr: "v1/pods",
+		},
+		"happy": {
+			p: &data.Prometheus{
+				URL: "http://localhost:9090",
+				Queries: []data.PromQuery{
+					{GVR: "v1/pods", Name: "RPS"},
+					{GVR: "v1/nodes", Name: "LOAD"},
+					{GVR: "v1/pods", Name: "P99"},
+				},
+			},
+			gvr: "v1/pods",
+			e:   2,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, len(u.p.QueriesFor(u.gvr)))
+		})
+	}
+}
+
+func TestPromQueryKeyLabels(t *testing.T) {
+	uu := map[string]struct {
+		q data.PromQuery
+		e []string
+	}{
+		"pods": {
+			q: data.PromQuery{GVR: "v1/pods"},
+			e: []string{"namespace", "pod"},
+		},
+		"nodes": {
+			q: data.PromQuery{GVR: "v1/nodes"},
+			e: []string{"node"},
+		},
+		"custom": {
+			q: data.PromQuery{GVR: "v1/nodes", Labels: []string{"instance"}},
+			e: []string{"instance"},
+		},
+		"default": {
+			q: data.PromQuery{GVR: "fred.io/v1/blees"},
+			e: []string{"namespace", "name"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.q.KeyLabels())
+		})
+	}
+}
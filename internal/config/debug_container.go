@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+const (
+	// DebugProfileGeneral represents a general purpose debug profile.
+	DebugProfileGeneral = "general"
+
+	// DebugProfileBaseline represents a debug profile matching the baseline pod security standard.
+	DebugProfileBaseline = "baseline"
+
+	// DebugProfileRestricted represents a debug profile matching the restricted pod security standard.
+	DebugProfileRestricted = "restricted"
+
+	// DebugProfileNetAdmin represents a network administrator debug profile.
+	DebugProfileNetAdmin = "netadmin"
+
+	// DebugProfileSysAdmin represents a system administrator debug profile.
+	DebugProfileSysAdmin = "sysadmin"
+)
+
+// DebugProfiles tracks all supported debug profiles.
+var DebugProfiles = []string{
+	DebugProfileGeneral,
+	DebugProfileBaseline,
+	DebugProfileRestricted,
+	DebugProfileNetAdmin,
+	DebugProfileSysAdmin,
+}
+
+// DebugContainer represents k9s ephemeral debug container configuration.
+type DebugContainer struct {
+	Image     string   `json:"image" yaml:"image"`
+	Profile   string   `json:"profile" yaml:"profile"`
+	Command   []string `json:"command,omitempty" yaml:"command,omitempty"`
+	Namespace string   `json:"namespace" yaml:"namespace"`
+}
+
+// NewDebugContainer returns a new instance.
+func NewDebugContainer() DebugContainer {
+	return DebugContainer{
+		Image:     defaultDockerShellImage,
+		Profile:   DebugProfileGeneral,
+		Namespace: "default",
+	}
+}
+
+// Validate validates the configuration.
+func (d DebugContainer) Validate() DebugContainer {
+	if d.Image == "" {
+		d.Image = defaultDockerShellImage
+	}
+	if d.Namespace == "" {
+		d.Namespace = "default"
+	}
+	for _, p := range DebugProfiles {
+		if d.Profile == p {
+			return d
+		}
+	}
+	d.Profile = DebugProfileGeneral
+
+	return d
+}
@@ -80,6 +80,15 @@ var (
 
 	// AppHotKeysFile tracks hotkeys config file.
 	AppHotKeysFile string
+
+	// AppQueriesFile tracks shared queries config file.
+	AppQueriesFile string
+
+	// AppKeyMapFile tracks key bindings config file.
+	AppKeyMapFile string
+
+	// AppAlertsFile tracks watch alerts config file.
+	AppAlertsFile string
 )
 
 // InitLogLoc initializes K9s logs location.
@@ -143,6 +152,9 @@ func initK9sEnvLocs() error {
 
 	AppConfigFile = filepath.Join(AppConfigDir, data.MainConfigFile)
 	AppHotKeysFile = filepath.Join(AppConfigDir, "hotkeys.yaml")
+	AppQueriesFile = filepath.Join(AppConfigDir, "queries.yaml")
+	AppKeyMapFile = filepath.Join(AppConfigDir, "keymap.yaml")
+	AppAlertsFile = filepath.Join(AppConfigDir, "alerts.yaml")
 	AppAliasesFile = filepath.Join(AppConfigDir, "aliases.yaml")
 	AppPluginsFile = filepath.Join(AppConfigDir, "plugins.yaml")
 	AppViewsFile = filepath.Join(AppConfigDir, "views.yaml")
@@ -164,6 +176,9 @@ func initXDGLocs() error {
 	}
 
 	AppHotKeysFile = filepath.Join(AppConfigDir, "hotkeys.yaml")
+	AppQueriesFile = filepath.Join(AppConfigDir, "queries.yaml")
+	AppKeyMapFile = filepath.Join(AppConfigDir, "keymap.yaml")
+	AppAlertsFile = filepath.Join(AppConfigDir, "alerts.yaml")
 	AppAliasesFile = filepath.Join(AppConfigDir, "aliases.yaml")
 	AppPluginsFile = filepath.Join(AppConfigDir, "plugins.yaml")
 	AppViewsFile = filepath.Join(AppConfigDir, "views.yaml")
@@ -215,6 +230,31 @@ func AppContextHotkeysFile(cluster, context string) string {
 	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "hotkeys.yaml")
 }
 
+// AppContextAlertsFile generates a valid context specific alerts file path.
+func AppContextAlertsFile(cluster, context string) string {
+	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "alerts.yaml")
+}
+
+// AppContextKeyMapFile generates a valid context specific key bindings file path.
+func AppContextKeyMapFile(cluster, context string) string {
+	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "keymap.yaml")
+}
+
+// AppContextLayoutFile generates a valid context specific layout file path.
+func AppContextLayoutFile(cluster, context string) string {
+	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "layout.yaml")
+}
+
+// AppContextSessionFile generates a valid context specific session file path.
+func AppContextSessionFile(cluster, context string) string {
+	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "session.yaml")
+}
+
+// AppContextQueriesFile generates a valid context specific queries file path.
+func AppContextQueriesFile(cluster, context string) string {
+	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "queries.yaml")
+}
+
 // AppContextConfig generates a valid context config file path.
 func AppContextConfig(cluster, context string) string {
 	return filepath.Join(AppContextDir(cluster, context), data.MainConfigFile)
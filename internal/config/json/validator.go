@@ -28,6 +28,21 @@ const (
 	// HotkeysSchema describes hotkeys schema.
 	HotkeysSchema = "hotkeys.json"
 
+	// QueriesSchema describes queries schema.
+	QueriesSchema = "queries.json"
+
+	// AlertsSchema describes alerts schema.
+	AlertsSchema = "alerts.json"
+
+	// KeyMapSchema describes key bindings schema.
+	KeyMapSchema = "keymap.json"
+
+	// LayoutSchema describes layout schema.
+	LayoutSchema = "layout.json"
+
+	// SessionSchema describes session schema.
+	SessionSchema = "session.json"
+
 	// K9sSchema describes k9s config schema.
 	K9sSchema = "k9s.json"
 
@@ -57,6 +72,21 @@ var (
 	//go:embed schemas/hotkeys.json
 	hotkeysSchema string
 
+	//go:embed schemas/queries.json
+	queriesSchema string
+
+	//go:embed schemas/alerts.json
+	alertsSchema string
+
+	//go:embed schemas/keymap.json
+	keyMapSchema string
+
+	//go:embed schemas/layout.json
+	layoutSchema string
+
+	//go:embed schemas/session.json
+	sessionSchema string
+
 	//go:embed schemas/skin.json
 	skinSchema string
 )
@@ -77,6 +107,11 @@ func NewValidator() *Validator {
 			ViewsSchema:   gojsonschema.NewStringLoader(viewsSchema),
 			PluginsSchema: gojsonschema.NewStringLoader(pluginSchema),
 			HotkeysSchema: gojsonschema.NewStringLoader(hotkeysSchema),
+			QueriesSchema: gojsonschema.NewStringLoader(queriesSchema),
+			AlertsSchema:  gojsonschema.NewStringLoader(alertsSchema),
+			KeyMapSchema:  gojsonschema.NewStringLoader(keyMapSchema),
+			LayoutSchema:  gojsonschema.NewStringLoader(layoutSchema),
+			SessionSchema: gojsonschema.NewStringLoader(sessionSchema),
 			SkinSchema:    gojsonschema.NewStringLoader(skinSchema),
 		},
 	}
@@ -221,3 +221,161 @@ columns is required`,
 		})
 	}
 }
+
+func TestValidateQueries(t *testing.T) {
+	uu := map[string]struct {
+		f   string
+		err string
+	}{
+		"happy": {
+			f: "testdata/queries/cool.yaml",
+		},
+		"toast": {
+			f: "testdata/queries/toast.yaml",
+			err: `Additional property cmd is not allowed
+Additional property ns is not allowed
+command is required`,
+		},
+	}
+
+	v := json.NewValidator()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb, err := os.ReadFile(u.f)
+			assert.NoError(t, err)
+			err = v.Validate(json.QueriesSchema, bb)
+			if u.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, u.err, err.Error())
+			}
+		})
+	}
+}
+
+func TestValidateLayout(t *testing.T) {
+	uu := map[string]struct {
+		f   string
+		err string
+	}{
+		"happy": {
+			f: "testdata/layout/cool.yaml",
+		},
+		"toast": {
+			f: "testdata/layout/toast.yaml",
+			err: `Additional property cmd is not allowed
+command is required
+tabs.0.split must be one of the following: "", "horizontal", "vertical"`,
+		},
+	}
+
+	v := json.NewValidator()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb, err := os.ReadFile(u.f)
+			assert.NoError(t, err)
+			err = v.Validate(json.LayoutSchema, bb)
+			if u.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, u.err, err.Error())
+			}
+		})
+	}
+}
+
+func TestValidateSession(t *testing.T) {
+	uu := map[string]struct {
+		f   string
+		err string
+	}{
+		"happy": {
+			f: "testdata/session/cool.yaml",
+		},
+		"toast": {
+			f: "testdata/session/toast.yaml",
+			err: `Additional property sort is not allowed
+gvr is required`,
+		},
+	}
+
+	v := json.NewValidator()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb, err := os.ReadFile(u.f)
+			assert.NoError(t, err)
+			err = v.Validate(json.SessionSchema, bb)
+			if u.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, u.err, err.Error())
+			}
+		})
+	}
+}
+
+func TestValidateKeyMap(t *testing.T) {
+	uu := map[string]struct {
+		f   string
+		err string
+	}{
+		"happy": {
+			f: "testdata/keymap/cool.yaml",
+		},
+		"toast": {
+			f: "testdata/keymap/toast.yaml",
+			err: `Does not match pattern '^([^.]+(\.[^.]+)*\.)?[A-Za-z0-9]+$'
+Property name of "pod.sort-name" does not match
+String length must be greater than or equal to 1`,
+		},
+	}
+
+	v := json.NewValidator()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb, err := os.ReadFile(u.f)
+			assert.NoError(t, err)
+			err = v.Validate(json.KeyMapSchema, bb)
+			if u.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, u.err, err.Error())
+			}
+		})
+	}
+}
+
+func TestValidateAlerts(t *testing.T) {
+	uu := map[string]struct {
+		f   string
+		err string
+	}{
+		"happy": {
+			f: "testdata/alerts/cool.yaml",
+		},
+		"toast": {
+			f: "testdata/alerts/toast.yaml",
+			err: `Additional property status is not allowed
+state is required`,
+		},
+	}
+
+	v := json.NewValidator()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb, err := os.ReadFile(u.f)
+			assert.NoError(t, err)
+			err = v.Validate(json.AlertsSchema, bb)
+			if u.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, u.err, err.Error())
+			}
+		})
+	}
+}
@@ -14,23 +14,29 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/rs/zerolog/log"
+	"k8s.io/client-go/tools/clientcmd/api"
 )
 
 // K9s tracks K9s configuration options.
 type K9s struct {
-	LiveViewAutoRefresh bool       `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
-	ScreenDumpDir       string     `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
-	RefreshRate         int        `json:"refreshRate" yaml:"refreshRate"`
-	MaxConnRetry        int        `json:"maxConnRetry" yaml:"maxConnRetry"`
-	ReadOnly            bool       `json:"readOnly" yaml:"readOnly"`
-	NoExitOnCtrlC       bool       `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
-	UI                  UI         `json:"ui" yaml:"ui"`
-	SkipLatestRevCheck  bool       `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
-	DisablePodCounting  bool       `json:"disablePodCounting" yaml:"disablePodCounting"`
-	ShellPod            ShellPod   `json:"shellPod" yaml:"shellPod"`
-	ImageScans          ImageScans `json:"imageScans" yaml:"imageScans"`
-	Logger              Logger     `json:"logger" yaml:"logger"`
-	Thresholds          Threshold  `json:"thresholds" yaml:"thresholds"`
+	LiveViewAutoRefresh bool           `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
+	ScreenDumpDir       string         `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
+	RefreshRate         int            `json:"refreshRate" yaml:"refreshRate"`
+	MaxConnRetry        int            `json:"maxConnRetry" yaml:"maxConnRetry"`
+	ReadOnly            bool           `json:"readOnly" yaml:"readOnly"`
+	NoExitOnCtrlC       bool           `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
+	RestoreSession      bool           `json:"restoreSession" yaml:"restoreSession"`
+	UI                  UI             `json:"ui" yaml:"ui"`
+	SkipLatestRevCheck  bool           `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
+	DisablePodCounting  bool           `json:"disablePodCounting" yaml:"disablePodCounting"`
+	ShellPod            ShellPod       `json:"shellPod" yaml:"shellPod"`
+	DebugContainer      DebugContainer `json:"debugContainer" yaml:"debugContainer"`
+	RightSizing         RightSizing    `json:"rightSizing" yaml:"rightSizing"`
+	LargeResources      LargeResources `json:"largeResources" yaml:"largeResources"`
+	Snapshot            Snapshot       `json:"snapshot" yaml:"snapshot"`
+	ImageScans          ImageScans     `json:"imageScans" yaml:"imageScans"`
+	Logger              Logger         `json:"logger" yaml:"logger"`
+	Thresholds          Threshold      `json:"thresholds" yaml:"thresholds"`
 	manualRefreshRate   int
 	manualHeadless      *bool
 	manualLogoless      *bool
@@ -39,6 +45,7 @@ type K9s struct {
 	manualCommand       *string
 	manualScreenDumpDir *string
 	dir                 *data.Dir
+	offline             bool
 	activeContextName   string
 	activeConfig        *data.Config
 	conn                client.Connection
@@ -49,16 +56,20 @@ type K9s struct {
 // NewK9s create a new K9s configuration.
 func NewK9s(conn client.Connection, ks data.KubeSettings) *K9s {
 	return &K9s{
-		RefreshRate:   defaultRefreshRate,
-		MaxConnRetry:  defaultMaxConnRetry,
-		ScreenDumpDir: AppDumpsDir,
-		Logger:        NewLogger(),
-		Thresholds:    NewThreshold(),
-		ShellPod:      NewShellPod(),
-		ImageScans:    NewImageScans(),
-		dir:           data.NewDir(AppContextsDir),
-		conn:          conn,
-		ks:            ks,
+		RefreshRate:    defaultRefreshRate,
+		MaxConnRetry:   defaultMaxConnRetry,
+		ScreenDumpDir:  AppDumpsDir,
+		Logger:         NewLogger(),
+		Thresholds:     NewThreshold(),
+		ShellPod:       NewShellPod(),
+		DebugContainer: NewDebugContainer(),
+		RightSizing:    NewRightSizing(),
+		LargeResources: NewLargeResources(),
+		Snapshot:       NewSnapshot(),
+		ImageScans:     NewImageScans(),
+		dir:            data.NewDir(AppContextsDir),
+		conn:           conn,
+		ks:             ks,
 	}
 }
 
@@ -69,8 +80,28 @@ func (k *K9s) resetConnection(conn client.Connection) {
 	k.conn = conn
 }
 
+// SetOffline flags the session as browsing a snapshot. Offline sessions are
+// read only and do not persist context configurations.
+func (k *K9s) SetOffline(b bool) {
+	k.mx.Lock()
+	defer k.mx.Unlock()
+
+	k.offline = b
+}
+
+// IsOffline checks if the session is browsing a snapshot.
+func (k *K9s) IsOffline() bool {
+	k.mx.RLock()
+	defer k.mx.RUnlock()
+
+	return k.offline
+}
+
 // Save saves the k9s config to disk.
 func (k *K9s) Save(force bool) error {
+	if k.IsOffline() {
+		return nil
+	}
 	if k.getActiveConfig() == nil {
 		log.Warn().Msgf("Save failed. no active config detected")
 		return nil
@@ -99,10 +130,15 @@ func (k *K9s) Merge(k1 *K9s) {
 	k.MaxConnRetry = k1.MaxConnRetry
 	k.ReadOnly = k1.ReadOnly
 	k.NoExitOnCtrlC = k1.NoExitOnCtrlC
+	k.RestoreSession = k1.RestoreSession
 	k.UI = k1.UI
 	k.SkipLatestRevCheck = k1.SkipLatestRevCheck
 	k.DisablePodCounting = k1.DisablePodCounting
 	k.ShellPod = k1.ShellPod
+	k.DebugContainer = k1.DebugContainer
+	k.RightSizing = k1.RightSizing
+	k.LargeResources = k1.LargeResources
+	k.Snapshot = k1.Snapshot
 	k.Logger = k1.Logger
 	k.ImageScans = k1.ImageScans
 	if k1.Thresholds != nil {
@@ -110,6 +146,11 @@ func (k *K9s) Merge(k1 *K9s) {
 	}
 }
 
+// HasManualCommand checks if a startup command was specified on the command line.
+func (k *K9s) HasManualCommand() bool {
+	return isStringSet(k.manualCommand)
+}
+
 // AppScreenDumpDir fetch screen dumps dir.
 func (k *K9s) AppScreenDumpDir() string {
 	d := k.ScreenDumpDir
@@ -129,6 +170,11 @@ func (k *K9s) ContextScreenDumpDir() string {
 	return filepath.Join(k.AppScreenDumpDir(), k.contextPath())
 }
 
+// ContextUsagePath returns the context specific container usage samples file.
+func (k *K9s) ContextUsagePath() string {
+	return filepath.Join(AppContextsDir, k.contextPath(), usageFile)
+}
+
 func (k *K9s) contextPath() string {
 	if k.getActiveConfig() == nil {
 		return "na"
@@ -210,7 +256,7 @@ func (k *K9s) ActivateContext(n string) (*data.Context, error) {
 		return nil, err
 	}
 
-	cfg, err := k.dir.Load(n, ct)
+	cfg, err := k.loadContext(n, ct)
 	if err != nil {
 		return nil, err
 	}
@@ -237,7 +283,7 @@ func (k *K9s) Reload() error {
 		return err
 	}
 
-	cfg, err := k.dir.Load(k.getActiveContextName(), ct)
+	cfg, err := k.loadContext(k.getActiveContextName(), ct)
 	if err != nil {
 		return err
 	}
@@ -247,6 +293,14 @@ func (k *K9s) Reload() error {
 	return nil
 }
 
+func (k *K9s) loadContext(n string, ct *api.Context) (*data.Config, error) {
+	if k.IsOffline() {
+		return data.NewConfig(ct), nil
+	}
+
+	return k.dir.Load(n, ct)
+}
+
 // Override overrides k9s config from cli args.
 func (k *K9s) Override(k9sFlags *Flags) {
 	if k9sFlags.RefreshRate != nil && *k9sFlags.RefreshRate != DefaultRefreshRate {
@@ -305,6 +359,9 @@ func (k *K9s) GetRefreshRate() int {
 
 // IsReadOnly returns the readonly setting.
 func (k *K9s) IsReadOnly() bool {
+	if k.IsOffline() {
+		return true
+	}
 	ro := k.ReadOnly
 	if cfg := k.getActiveConfig(); cfg != nil && cfg.Context.ReadOnly != nil {
 		ro = *cfg.Context.ReadOnly
@@ -331,6 +388,10 @@ func (k *K9s) Validate(c client.Connection, ks data.KubeSettings) {
 		}
 	}
 	k.ShellPod = k.ShellPod.Validate()
+	k.DebugContainer = k.DebugContainer.Validate()
+	k.RightSizing = k.RightSizing.Validate()
+	k.LargeResources = k.LargeResources.Validate()
+	k.Snapshot = k.Snapshot.Validate()
 	k.Logger = k.Logger.Validate()
 	k.Thresholds = k.Thresholds.Validate()
 
@@ -86,6 +86,15 @@ func Test_k9sOverrides(t *testing.T) {
 			ll:   true,
 			cl:   true,
 		},
+		"offline": {
+			k: &K9s{
+				RefreshRate: 10,
+				ReadOnly:    false,
+				offline:     true,
+			},
+			rate: 10,
+			ro:   true,
+		},
 	}
 
 	for k := range uu {
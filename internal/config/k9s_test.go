@@ -5,6 +5,7 @@ package config_test
 
 import (
 	"errors"
+	"os"
 	"testing"
 
 	"github.com/derailed/k9s/internal/config"
@@ -60,6 +61,33 @@ func TestK9sReload(t *testing.T) {
 	}
 }
 
+func TestK9sOffline(t *testing.T) {
+	dir := config.AppContextsDir
+	config.AppContextsDir = t.TempDir()
+	defer func() { config.AppContextsDir = dir }()
+
+	cl, ct := "cl-1", "ct-1-1"
+	k := config.NewK9s(
+		mock.NewMockConnection(),
+		mock.NewMockKubeSettings(&genericclioptions.ConfigFlags{
+			ClusterName: &cl,
+			Context:     &ct,
+		}),
+	)
+	k.SetOffline(true)
+
+	c, err := k.ActivateContext(ct)
+	assert.NoError(t, err)
+	assert.Equal(t, cl, c.ClusterName)
+	assert.NoError(t, k.Reload())
+	assert.NoError(t, k.Save(true))
+	assert.True(t, k.IsReadOnly())
+
+	ee, err := os.ReadDir(config.AppContextsDir)
+	assert.NoError(t, err)
+	assert.Empty(t, ee)
+}
+
 func TestK9sMerge(t *testing.T) {
 	cl, ct := "cl-1", "ct-1-1"
 
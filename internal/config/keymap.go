@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// GlobalKeyScope represents key bindings applying to all views.
+	GlobalKeyScope = "global"
+
+	// TableKeyScope represents key bindings applying to all resource views.
+	TableKeyScope = "table"
+)
+
+// KeyMap represents a collection of built-in actions key bindings.
+type KeyMap struct {
+	Bindings map[string]string `yaml:"keyMap"`
+}
+
+// KeyBinding represents a built-in action key binding.
+type KeyBinding struct {
+	Scope  string
+	Action string
+	Keys   []string
+}
+
+// NewKeyMap returns a new key map.
+func NewKeyMap() KeyMap {
+	return KeyMap{
+		Bindings: make(map[string]string),
+	}
+}
+
+// Load loads the global and context specific key maps.
+func (k KeyMap) Load(path string) error {
+	if err := k.LoadKeyMap(AppKeyMapFile); err != nil {
+		return err
+	}
+	if err := k.LoadKeyMap(path); err != nil {
+		return err
+	}
+
+	return k.Validate()
+}
+
+// LoadKeyMap loads key bindings from a given file.
+func (k KeyMap) LoadKeyMap(path string) error {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := data.JSONValidator.Validate(json.KeyMapSchema, bb); err != nil {
+		return fmt.Errorf("validation failed for %q: %w", path, err)
+	}
+
+	var km KeyMap
+	if err := yaml.Unmarshal(bb, &km); err != nil {
+		return err
+	}
+	for id, keys := range km.Bindings {
+		k.Bindings[id] = keys
+	}
+
+	return nil
+}
+
+// Validate checks key bindings for conflicts.
+func (k KeyMap) Validate() error {
+	bb := k.all()
+
+	var errs error
+	for i := 0; i < len(bb); i++ {
+		for j := i + 1; j < len(bb); j++ {
+			if !bb[i].overlaps(bb[j]) {
+				continue
+			}
+			errs = errors.Join(errs, fmt.Errorf("key binding conflict %q between %q and %q",
+				strings.Join(bb[i].Keys, " "), bb[i].ID(), bb[j].ID()))
+		}
+	}
+
+	return errs
+}
+
+// For returns the key bindings applying to the given scopes.
+// A binding for a given scope overrides a global binding for the same action.
+func (k KeyMap) For(scopes map[string]struct{}) []KeyBinding {
+	bb := make([]KeyBinding, 0, len(k.Bindings))
+	for _, b := range k.all() {
+		if b.Scope == GlobalKeyScope {
+			continue
+		}
+		if _, ok := scopes[b.Scope]; ok {
+			bb = append(bb, b)
+		}
+	}
+	for _, b := range k.all() {
+		if b.Scope != GlobalKeyScope {
+			continue
+		}
+		if !slices.ContainsFunc(bb, func(o KeyBinding) bool { return o.Action == b.Action }) {
+			bb = append(bb, b)
+		}
+	}
+
+	return bb
+}
+
+func (k KeyMap) all() []KeyBinding {
+	ids := make([]string, 0, len(k.Bindings))
+	for id := range k.Bindings {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	bb := make([]KeyBinding, 0, len(ids))
+	for _, id := range ids {
+		bb = append(bb, NewKeyBinding(id, k.Bindings[id]))
+	}
+
+	return bb
+}
+
+// NewKeyBinding returns a new key binding given an action id and a key sequence.
+func NewKeyBinding(id, keys string) KeyBinding {
+	var b KeyBinding
+	if i := strings.LastIndex(id, "."); i >= 0 {
+		b.Scope, b.Action = id[:i], id[i+1:]
+	} else {
+		b.Scope, b.Action = GlobalKeyScope, id
+	}
+	b.Keys = strings.Fields(keys)
+
+	return b
+}
+
+// ID returns the binding action id.
+func (b KeyBinding) ID() string {
+	return b.Scope + "." + b.Action
+}
+
+// IsChord checks if the binding requires several keys.
+func (b KeyBinding) IsChord() bool {
+	return len(b.Keys) > 1
+}
+
+// overlaps checks if two bindings key sequences collide in a shared scope.
+func (b KeyBinding) overlaps(o KeyBinding) bool {
+	if b.Scope != o.Scope && b.Scope != GlobalKeyScope && o.Scope != GlobalKeyScope {
+		return false
+	}
+	if b.Scope != o.Scope && b.Action == o.Action {
+		return false
+	}
+	n := min(len(b.Keys), len(o.Keys))
+	if n == 0 {
+		return false
+	}
+
+	return slices.Equal(b.Keys[:n], o.Keys[:n])
+}
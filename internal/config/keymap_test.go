@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyMapLoad(t *testing.T) {
+	k := config.NewKeyMap()
+	assert.NoError(t, k.LoadKeyMap("testdata/keymap/keymap.yaml"))
+	assert.NoError(t, k.Validate())
+
+	assert.Equal(t, 5, len(k.Bindings))
+	assert.Equal(t, "Shift-S", k.Bindings["pod.shell"])
+}
+
+func TestKeyMapValidate(t *testing.T) {
+	k := config.NewKeyMap()
+	assert.NoError(t, k.LoadKeyMap("testdata/keymap/conflict.yaml"))
+
+	err := k.Validate()
+	assert.Equal(t, `key binding conflict "Shift-X" between "deploy.scale" and "global.describe"
+key binding conflict "Shift-S l" between "pod.logs" and "pod.shell"`, err.Error())
+}
+
+func TestKeyMapFor(t *testing.T) {
+	k := config.NewKeyMap()
+	assert.NoError(t, k.LoadKeyMap("testdata/keymap/keymap.yaml"))
+
+	uu := map[string]struct {
+		scopes map[string]struct{}
+		e      []config.KeyBinding
+	}{
+		"none": {
+			e: []config.KeyBinding{
+				{Scope: "global", Action: "describe", Keys: []string{"Ctrl-G", "d"}},
+			},
+		},
+		"pod": {
+			scopes: map[string]struct{}{"po": {}, "pod": {}, "table": {}},
+			e: []config.KeyBinding{
+				{Scope: "pod", Action: "describe", Keys: []string{"Shift-D"}},
+				{Scope: "pod", Action: "shell", Keys: []string{"Shift-S"}},
+				{Scope: "table", Action: "sortName", Keys: []string{"Shift-X"}},
+			},
+		},
+		"log": {
+			scopes: map[string]struct{}{"log": {}},
+			e: []config.KeyBinding{
+				{Scope: "log", Action: "toggleWrap", Keys: []string{"Ctrl-W"}},
+				{Scope: "global", Action: "describe", Keys: []string{"Ctrl-G", "d"}},
+			},
+		},
+	}
+
+	for k1 := range uu {
+		u := uu[k1]
+		t.Run(k1, func(t *testing.T) {
+			assert.Equal(t, u.e, k.For(u.scopes))
+		})
+	}
+}
+
+func TestNewKeyBinding(t *testing.T) {
+	uu := map[string]struct {
+		id, keys string
+		e        config.KeyBinding
+		chord    bool
+	}{
+		"plain": {
+			id:   "pod.shell",
+			keys: "s",
+			e:    config.KeyBinding{Scope: "pod", Action: "shell", Keys: []string{"s"}},
+		},
+		"global": {
+			id:   "describe",
+			keys: "d",
+			e:    config.KeyBinding{Scope: "global", Action: "describe", Keys: []string{"d"}},
+		},
+		"crd": {
+			id:    "foo.acme.io.sortName",
+			keys:  "Ctrl-X  n",
+			e:     config.KeyBinding{Scope: "foo.acme.io", Action: "sortName", Keys: []string{"Ctrl-X", "n"}},
+			chord: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			b := config.NewKeyBinding(u.id, u.keys)
+			assert.Equal(t, u.e, b)
+			assert.Equal(t, u.chord, b.IsChord())
+		})
+	}
+}
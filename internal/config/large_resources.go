@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"slices"
+	"time"
+)
+
+const (
+	defaultMaxItems = 5_000
+	defaultPageSize = 500
+	defaultResync   = 30
+)
+
+// LargeResources represents the adaptive listing configuration for very large resources.
+// Resources exceeding MaxItems are listed via server side tables in chunks of PageSize
+// every ResyncInterval seconds rather than cached by informers.
+type LargeResources struct {
+	Enable         bool     `json:"enable" yaml:"enable"`
+	MaxItems       int      `json:"maxItems" yaml:"maxItems"`
+	PageSize       int      `json:"pageSize" yaml:"pageSize"`
+	ResyncInterval int      `json:"resyncInterval" yaml:"resyncInterval"`
+	GVRs           []string `json:"gvrs,omitempty" yaml:"gvrs,omitempty"`
+}
+
+// NewLargeResources returns a new instance.
+func NewLargeResources() LargeResources {
+	return LargeResources{
+		MaxItems:       defaultMaxItems,
+		PageSize:       defaultPageSize,
+		ResyncInterval: defaultResync,
+	}
+}
+
+// Validate validates the configuration.
+func (l LargeResources) Validate() LargeResources {
+	if l.MaxItems <= 0 {
+		l.MaxItems = defaultMaxItems
+	}
+	if l.PageSize <= 0 {
+		l.PageSize = defaultPageSize
+	}
+	if l.ResyncInterval <= 0 {
+		l.ResyncInterval = defaultResync
+	}
+
+	return l
+}
+
+// Resync returns how often large resources are listed.
+func (l LargeResources) Resync() time.Duration {
+	return time.Duration(l.ResyncInterval) * time.Second
+}
+
+// IsForced checks if a resource must always be listed in chunks.
+func (l LargeResources) IsForced(gvr string) bool {
+	return slices.Contains(l.GVRs, gvr)
+}
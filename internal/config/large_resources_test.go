@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLargeResourcesValidate(t *testing.T) {
+	uu := map[string]struct {
+		l, e config.LargeResources
+	}{
+		"default": {
+			l: config.NewLargeResources(),
+			e: config.NewLargeResources(),
+		},
+		"empty": {
+			e: config.NewLargeResources(),
+		},
+		"negative": {
+			l: config.LargeResources{Enable: true, MaxItems: -1, PageSize: -10, ResyncInterval: -1},
+			e: config.LargeResources{Enable: true, MaxItems: 5_000, PageSize: 500, ResyncInterval: 30},
+		},
+		"custom": {
+			l: config.LargeResources{Enable: true, MaxItems: 100, PageSize: 10, ResyncInterval: 60},
+			e: config.LargeResources{Enable: true, MaxItems: 100, PageSize: 10, ResyncInterval: 60},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.l.Validate())
+		})
+	}
+}
+
+func TestLargeResourcesIsForced(t *testing.T) {
+	uu := map[string]struct {
+		l   config.LargeResources
+		gvr string
+		e   bool
+	}{
+		"empty": {
+			l:   config.NewLargeResources(),
+			gvr: "v1/events",
+		},
+		"forced": {
+			l:   config.LargeResources{GVRs: []string{"v1/events", "v1/pods"}},
+			gvr: "v1/pods",
+			e:   true,
+		},
+		"not-forced": {
+			l:   config.LargeResources{GVRs: []string{"v1/events"}},
+			gvr: "v1/pods",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.l.IsForced(u.gvr))
+		})
+	}
+}
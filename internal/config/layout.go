@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// SplitHorizontal stacks panes on top of each other.
+	SplitHorizontal = "horizontal"
+
+	// SplitVertical lays out panes side by side.
+	SplitVertical = "vertical"
+)
+
+// Layout represents a saved workspace layout.
+type Layout struct {
+	ActiveTab int         `yaml:"activeTab"`
+	Tabs      []LayoutTab `yaml:"tabs"`
+}
+
+// LayoutTab represents a tab of split panes.
+type LayoutTab struct {
+	Split      string       `yaml:"split,omitempty"`
+	ActivePane int          `yaml:"activePane"`
+	Panes      []LayoutPane `yaml:"panes"`
+}
+
+// LayoutPane represents a pane resource view.
+type LayoutPane struct {
+	Command   string `yaml:"command"`
+	Namespace string `yaml:"namespace,omitempty"`
+}
+
+// NewLayout returns a new layout.
+func NewLayout() *Layout {
+	return &Layout{}
+}
+
+// IsEmpty checks if the layout has any tabs.
+func (l *Layout) IsEmpty() bool {
+	return len(l.Tabs) == 0
+}
+
+// Load loads a layout from a given file.
+func (l *Layout) Load(path string) error {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := data.JSONValidator.Validate(json.LayoutSchema, bb); err != nil {
+		return fmt.Errorf("validation failed for %q: %w", path, err)
+	}
+
+	var ll Layout
+	if err := yaml.Unmarshal(bb, &ll); err != nil {
+		return err
+	}
+	*l = ll
+	l.ensureActive()
+
+	return nil
+}
+
+// Save saves the layout to a given file.
+func (l *Layout) Save(path string) error {
+	if err := data.EnsureDirPath(path, data.DefaultDirMod); err != nil {
+		return err
+	}
+	bb, err := yaml.Marshal(l)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bb, data.DefaultFileMod)
+}
+
+func (l *Layout) ensureActive() {
+	if l.ActiveTab < 0 || l.ActiveTab >= len(l.Tabs) {
+		l.ActiveTab = 0
+	}
+	for i := range l.Tabs {
+		t := &l.Tabs[i]
+		if t.ActivePane < 0 || t.ActivePane >= len(t.Panes) {
+			t.ActivePane = 0
+		}
+	}
+}
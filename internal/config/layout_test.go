@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLayoutLoad(t *testing.T) {
+	l := config.NewLayout()
+	assert.NoError(t, l.Load("testdata/layout/layout.yaml"))
+
+	assert.Equal(t, 1, l.ActiveTab)
+	assert.Equal(t, 2, len(l.Tabs))
+	assert.Equal(t, config.SplitHorizontal, l.Tabs[0].Split)
+	assert.Equal(t, []config.LayoutPane{
+		{Command: "pods", Namespace: "default"},
+		{Command: "events"},
+	}, l.Tabs[0].Panes)
+	assert.Equal(t, "deploy kube-system", l.Tabs[1].Panes[0].Command)
+}
+
+func TestLayoutLoadMissing(t *testing.T) {
+	l := config.NewLayout()
+	assert.Error(t, l.Load("testdata/layout/blee.yaml"))
+	assert.True(t, l.IsEmpty())
+}
+
+func TestLayoutSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ctx", "layout.yaml")
+	l := config.Layout{
+		ActiveTab: 3,
+		Tabs: []config.LayoutTab{
+			{
+				Split:      config.SplitVertical,
+				ActivePane: 1,
+				Panes: []config.LayoutPane{
+					{Command: "pods", Namespace: "ns1"},
+					{Command: "events", Namespace: "ns1"},
+				},
+			},
+		},
+	}
+	assert.NoError(t, l.Save(path))
+
+	l1 := config.NewLayout()
+	assert.NoError(t, l1.Load(path))
+	assert.Equal(t, 0, l1.ActiveTab)
+	assert.Equal(t, l.Tabs, l1.Tabs)
+}
@@ -14,7 +14,7 @@ import (
 	"github.com/derailed/k9s/internal/config"
 	version "k8s.io/apimachinery/pkg/version"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
-	disk "k8s.io/client-go/discovery/cached/disk"
+	"k8s.io/client-go/discovery"
 	dynamic "k8s.io/client-go/dynamic"
 	kubernetes "k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
@@ -135,7 +135,7 @@ func (m mockConnection) DialLogs() (kubernetes.Interface, error) {
 func (m mockConnection) SwitchContext(ctx string) error {
 	return nil
 }
-func (m mockConnection) CachedDiscovery() (*disk.CachedDiscoveryClient, error) {
+func (m mockConnection) CachedDiscovery() (discovery.CachedDiscoveryInterface, error) {
 	return nil, nil
 }
 func (m mockConnection) RestConfig() (*restclient.Config, error) {
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"gopkg.in/yaml.v2"
+)
+
+// Queries represents a collection of named queries.
+type Queries struct {
+	Query map[string]Query `yaml:"queries"`
+}
+
+// Query describes a saved resource view query.
+type Query struct {
+	Command     string `yaml:"command"`
+	Namespace   string `yaml:"namespace,omitempty"`
+	Filter      string `yaml:"filter,omitempty"`
+	Labels      string `yaml:"labels,omitempty"`
+	SortColumn  string `yaml:"sortColumn,omitempty"`
+	ShortCut    string `yaml:"shortCut,omitempty"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// NewQueries returns a new query collection.
+func NewQueries() Queries {
+	return Queries{
+		Query: make(map[string]Query),
+	}
+}
+
+// Line returns the query prompt command.
+func (q Query) Line() string {
+	ss := []string{q.Command}
+	if q.Namespace != "" {
+		ss = append(ss, q.Namespace)
+	}
+
+	return strings.Join(ss, " ")
+}
+
+// SortCol returns the query sort column and direction if any.
+func (q Query) SortCol() (string, bool, bool) {
+	if q.SortColumn == "" {
+		return "", false, false
+	}
+	name, dir, _ := strings.Cut(q.SortColumn, ":")
+
+	return name, dir != "desc", true
+}
+
+// Load loads the shared queries and the context specific ones if any.
+// Context queries override shared ones with the same name.
+func (q Queries) Load(path string) error {
+	if err := q.LoadQueries(AppQueriesFile); err != nil {
+		return err
+	}
+
+	return q.LoadQueries(path)
+}
+
+// LoadQueries loads queries from a given file.
+func (q Queries) LoadQueries(path string) error {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := data.JSONValidator.Validate(json.QueriesSchema, bb); err != nil {
+		return fmt.Errorf("validation failed for %q: %w", path, err)
+	}
+
+	var qq Queries
+	if err := yaml.Unmarshal(bb, &qq); err != nil {
+		return err
+	}
+	for k, v := range qq.Query {
+		q.Query[k] = v
+	}
+
+	return nil
+}
+
+// SaveQuery adds or updates a named query in the given file.
+func SaveQuery(path, name string, q Query) error {
+	qq := NewQueries()
+	if err := qq.LoadQueries(path); err != nil {
+		return err
+	}
+	qq.Query[name] = q
+
+	return qq.save(path)
+}
+
+// DeleteQuery removes a named query from the given file.
+func DeleteQuery(path, name string) error {
+	qq := NewQueries()
+	if err := qq.LoadQueries(path); err != nil {
+		return err
+	}
+	if _, ok := qq.Query[name]; !ok {
+		return fmt.Errorf("no query named %q found in %s", name, path)
+	}
+	delete(qq.Query, name)
+
+	return qq.save(path)
+}
+
+func (q Queries) save(path string) error {
+	if err := data.EnsureDirPath(path, data.DefaultDirMod); err != nil {
+		return err
+	}
+	bb, err := yaml.Marshal(q)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bb, data.DefaultFileMod)
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryLoad(t *testing.T) {
+	qq := config.NewQueries()
+	assert.NoError(t, qq.LoadQueries("testdata/queries/queries.yaml"))
+
+	assert.Equal(t, 1, len(qq.Query))
+	q, ok := qq.Query["crashers"]
+	assert.True(t, ok)
+	assert.Equal(t, "pods prod", q.Line())
+	assert.Equal(t, "CrashLoop", q.Filter)
+	assert.Equal(t, "Shift-1", q.ShortCut)
+}
+
+func TestQuerySortCol(t *testing.T) {
+	uu := map[string]struct {
+		s        string
+		name     string
+		asc, set bool
+	}{
+		"none": {},
+		"asc": {
+			s:    "NAME:asc",
+			name: "NAME",
+			asc:  true,
+			set:  true,
+		},
+		"desc": {
+			s:    "RESTARTS:desc",
+			name: "RESTARTS",
+			set:  true,
+		},
+		"no-dir": {
+			s:    "AGE",
+			name: "AGE",
+			asc:  true,
+			set:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			name, asc, ok := config.Query{SortColumn: u.s}.SortCol()
+			assert.Equal(t, u.set, ok)
+			assert.Equal(t, u.name, name)
+			assert.Equal(t, u.asc, asc)
+		})
+	}
+}
+
+func TestQuerySaveDelete(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queries.yaml")
+	q := config.Query{Command: "v1/pods", Namespace: "fred", Labels: "app=blee"}
+
+	assert.NoError(t, config.SaveQuery(path, "blee", q))
+	assert.NoError(t, config.SaveQuery(path, "duh", config.Query{Command: "dp"}))
+	qq := config.NewQueries()
+	assert.NoError(t, qq.LoadQueries(path))
+	assert.Equal(t, 2, len(qq.Query))
+	assert.Equal(t, q, qq.Query["blee"])
+
+	assert.NoError(t, config.DeleteQuery(path, "blee"))
+	assert.Error(t, config.DeleteQuery(path, "blee"))
+	qq = config.NewQueries()
+	assert.NoError(t, qq.LoadQueries(path))
+	assert.Equal(t, 1, len(qq.Query))
+}
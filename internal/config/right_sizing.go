@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import "time"
+
+const (
+	defaultSampleInterval = 30
+	defaultRetention      = "1h"
+	usageFile             = "usage.json"
+)
+
+// RightSizing represents the container usage sampler configuration.
+type RightSizing struct {
+	Enable         bool   `json:"enable" yaml:"enable"`
+	SampleInterval int    `json:"sampleInterval" yaml:"sampleInterval"`
+	Retention      string `json:"retention" yaml:"retention"`
+	Persist        bool   `json:"persist" yaml:"persist"`
+}
+
+// NewRightSizing returns a new instance.
+func NewRightSizing() RightSizing {
+	return RightSizing{
+		Enable:         true,
+		SampleInterval: defaultSampleInterval,
+		Retention:      defaultRetention,
+	}
+}
+
+// Validate validates the configuration.
+func (r RightSizing) Validate() RightSizing {
+	if r.SampleInterval <= 0 {
+		r.SampleInterval = defaultSampleInterval
+	}
+	if d, err := time.ParseDuration(r.Retention); err != nil || d <= 0 {
+		r.Retention = defaultRetention
+	}
+
+	return r
+}
+
+// Interval returns the sampling interval.
+func (r RightSizing) Interval() time.Duration {
+	return time.Duration(r.SampleInterval) * time.Second
+}
+
+// RetentionDuration returns how long samples are retained.
+func (r RightSizing) RetentionDuration() time.Duration {
+	d, err := time.ParseDuration(r.Retention)
+	if err != nil || d <= 0 {
+		d, _ = time.ParseDuration(defaultRetention)
+	}
+
+	return d
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"gopkg.in/yaml.v2"
+)
+
+// Session tracks a context navigation session.
+type Session struct {
+	Pages []SessionPage `yaml:"pages"`
+}
+
+// SessionPage tracks a resource view state.
+type SessionPage struct {
+	Command    string `yaml:"command,omitempty"`
+	GVR        string `yaml:"gvr"`
+	Path       string `yaml:"path,omitempty"`
+	Labels     string `yaml:"labels,omitempty"`
+	Fields     string `yaml:"fields,omitempty"`
+	Filter     string `yaml:"filter,omitempty"`
+	SortColumn string `yaml:"sortColumn,omitempty"`
+	Selected   string `yaml:"selected,omitempty"`
+}
+
+// NewSession returns a new session.
+func NewSession() *Session {
+	return &Session{}
+}
+
+// IsEmpty checks if the session has any pages.
+func (s *Session) IsEmpty() bool {
+	return len(s.Pages) == 0
+}
+
+// SortCol returns the page sort column and direction if any.
+func (p SessionPage) SortCol() (string, bool, bool) {
+	if p.SortColumn == "" {
+		return "", false, false
+	}
+	name, dir, _ := strings.Cut(p.SortColumn, ":")
+
+	return name, dir != "desc", true
+}
+
+// SetSortCol sets the page sort column and direction.
+func (p *SessionPage) SetSortCol(name string, asc bool) {
+	if name == "" {
+		p.SortColumn = ""
+		return
+	}
+	dir := "asc"
+	if !asc {
+		dir = "desc"
+	}
+	p.SortColumn = name + ":" + dir
+}
+
+// Load loads a session from a given file.
+func (s *Session) Load(path string) error {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := data.JSONValidator.Validate(json.SessionSchema, bb); err != nil {
+		return fmt.Errorf("validation failed for %q: %w", path, err)
+	}
+
+	var ss Session
+	if err := yaml.Unmarshal(bb, &ss); err != nil {
+		return err
+	}
+	*s = ss
+
+	return nil
+}
+
+// Save saves the session to a given file.
+func (s *Session) Save(path string) error {
+	if err := data.EnsureDirPath(path, data.DefaultDirMod); err != nil {
+		return err
+	}
+	bb, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bb, data.DefaultFileMod)
+}
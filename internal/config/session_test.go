@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionLoad(t *testing.T) {
+	s := config.NewSession()
+	assert.NoError(t, s.Load("testdata/session/session.yaml"))
+
+	assert.Equal(t, 2, len(s.Pages))
+	assert.Equal(t, "deploy fred", s.Pages[0].Command)
+	assert.Equal(t, "apps/v1/deployments", s.Pages[0].GVR)
+	name, asc, ok := s.Pages[0].SortCol()
+	assert.True(t, ok)
+	assert.False(t, asc)
+	assert.Equal(t, "AGE", name)
+	assert.Equal(t, config.SessionPage{
+		GVR:      "v1/pods",
+		Path:     "fred/nginx",
+		Labels:   "app=nginx",
+		Filter:   "app=nginx",
+		Selected: "fred/nginx-123",
+	}, s.Pages[1])
+}
+
+func TestSessionLoadMissing(t *testing.T) {
+	s := config.NewSession()
+	assert.Error(t, s.Load("testdata/session/blee.yaml"))
+	assert.True(t, s.IsEmpty())
+}
+
+func TestSessionSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ctx", "session.yaml")
+	s := config.NewSession()
+	p := config.SessionPage{Command: "po", GVR: "v1/pods", Filter: "fred"}
+	p.SetSortCol("NAME", false)
+	s.Pages = append(s.Pages, p)
+	assert.NoError(t, s.Save(path))
+
+	s1 := config.NewSession()
+	assert.NoError(t, s1.Load(path))
+	assert.Equal(t, s, s1)
+}
+
+func TestSessionPageSetSortCol(t *testing.T) {
+	uu := map[string]struct {
+		name string
+		asc  bool
+		e    string
+	}{
+		"none": {},
+		"asc": {
+			name: "NAME",
+			asc:  true,
+			e:    "NAME:asc",
+		},
+		"desc": {
+			name: "AGE",
+			e:    "AGE:desc",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var p config.SessionPage
+			p.SetSortCol(u.name, u.asc)
+			assert.Equal(t, u.e, p.SortColumn)
+		})
+	}
+}
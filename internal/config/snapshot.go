@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+const defaultLogLines = 200
+
+// Snapshot represents the cluster snapshot capture configuration.
+type Snapshot struct {
+	GVRs           []string `json:"gvrs,omitempty" yaml:"gvrs,omitempty"`
+	LogLines       int      `json:"logLines" yaml:"logLines"`
+	IncludeSecrets bool     `json:"includeSecrets" yaml:"includeSecrets"`
+}
+
+// NewSnapshot returns a new instance.
+func NewSnapshot() Snapshot {
+	return Snapshot{
+		LogLines: defaultLogLines,
+	}
+}
+
+// Validate validates the configuration.
+func (s Snapshot) Validate() Snapshot {
+	if s.LogLines <= 0 {
+		s.LogLines = defaultLogLines
+	}
+
+	return s
+}
@@ -34,4 +34,7 @@ type UI struct {
 
 	// DefaultsToFullScreen toggles fullscreen on views like logs, yaml, details.
 	DefaultsToFullScreen bool `json:"defaultsToFullScreen" yaml:"defaultsToFullScreen"`
+
+	// InlineEditor toggles the built-in yaml editor in lieu of $EDITOR.
+	InlineEditor bool `json:"inlineEditor" yaml:"inlineEditor"`
 }
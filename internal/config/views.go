@@ -24,10 +24,105 @@ type ViewConfigListener interface {
 	ViewSettingsChanged(ViewSetting)
 }
 
+const (
+	colExprSep = ":"
+	colHintSep = "|"
+)
+
+// ColumnSpec represents a view column definition. A column either references
+// an existing resource column by name or defines a custom column computed from
+// the raw resource using a JSONPath or CEL expression ie NAME:expr|hints.
+// JSONPath expressions start with a `.` or `{`, any other expression is CEL.
+type ColumnSpec struct {
+	Name     string
+	Expr     string
+	Number   bool
+	Duration bool
+	Capacity bool
+}
+
+// ParseColumnSpec parses a column definition. Sorting hints are one of
+// N(number), D(duration) or C(capacity).
+func ParseColumnSpec(s string) ColumnSpec {
+	name, expr, ok := strings.Cut(s, colExprSep)
+	spec := ColumnSpec{Name: strings.TrimSpace(name)}
+	if !ok {
+		return spec
+	}
+	if i := strings.LastIndex(expr, colHintSep); i >= 0 && isColHints(expr[i+1:]) {
+		for _, h := range expr[i+1:] {
+			switch h {
+			case 'N':
+				spec.Number = true
+			case 'D':
+				spec.Duration = true
+			case 'C':
+				spec.Capacity = true
+			}
+		}
+		expr = expr[:i]
+	}
+	spec.Expr = strings.TrimSpace(expr)
+
+	return spec
+}
+
+// IsCustom returns true if the column is computed from an expression.
+func (c ColumnSpec) IsCustom() bool {
+	return c.Expr != ""
+}
+
+// IsJSONPath returns true if the column expression is a JSONPath expression.
+func (c ColumnSpec) IsJSONPath() bool {
+	return strings.HasPrefix(c.Expr, ".") || strings.HasPrefix(c.Expr, "{")
+}
+
+func isColHints(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !strings.ContainsRune("NDC", r) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // ViewSetting represents a view configuration.
 type ViewSetting struct {
-	Columns    []string `yaml:"columns"`
-	SortColumn string   `yaml:"sortColumn"`
+	Columns    []string    `yaml:"columns"`
+	SortColumn string      `yaml:"sortColumn"`
+	ColorRules []ColorRule `yaml:"colorRules"`
+}
+
+// ColumnNames returns the view column names.
+func (v *ViewSetting) ColumnNames() []string {
+	if v == nil {
+		return nil
+	}
+	cc := make([]string, 0, len(v.Columns))
+	for _, c := range v.Columns {
+		cc = append(cc, ParseColumnSpec(c).Name)
+	}
+
+	return cc
+}
+
+// CustomColumns returns the view columns computed from expressions.
+func (v *ViewSetting) CustomColumns() []ColumnSpec {
+	if v == nil {
+		return nil
+	}
+	var cc []ColumnSpec
+	for _, c := range v.Columns {
+		if spec := ParseColumnSpec(c); spec.IsCustom() {
+			cc = append(cc, spec)
+		}
+	}
+
+	return cc
 }
 
 func (v *ViewSetting) HasCols() bool {
@@ -57,6 +152,9 @@ func (v *ViewSetting) Equals(vs *ViewSetting) bool {
 	if c := slices.Compare(v.Columns, vs.Columns); c != 0 {
 		return false
 	}
+	if !slices.EqualFunc(v.ColorRules, vs.ColorRules, ColorRule.Equal) {
+		return false
+	}
 	return cmp.Compare(v.SortColumn, vs.SortColumn) == 0
 }
 
@@ -97,6 +195,13 @@ func (v *CustomView) Load(path string) error {
 	if err := yaml.Unmarshal(bb, &in); err != nil {
 		return err
 	}
+	for gvr, vs := range in.Views {
+		for i := range vs.ColorRules {
+			if err := vs.ColorRules[i].Validate(); err != nil {
+				return fmt.Errorf("view %q: %w", gvr, err)
+			}
+		}
+	}
 	v.Views = in.Views
 	v.fireConfigChanged()
 
@@ -14,8 +14,11 @@ func TestViewSettingsLoad(t *testing.T) {
 	cfg := config.NewCustomView()
 
 	assert.Nil(t, cfg.Load("testdata/views/views.yaml"))
-	assert.Equal(t, 1, len(cfg.Views))
+	assert.Equal(t, 2, len(cfg.Views))
 	assert.Equal(t, 4, len(cfg.Views["v1/pods"].Columns))
+	assert.Equal(t, 2, len(cfg.Views["v1/pods"].ColorRules))
+	assert.Equal(t, 1, len(cfg.Views["v1/nodes"].ColorRules))
+	assert.Empty(t, cfg.Views["v1/nodes"].Columns)
 }
 
 func TestViewSetting_Equals(t *testing.T) {
@@ -32,9 +35,72 @@ func TestViewSetting_Equals(t *testing.T) {
 		{&config.ViewSetting{Columns: []string{"A"}}, &config.ViewSetting{Columns: []string{"B"}}, false},
 		{&config.ViewSetting{SortColumn: "A"}, &config.ViewSetting{SortColumn: "B"}, false},
 		{&config.ViewSetting{SortColumn: "A"}, &config.ViewSetting{SortColumn: "A"}, true},
+		{&config.ViewSetting{ColorRules: []config.ColorRule{{Column: "A", Color: "red"}}}, &config.ViewSetting{}, false},
+		{&config.ViewSetting{ColorRules: []config.ColorRule{{Column: "A", Color: "red"}}}, &config.ViewSetting{ColorRules: []config.ColorRule{{Column: "A", Color: "red"}}}, true},
+		{&config.ViewSetting{ColorRules: []config.ColorRule{{Column: "A", Color: "red"}}}, &config.ViewSetting{ColorRules: []config.ColorRule{{Column: "A", Color: "blue"}}}, false},
 	}
 
 	for _, tt := range tests {
 		assert.Equalf(t, tt.equals, tt.v1.Equals(tt.v2), "%#v and %#v", tt.v1, tt.v2)
 	}
 }
+
+func TestParseColumnSpec(t *testing.T) {
+	uu := map[string]struct {
+		s string
+		e config.ColumnSpec
+	}{
+		"plain": {
+			s: "NAME",
+			e: config.ColumnSpec{Name: "NAME"},
+		},
+		"jsonpath": {
+			s: "IMAGE:.spec.containers[0].image",
+			e: config.ColumnSpec{Name: "IMAGE", Expr: ".spec.containers[0].image"},
+		},
+		"cel": {
+			s: "TEAM:metadata.annotations['team']",
+			e: config.ColumnSpec{Name: "TEAM", Expr: "metadata.annotations['team']"},
+		},
+		"number": {
+			s: "COUNT:size(spec.containers)|N",
+			e: config.ColumnSpec{Name: "COUNT", Expr: "size(spec.containers)", Number: true},
+		},
+		"capacity": {
+			s: "MEM:.spec.containers[0].resources.requests.memory|C",
+			e: config.ColumnSpec{Name: "MEM", Expr: ".spec.containers[0].resources.requests.memory", Capacity: true},
+		},
+		"duration": {
+			s: "STARTED:.status.startTime|D",
+			e: config.ColumnSpec{Name: "STARTED", Expr: ".status.startTime", Duration: true},
+		},
+		"cel-or": {
+			s: "READY:status.phase == 'Running' || status.phase == 'Succeeded'",
+			e: config.ColumnSpec{Name: "READY", Expr: "status.phase == 'Running' || status.phase == 'Succeeded'"},
+		},
+		"cel-ternary": {
+			s: "QOS:has(status.qosClass) ? status.qosClass : 'n/a'",
+			e: config.ColumnSpec{Name: "QOS", Expr: "has(status.qosClass) ? status.qosClass : 'n/a'"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, config.ParseColumnSpec(u.s))
+		})
+	}
+}
+
+func TestViewSettingColumns(t *testing.T) {
+	vs := config.ViewSetting{
+		Columns: []string{"NAME", "IMAGE:.spec.containers[0].image", "TEAM:metadata.annotations['team']|N"},
+	}
+
+	assert.Equal(t, []string{"NAME", "IMAGE", "TEAM"}, vs.ColumnNames())
+	cc := vs.CustomColumns()
+	assert.Equal(t, 2, len(cc))
+	assert.True(t, cc[0].IsJSONPath())
+	assert.False(t, cc[1].IsJSONPath())
+	assert.True(t, cc[1].Number)
+}
@@ -6,6 +6,7 @@ package dao
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -46,6 +47,9 @@ func (c *Container) List(ctx context.Context, _ string) ([]runtime.Object, error
 	if err != nil {
 		return nil, err
 	}
+	if len(cmx) > 0 {
+		recordContainersUsage(fqn, po, cmx, time.Now())
+	}
 	res := make([]runtime.Object, 0, len(po.Spec.InitContainers)+len(po.Spec.Containers))
 	for _, co := range po.Spec.InitContainers {
 		res = append(res, makeContainerRes(co, po, cmx[co.Name], true))
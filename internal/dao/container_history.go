@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+const maxContainerSamples = 300
+
+// ContainerSample represents a container usage sample.
+type ContainerSample struct {
+	At       time.Time
+	CPU, MEM int64
+	Restarts int32
+}
+
+// Sample records the current usage of a pod containers.
+func (c *Container) Sample(ctx context.Context, fqn string) (*v1.Pod, error) {
+	po, err := c.fetchPod(fqn)
+	if err != nil {
+		return nil, err
+	}
+	cmx, err := client.DialMetrics(c.Client()).FetchContainersMetrics(ctx, fqn)
+	if err != nil {
+		return po, err
+	}
+	recordContainersUsage(fqn, po, cmx, time.Now())
+
+	return po, nil
+}
+
+// History returns a container usage samples collected during the session.
+func (c *Container) History(fqn, co string) []ContainerSample {
+	return containerUsage.get(containerKey(fqn, co))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func recordContainersUsage(fqn string, po *v1.Pod, cmx client.ContainersMetrics, at time.Time) {
+	for co, mx := range cmx {
+		containerUsage.add(containerKey(fqn, co), newContainerSample(po, co, mx, at))
+	}
+}
+
+func newContainerSample(po *v1.Pod, co string, mx *mv1beta1.ContainerMetrics, at time.Time) ContainerSample {
+	s := ContainerSample{
+		At:  at,
+		CPU: mx.Usage.Cpu().MilliValue(),
+		MEM: mx.Usage.Memory().Value(),
+	}
+	if st := getContainerStatus(co, po.Status); st != nil {
+		s.Restarts = st.RestartCount
+	}
+
+	return s
+}
+
+func containerKey(fqn, co string) string {
+	return fqn + ":" + co
+}
+
+var containerUsage = newContainerSamples(maxContainerSamples)
+
+// containerSamples tracks usage samples per container.
+type containerSamples struct {
+	samples map[string][]ContainerSample
+	max     int
+	mx      sync.RWMutex
+}
+
+func newContainerSamples(max int) *containerSamples {
+	return &containerSamples{
+		samples: make(map[string][]ContainerSample),
+		max:     max,
+	}
+}
+
+func (c *containerSamples) add(key string, s ContainerSample) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	ss := append(c.samples[key], s)
+	if len(ss) > c.max {
+		ss = ss[len(ss)-c.max:]
+	}
+	c.samples[key] = ss
+}
+
+func (c *containerSamples) get(key string) []ContainerSample {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	return append([]ContainerSample(nil), c.samples[key]...)
+}
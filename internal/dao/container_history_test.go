@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestContainerSamples(t *testing.T) {
+	s := newContainerSamples(2)
+	for i := 1; i <= 3; i++ {
+		s.add("default/p1:c1", ContainerSample{CPU: int64(i)})
+	}
+
+	ss := s.get("default/p1:c1")
+	assert.Equal(t, 2, len(ss))
+	assert.Equal(t, int64(2), ss[0].CPU)
+	assert.Equal(t, int64(3), ss[1].CPU)
+	assert.Empty(t, s.get("default/p1:c2"))
+}
+
+func TestNewContainerSample(t *testing.T) {
+	po := v1.Pod{
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "c1", RestartCount: 2},
+			},
+		},
+	}
+	mx := mv1beta1.ContainerMetrics{
+		Name: "c1",
+		Usage: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("250m"),
+			v1.ResourceMemory: resource.MustParse("64Mi"),
+		},
+	}
+	now := time.Now()
+
+	assert.Equal(t, ContainerSample{At: now, CPU: 250, MEM: 64 * 1024 * 1024, Restarts: 2}, newContainerSample(&po, "c1", &mx, now))
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
@@ -35,6 +36,39 @@ type CronJob struct {
 	Generic
 }
 
+// List returns a collection of cronjobs along with their owned jobs run stats.
+func (c *CronJob) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := c.Generic.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+	if client.IsAllNamespace(ns) {
+		ns = client.BlankNamespace
+	}
+	jobs, err := c.getFactory().List(jobGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	jj, err := ownedJobs(jobs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, now := make([]runtime.Object, 0, len(oo)), time.Now()
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		res = append(res, &render.CronJobWithStats{
+			Raw:   u,
+			Stats: ComputeCronJobStats(NewCronJobRuns(jj[u.GetUID()], now)),
+		})
+	}
+
+	return res, nil
+}
+
 // ListImages lists container images.
 func (c *CronJob) ListImages(ctx context.Context, fqn string) ([]string, error) {
 	cj, err := c.GetInstance(fqn)
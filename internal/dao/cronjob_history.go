@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/robfig/cron/v3"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// JobSucceeded tracks a successful job run.
+	JobSucceeded = "Succeeded"
+
+	// JobFailed tracks a failed job run.
+	JobFailed = "Failed"
+
+	// JobRunning tracks an in flight job run.
+	JobRunning = "Running"
+)
+
+// CronJobRun represents a job run spawned by a cronjob.
+type CronJobRun struct {
+	Job      string
+	Status   string
+	Start    time.Time
+	End      time.Time
+	Duration time.Duration
+}
+
+// History returns a cronjob along with its owned job runs sorted by start time.
+func (c *CronJob) History(path string) (*batchv1.CronJob, []CronJobRun, error) {
+	cj, err := c.GetInstance(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	oo, err := c.getFactory().List(jobGVR, cj.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	jj, err := ownedJobs(oo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cj, NewCronJobRuns(jj[cj.UID], time.Now()), nil
+}
+
+// ownedJobs groups jobs by their owner uid.
+func ownedJobs(oo []runtime.Object) (map[types.UID][]batchv1.Job, error) {
+	jj := make(map[types.UID][]batchv1.Job)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		var j batchv1.Job
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &j); err != nil {
+			return nil, errors.New("expecting Job resource")
+		}
+		for _, r := range j.OwnerReferences {
+			jj[r.UID] = append(jj[r.UID], j)
+		}
+	}
+
+	return jj, nil
+}
+
+// NewCronJobRuns returns job runs sorted by start time.
+func NewCronJobRuns(jj []batchv1.Job, now time.Time) []CronJobRun {
+	rr := make([]CronJobRun, 0, len(jj))
+	for i := range jj {
+		rr = append(rr, newCronJobRun(&jj[i], now))
+	}
+	sort.SliceStable(rr, func(i, j int) bool {
+		return rr[i].Start.Before(rr[j].Start)
+	})
+
+	return rr
+}
+
+func newCronJobRun(j *batchv1.Job, now time.Time) CronJobRun {
+	r := CronJobRun{
+		Job:    j.Name,
+		Status: JobRunning,
+		Start:  j.CreationTimestamp.Time,
+	}
+	if j.Status.StartTime != nil {
+		r.Start = j.Status.StartTime.Time
+	}
+	for _, c := range j.Status.Conditions {
+		if c.Status != v1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			r.Status, r.End = JobSucceeded, c.LastTransitionTime.Time
+		case batchv1.JobFailed:
+			r.Status, r.End = JobFailed, c.LastTransitionTime.Time
+		}
+	}
+	if r.Status == JobSucceeded && j.Status.CompletionTime != nil {
+		r.End = j.Status.CompletionTime.Time
+	}
+	end := r.End
+	if r.Status == JobRunning {
+		end = now
+	}
+	if !r.Start.IsZero() && end.After(r.Start) {
+		r.Duration = end.Sub(r.Start)
+	}
+
+	return r
+}
+
+// ComputeCronJobStats computes run statistics. Average duration only
+// accounts for completed runs.
+func ComputeCronJobStats(rr []CronJobRun) render.CronJobStats {
+	s := render.CronJobStats{Runs: len(rr)}
+	var total time.Duration
+	for _, r := range rr {
+		switch r.Status {
+		case JobSucceeded:
+			s.Succeeded++
+			if r.End.After(s.LastSuccess) {
+				s.LastSuccess = r.End
+			}
+		case JobFailed:
+			s.Failed++
+			if r.End.After(s.LastFailure) {
+				s.LastFailure = r.End
+			}
+		default:
+			continue
+		}
+		total += r.Duration
+	}
+	if done := s.Succeeded + s.Failed; done > 0 {
+		s.AvgDuration = total / time.Duration(done)
+	}
+
+	return s
+}
+
+// NextSchedules returns the next n scheduled times for a cronjob. Schedules
+// without a time zone are computed in UTC as the controller manager does by default.
+func NextSchedules(cj *batchv1.CronJob, from time.Time, n int) ([]time.Time, error) {
+	loc := time.UTC
+	if tz := cj.Spec.TimeZone; tz != nil && *tz != "" {
+		l, err := time.LoadLocation(*tz)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", *tz, err)
+		}
+		loc = l
+	}
+	sched, err := cron.ParseStandard(cj.Spec.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: %w", cj.Spec.Schedule, err)
+	}
+
+	tt, t := make([]time.Time, 0, n), from.In(loc)
+	for i := 0; i < n; i++ {
+		t = sched.Next(t)
+		if t.IsZero() {
+			break
+		}
+		tt = append(tt, t)
+	}
+
+	return tt, nil
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNextSchedules(t *testing.T) {
+	from := time.Date(2024, 3, 10, 6, 30, 0, 0, time.UTC)
+	uu := map[string]struct {
+		schedule string
+		tz       string
+		e        []string
+		err      bool
+	}{
+		"utc": {
+			schedule: "0 * * * *",
+			e:        []string{"2024-03-10T07:00:00Z", "2024-03-10T08:00:00Z"},
+		},
+		"tz": {
+			schedule: "0 3 * * *",
+			tz:       "America/New_York",
+			e:        []string{"2024-03-10T03:00:00-04:00", "2024-03-11T03:00:00-04:00"},
+		},
+		"descriptor": {
+			schedule: "@daily",
+			e:        []string{"2024-03-11T00:00:00Z", "2024-03-12T00:00:00Z"},
+		},
+		"bad-schedule": {
+			schedule: "blee",
+			err:      true,
+		},
+		"bad-tz": {
+			schedule: "0 * * * *",
+			tz:       "Mars/Olympus",
+			err:      true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var cj batchv1.CronJob
+			cj.Spec.Schedule = u.schedule
+			if u.tz != "" {
+				cj.Spec.TimeZone = &u.tz
+			}
+			tt, err := dao.NextSchedules(&cj, from, 2)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			ss := make([]string, 0, len(tt))
+			for _, t := range tt {
+				ss = append(ss, t.Format(time.RFC3339))
+			}
+			assert.Equal(t, u.e, ss)
+		})
+	}
+}
+
+func TestCronJobStats(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	jj := []batchv1.Job{
+		makeJobRun("j2", now.Add(-2*time.Hour), 3*time.Minute, batchv1.JobFailed),
+		makeJobRun("j1", now.Add(-3*time.Hour), time.Minute, batchv1.JobComplete),
+		makeJobRun("j3", now.Add(-time.Hour), 2*time.Minute, batchv1.JobComplete),
+		makeJobRun("j4", now.Add(-5*time.Minute), 0, ""),
+	}
+
+	rr := dao.NewCronJobRuns(jj, now)
+	assert.Equal(t, []string{"j1", "j2", "j3", "j4"}, []string{rr[0].Job, rr[1].Job, rr[2].Job, rr[3].Job})
+	assert.Equal(t, dao.JobRunning, rr[3].Status)
+	assert.Equal(t, 5*time.Minute, rr[3].Duration)
+
+	s := dao.ComputeCronJobStats(rr)
+	assert.Equal(t, 4, s.Runs)
+	assert.Equal(t, 2, s.Succeeded)
+	assert.Equal(t, 1, s.Failed)
+	assert.Equal(t, 66, s.SuccessRate())
+	assert.Equal(t, 2*time.Minute, s.AvgDuration)
+	assert.Equal(t, now.Add(-time.Hour+2*time.Minute), s.LastSuccess)
+	assert.Equal(t, now.Add(-2*time.Hour+3*time.Minute), s.LastFailure)
+}
+
+// Helpers...
+
+func makeJobRun(n string, start time.Time, d time.Duration, cond batchv1.JobConditionType) batchv1.Job {
+	j := batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: n, CreationTimestamp: metav1.NewTime(start)},
+		Status:     batchv1.JobStatus{StartTime: &metav1.Time{Time: start}},
+	}
+	if cond == "" {
+		return j
+	}
+	j.Status.Conditions = []batchv1.JobCondition{
+		{Type: cond, Status: v1.ConditionTrue, LastTransitionTime: metav1.NewTime(start.Add(d))},
+	}
+
+	return j
+}
@@ -0,0 +1,287 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+const (
+	// DebugLabel tags k9s node debug pods.
+	DebugLabel = "k9s.io/debug"
+
+	// DebugContainerName represents the debug container name in node debug pods.
+	DebugContainerName = "debugger"
+
+	debugPrefix     = "k9s-debug-"
+	debugPollDelay  = time.Second
+	hostRootVolName = "host-root"
+)
+
+// DebugContainerInfo represents an ephemeral debug container.
+type DebugContainerInfo struct {
+	Name   string `json:"name"`
+	Image  string `json:"image"`
+	Target string `json:"target,omitempty"`
+	State  string `json:"state"`
+}
+
+// Debug injects an ephemeral debug container targeting a pod's container.
+func (p *Pod) Debug(ctx context.Context, path, target string, cfg config.DebugContainer) (string, error) {
+	ns, n := client.Namespaced(path)
+	auth, err := p.Client().CanI(ns, "v1/pods:ephemeralcontainers", n, []string{client.UpdateVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to debug %s", path)
+	}
+
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	po, err := dial.CoreV1().Pods(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	co := NewEphemeralDebugContainer(cfg, target)
+	po.Spec.EphemeralContainers = append(po.Spec.EphemeralContainers, co)
+	if _, err := dial.CoreV1().Pods(ns).UpdateEphemeralContainers(ctx, n, po, metav1.UpdateOptions{}); err != nil {
+		return "", err
+	}
+
+	return co.Name, p.waitDebugContainer(ctx, path, co.Name)
+}
+
+func (p *Pod) waitDebugContainer(ctx context.Context, path, co string) error {
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return err
+	}
+	ns, n := client.Namespaced(path)
+	for {
+		po, err := dial.CoreV1().Pods(ns).Get(ctx, n, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		for _, s := range po.Status.EphemeralContainerStatuses {
+			if s.Name != co {
+				continue
+			}
+			if s.State.Running != nil {
+				return nil
+			}
+			if t := s.State.Terminated; t != nil {
+				return fmt.Errorf("debug container %s terminated: %s", co, t.Reason)
+			}
+			if w := s.State.Waiting; w != nil && w.Reason != "" && w.Reason != "ContainerCreating" && w.Reason != "PodInitializing" {
+				return fmt.Errorf("debug container %s waiting: %s", co, w.Reason)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(debugPollDelay):
+		}
+	}
+}
+
+// DebugContainers returns a pod's ephemeral debug containers.
+func (p *Pod) DebugContainers(path string) ([]DebugContainerInfo, error) {
+	po, err := p.GetInstance(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ListDebugContainers(po), nil
+}
+
+// ListDebugContainers returns the ephemeral containers of a given pod.
+func ListDebugContainers(po *v1.Pod) []DebugContainerInfo {
+	ii := make([]DebugContainerInfo, 0, len(po.Spec.EphemeralContainers))
+	for _, co := range po.Spec.EphemeralContainers {
+		info := DebugContainerInfo{
+			Name:   co.Name,
+			Image:  co.Image,
+			Target: co.TargetContainerName,
+			State:  "Pending",
+		}
+		for _, s := range po.Status.EphemeralContainerStatuses {
+			if s.Name != co.Name {
+				continue
+			}
+			switch {
+			case s.State.Running != nil:
+				info.State = "Running"
+			case s.State.Terminated != nil:
+				info.State = "Terminated"
+			case s.State.Waiting != nil:
+				info.State = s.State.Waiting.Reason
+			}
+		}
+		ii = append(ii, info)
+	}
+
+	return ii
+}
+
+// Debug launches a debug pod on a given node and returns its path once running.
+func (n *Node) Debug(ctx context.Context, node string, cfg config.DebugContainer) (string, error) {
+	dial, err := n.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	spec := NewNodeDebugPod(node, cfg)
+	po, err := dial.CoreV1().Pods(cfg.Namespace).Create(ctx, spec, metav1.CreateOptions{})
+	if err != nil {
+		return "", err
+	}
+	for {
+		po, err = dial.CoreV1().Pods(po.Namespace).Get(ctx, po.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		switch po.Status.Phase {
+		case v1.PodRunning:
+			return client.FQN(po.Namespace, po.Name), nil
+		case v1.PodFailed, v1.PodSucceeded:
+			return "", fmt.Errorf("debug pod %s exited: %s", po.Name, po.Status.Phase)
+		}
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(debugPollDelay):
+		}
+	}
+}
+
+// DeleteDebugPod deletes a given node debug pod.
+func (n *Node) DeleteDebugPod(ctx context.Context, path string) error {
+	dial, err := n.Client().Dial()
+	if err != nil {
+		return err
+	}
+	ns, po := client.Namespaced(path)
+
+	return dial.CoreV1().Pods(ns).Delete(ctx, po, metav1.DeleteOptions{})
+}
+
+// CleanupDebugPods deletes all k9s node debug pods and returns the deleted count.
+func (n *Node) CleanupDebugPods(ctx context.Context, ns string) (int, error) {
+	dial, err := n.Client().Dial()
+	if err != nil {
+		return 0, err
+	}
+	pp, err := dial.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{LabelSelector: DebugLabel})
+	if err != nil {
+		return 0, err
+	}
+	for _, po := range pp.Items {
+		if err := dial.CoreV1().Pods(po.Namespace).Delete(ctx, po.Name, metav1.DeleteOptions{}); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(pp.Items), nil
+}
+
+// NewEphemeralDebugContainer returns an ephemeral debug container spec.
+func NewEphemeralDebugContainer(cfg config.DebugContainer, target string) v1.EphemeralContainer {
+	return v1.EphemeralContainer{
+		EphemeralContainerCommon: v1.EphemeralContainerCommon{
+			Name:                     debugPrefix + rand.String(5),
+			Image:                    cfg.Image,
+			Command:                  cfg.Command,
+			Stdin:                    true,
+			TTY:                      true,
+			TerminationMessagePolicy: v1.TerminationMessageReadFile,
+			SecurityContext:          debugSecurityContext(cfg.Profile),
+		},
+		TargetContainerName: target,
+	}
+}
+
+// NewNodeDebugPod returns a pod spec used to debug a given node.
+func NewNodeDebugPod(node string, cfg config.DebugContainer) *v1.Pod {
+	var grace int64
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      debugPrefix + node + "-" + rand.String(5),
+			Namespace: cfg.Namespace,
+			Labels:    map[string]string{DebugLabel: "true"},
+		},
+		Spec: v1.PodSpec{
+			NodeName:                      node,
+			RestartPolicy:                 v1.RestartPolicyNever,
+			TerminationGracePeriodSeconds: &grace,
+			Tolerations: []v1.Toleration{
+				{Operator: v1.TolerationOpExists},
+			},
+			Containers: []v1.Container{
+				{
+					Name:            DebugContainerName,
+					Image:           cfg.Image,
+					Command:         cfg.Command,
+					Stdin:           true,
+					TTY:             true,
+					SecurityContext: debugSecurityContext(cfg.Profile),
+				},
+			},
+		},
+	}
+
+	switch cfg.Profile {
+	case config.DebugProfileBaseline, config.DebugProfileRestricted:
+	case config.DebugProfileNetAdmin:
+		po.Spec.HostNetwork = true
+	default:
+		po.Spec.HostNetwork, po.Spec.HostPID, po.Spec.HostIPC = true, true, true
+		po.Spec.Volumes = []v1.Volume{
+			{
+				Name:         hostRootVolName,
+				VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/"}},
+			},
+		}
+		po.Spec.Containers[0].VolumeMounts = []v1.VolumeMount{
+			{Name: hostRootVolName, MountPath: "/host"},
+		}
+	}
+
+	return &po
+}
+
+func debugSecurityContext(profile string) *v1.SecurityContext {
+	switch profile {
+	case config.DebugProfileBaseline:
+		return nil
+	case config.DebugProfileRestricted:
+		nonRoot, escalate := true, false
+		return &v1.SecurityContext{
+			RunAsNonRoot:             &nonRoot,
+			AllowPrivilegeEscalation: &escalate,
+			Capabilities:             &v1.Capabilities{Drop: []v1.Capability{"ALL"}},
+			SeccompProfile:           &v1.SeccompProfile{Type: v1.SeccompProfileTypeRuntimeDefault},
+		}
+	case config.DebugProfileNetAdmin:
+		return &v1.SecurityContext{
+			Capabilities: &v1.Capabilities{Add: []v1.Capability{"NET_ADMIN", "NET_RAW"}},
+		}
+	case config.DebugProfileSysAdmin:
+		priv := true
+		return &v1.SecurityContext{Privileged: &priv}
+	default:
+		return &v1.SecurityContext{
+			Capabilities: &v1.Capabilities{Add: []v1.Capability{"SYS_PTRACE"}},
+		}
+	}
+}
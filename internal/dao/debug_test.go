@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestNewEphemeralDebugContainer(t *testing.T) {
+	uu := map[string]struct {
+		profile string
+		caps    []v1.Capability
+		priv    bool
+		nilSec  bool
+	}{
+		"general":  {profile: config.DebugProfileGeneral, caps: []v1.Capability{"SYS_PTRACE"}},
+		"baseline": {profile: config.DebugProfileBaseline, nilSec: true},
+		"netadmin": {profile: config.DebugProfileNetAdmin, caps: []v1.Capability{"NET_ADMIN", "NET_RAW"}},
+		"sysadmin": {profile: config.DebugProfileSysAdmin, priv: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := config.NewDebugContainer()
+			cfg.Profile = u.profile
+			co := NewEphemeralDebugContainer(cfg, "fred")
+
+			assert.True(t, strings.HasPrefix(co.Name, debugPrefix))
+			assert.Equal(t, "fred", co.TargetContainerName)
+			assert.Equal(t, cfg.Image, co.Image)
+			assert.True(t, co.Stdin && co.TTY)
+			if u.nilSec {
+				assert.Nil(t, co.SecurityContext)
+				return
+			}
+			if u.priv {
+				assert.True(t, *co.SecurityContext.Privileged)
+				return
+			}
+			assert.Equal(t, u.caps, co.SecurityContext.Capabilities.Add)
+		})
+	}
+}
+
+func TestNewNodeDebugPod(t *testing.T) {
+	cfg := config.NewDebugContainer()
+	po := NewNodeDebugPod("n1", cfg)
+
+	assert.Equal(t, "n1", po.Spec.NodeName)
+	assert.Equal(t, "default", po.Namespace)
+	assert.Equal(t, "true", po.Labels[DebugLabel])
+	assert.True(t, po.Spec.HostPID && po.Spec.HostNetwork && po.Spec.HostIPC)
+	assert.Equal(t, "/host", po.Spec.Containers[0].VolumeMounts[0].MountPath)
+
+	cfg.Profile = config.DebugProfileRestricted
+	po = NewNodeDebugPod("n1", cfg)
+	assert.False(t, po.Spec.HostPID || po.Spec.HostNetwork || po.Spec.HostIPC)
+	assert.Empty(t, po.Spec.Volumes)
+	assert.True(t, *po.Spec.Containers[0].SecurityContext.RunAsNonRoot)
+}
+
+func TestListDebugContainers(t *testing.T) {
+	po := v1.Pod{
+		Spec: v1.PodSpec{
+			EphemeralContainers: []v1.EphemeralContainer{
+				{EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: "d1", Image: "busybox"}, TargetContainerName: "c1"},
+				{EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: "d2", Image: "busybox"}},
+				{EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: "d3", Image: "busybox"}},
+			},
+		},
+		Status: v1.PodStatus{
+			EphemeralContainerStatuses: []v1.ContainerStatus{
+				{Name: "d1", State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+				{Name: "d2", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{}}},
+			},
+		},
+	}
+
+	assert.Equal(t, []DebugContainerInfo{
+		{Name: "d1", Image: "busybox", Target: "c1", State: "Running"},
+		{Name: "d2", Image: "busybox", State: "Terminated"},
+		{Name: "d3", Image: "busybox", State: "Pending"},
+	}, ListDebugContainers(&po))
+}
@@ -4,6 +4,8 @@
 package dao
 
 import (
+	"fmt"
+
 	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
 	"k8s.io/kubectl/pkg/describe"
@@ -33,11 +35,17 @@ func Describe(c client.Connection, gvr client.GVR, path string) (string, error)
 		log.Error().Err(err).Msgf("Unable to find mapper for %s %s", gvr, n)
 		return "", err
 	}
-	d, err := describe.Describer(c.Config().Flags(), mapping)
+	cfg, err := c.RestConfig()
 	if err != nil {
-		log.Error().Err(err).Msgf("Unable to find describer for %#v", mapping)
 		return "", err
 	}
+	d, ok := describe.DescriberFor(mapping.GroupVersionKind.GroupKind(), cfg)
+	if !ok {
+		if d, ok = describe.GenericDescriberFor(mapping, cfg); !ok {
+			log.Error().Msgf("Unable to find describer for %#v", mapping)
+			return "", fmt.Errorf("no describer for %s", gvr)
+		}
+	}
 
 	return d.Describe(ns, n, describe.DescriberSettings{ShowEvents: true})
 }
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Diagnostic)(nil)
+
+// InformersLister represents a factory reporting on its informers.
+type InformersLister interface {
+	// Informers returns the state of all active informers.
+	Informers() []watch.InformerInfo
+}
+
+// Diagnostic represents api client diagnostics.
+type Diagnostic struct {
+	NonResource
+}
+
+// List returns the api client diagnostics per resource.
+func (d *Diagnostic) List(_ context.Context, _ string) ([]runtime.Object, error) {
+	rr := make(map[string]*render.DiagnosticRes)
+	resFor := func(gvr string) *render.DiagnosticRes {
+		r, ok := rr[gvr]
+		if !ok {
+			r = &render.DiagnosticRes{GVR: gvr}
+			rr[gvr] = r
+		}
+		return r
+	}
+
+	if l, ok := d.getFactory().(InformersLister); ok {
+		for _, i := range l.Informers() {
+			r := resFor(i.GVR)
+			r.Informers++
+			if i.Synced {
+				r.Synced++
+			}
+			r.Items += i.Items
+			r.CacheBytes += i.CacheBytes
+		}
+	}
+	for _, s := range client.Diag.Stats() {
+		resFor(s.GVR).Stats = s
+	}
+
+	gvrs := make([]string, 0, len(rr))
+	for gvr := range rr {
+		gvrs = append(gvrs, gvr)
+	}
+	sort.Strings(gvrs)
+	oo := make([]runtime.Object, 0, len(gvrs))
+	for _, gvr := range gvrs {
+		oo = append(oo, *rr[gvr])
+	}
+
+	return oo, nil
+}
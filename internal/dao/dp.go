@@ -19,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/polymorphichelpers"
 	"k8s.io/kubectl/pkg/scheme"
 )
@@ -76,6 +77,47 @@ func (d *Deployment) Scale(ctx context.Context, path string, replicas int32) err
 
 // Restart a Deployment rollout.
 func (d *Deployment) Restart(ctx context.Context, path string) error {
+	return d.patchRollout(ctx, path, "restart", polymorphichelpers.ObjectRestarterFn)
+}
+
+// Pause pauses a Deployment rollout.
+func (d *Deployment) Pause(ctx context.Context, path string) error {
+	return d.patchRollout(ctx, path, "pause", polymorphichelpers.ObjectPauserFn)
+}
+
+// Resume resumes a paused Deployment rollout.
+func (d *Deployment) Resume(ctx context.Context, path string) error {
+	return d.patchRollout(ctx, path, "resume", polymorphichelpers.ObjectResumerFn)
+}
+
+// RollbackTo rolls a Deployment back to a given revision.
+func (d *Deployment) RollbackTo(ctx context.Context, path string, rev int64) error {
+	dp, err := d.GetInstance(path)
+	if err != nil {
+		return err
+	}
+	auth, err := d.Client().CanI(dp.Namespace, "apps/v1/deployments", dp.Name, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to rollback a deployment")
+	}
+
+	dial, err := d.Client().Dial()
+	if err != nil {
+		return err
+	}
+	rb, err := polymorphichelpers.RollbackerFor(appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind(), dial)
+	if err != nil {
+		return err
+	}
+	_, err = rb.Rollback(dp, map[string]string{}, rev, cmdutil.DryRunNone)
+
+	return err
+}
+
+func (d *Deployment) patchRollout(ctx context.Context, path, action string, fn func(runtime.Object) ([]byte, error)) error {
 	o, err := d.getFactory().Get("apps/v1/deployments", path, true, labels.Everything())
 	if err != nil {
 		return err
@@ -91,7 +133,7 @@ func (d *Deployment) Restart(ctx context.Context, path string) error {
 		return err
 	}
 	if !auth {
-		return fmt.Errorf("user is not authorized to restart a deployment")
+		return fmt.Errorf("user is not authorized to %s a deployment", action)
 	}
 
 	dial, err := d.Client().Dial()
@@ -104,7 +146,7 @@ func (d *Deployment) Restart(ctx context.Context, path string) error {
 		return err
 	}
 
-	after, err := polymorphichelpers.ObjectRestarterFn(&dp)
+	after, err := fn(&dp)
 	if err != nil {
 		return err
 	}
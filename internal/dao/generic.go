@@ -39,6 +39,7 @@ type Generic struct {
 // BOZO!! no auth check??
 func (g *Generic) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	labelSel, _ := ctx.Value(internal.KeyLabels).(string)
+	fieldSel, _ := ctx.Value(internal.KeyFields).(string)
 	if client.IsAllNamespace(ns) {
 		ns = client.BlankNamespace
 	}
@@ -52,10 +53,11 @@ func (g *Generic) List(ctx context.Context, ns string) ([]runtime.Object, error)
 		return nil, err
 	}
 
+	opts := metav1.ListOptions{LabelSelector: labelSel, FieldSelector: fieldSel}
 	if client.IsClusterScoped(ns) {
-		ll, err = dial.List(ctx, metav1.ListOptions{LabelSelector: labelSel})
+		ll, err = dial.List(ctx, opts)
 	} else {
-		ll, err = dial.Namespace(ns).List(ctx, metav1.ListOptions{LabelSelector: labelSel})
+		ll, err = dial.Namespace(ns).List(ctx, opts)
 	}
 	if err != nil {
 		return nil, err
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	maxHPASamples     = 120
+	maxHPAEvents      = 10
+	hpaSampleInterval = 30 * time.Second
+)
+
+var _ Accessor = (*HPA)(nil)
+
+// HPA represents a K8s HorizontalPodAutoscaler.
+type HPA struct {
+	Resource
+}
+
+// List returns a collection of HPAs along with their session replica counts.
+func (h *HPA) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := h.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+
+	ct, now := h.Client().ActiveContext(), time.Now()
+	res := make([]runtime.Object, 0, len(oo))
+	seen := make(map[string]struct{}, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		key := hpaSampleKey(ct, extractFQN(o))
+		seen[key] = struct{}{}
+		replicas, _, _ := unstructured.NestedInt64(u.Object, "status", "currentReplicas")
+		res = append(res, &render.HPAWithHistory{
+			Raw:      u,
+			Replicas: hpaReplicas.add(key, replicas, now),
+		})
+	}
+	prefix := hpaSampleKey(ct, "")
+	if !client.IsAllNamespaces(ns) {
+		prefix += ns + "/"
+	}
+	hpaReplicas.prune(prefix, seen)
+
+	return res, nil
+}
+
+// Replicas returns an HPA replica counts sampled during the session.
+func (h *HPA) Replicas(path string) []int64 {
+	return hpaReplicas.get(hpaSampleKey(h.Client().ActiveContext(), path))
+}
+
+// ScalingEvents returns an HPA most recent events, newest first.
+func (h *HPA) ScalingEvents(ctx context.Context, path string) ([]v1.Event, error) {
+	ns, n := client.Namespaced(path)
+	dial, err := h.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	sel := fields.Set{
+		"involvedObject.kind": "HorizontalPodAutoscaler",
+		"involvedObject.name": n,
+	}.AsSelector().String()
+	ee, err := dial.CoreV1().Events(ns).List(ctx, metav1.ListOptions{FieldSelector: sel})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(ee.Items, func(i, j int) bool {
+		return eventTime(&ee.Items[i]).After(eventTime(&ee.Items[j]).Time)
+	})
+	if len(ee.Items) > maxHPAEvents {
+		ee.Items = ee.Items[:maxHPAEvents]
+	}
+
+	return ee.Items, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func eventTime(e *v1.Event) metav1.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp
+	case !e.EventTime.IsZero():
+		return metav1.NewTime(e.EventTime.Time)
+	default:
+		return e.CreationTimestamp
+	}
+}
+
+var hpaReplicas = newReplicaSamples(maxHPASamples, hpaSampleInterval)
+
+func hpaSampleKey(ct, fqn string) string {
+	return ct + "@" + fqn
+}
+
+type replicaSample struct {
+	at    time.Time
+	count int64
+}
+
+// replicaSamples tracks replica counts per resource at most once per interval.
+type replicaSamples struct {
+	samples  map[string][]replicaSample
+	max      int
+	interval time.Duration
+	mx       sync.RWMutex
+}
+
+func newReplicaSamples(max int, interval time.Duration) *replicaSamples {
+	return &replicaSamples{
+		samples:  make(map[string][]replicaSample),
+		max:      max,
+		interval: interval,
+	}
+}
+
+// add records a replica count. Counts observed within the sampling interval
+// update the most recent sample rather than adding a new one.
+func (r *replicaSamples) add(key string, v int64, at time.Time) []int64 {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	ss := r.samples[key]
+	if n := len(ss); n > 0 && at.Sub(ss[n-1].at) < r.interval {
+		ss[n-1].count = v
+	} else {
+		ss = append(ss, replicaSample{at: at, count: v})
+	}
+	if len(ss) > r.max {
+		ss = ss[len(ss)-r.max:]
+	}
+	r.samples[key] = ss
+
+	return counts(ss)
+}
+
+func (r *replicaSamples) get(key string) []int64 {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	return counts(r.samples[key])
+}
+
+// prune drops samples matching a key prefix that are no longer tracked.
+func (r *replicaSamples) prune(prefix string, keep map[string]struct{}) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	for k := range r.samples {
+		if _, ok := keep[k]; !ok && strings.HasPrefix(k, prefix) {
+			delete(r.samples, k)
+		}
+	}
+}
+
+func counts(ss []replicaSample) []int64 {
+	cc := make([]int64, 0, len(ss))
+	for _, s := range ss {
+		cc = append(cc, s.count)
+	}
+
+	return cc
+}
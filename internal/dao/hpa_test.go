@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplicaSamples(t *testing.T) {
+	s := newReplicaSamples(3, time.Minute)
+	now := time.Now()
+	at := func(m int) time.Time {
+		return now.Add(time.Duration(m) * time.Minute)
+	}
+
+	assert.Equal(t, []int64{1}, s.add("c1@default/a", 1, at(0)))
+	assert.Equal(t, []int64{2}, s.add("c1@default/a", 2, at(0).Add(10*time.Second)))
+	s.add("c1@default/b", 5, at(0))
+	s.add("c2@default/a", 7, at(0))
+	s.add("c1@default/a", 3, at(1))
+	s.add("c1@default/a", 4, at(2))
+	assert.Equal(t, []int64{3, 4, 6}, s.add("c1@default/a", 6, at(3)))
+	assert.Equal(t, []int64{5}, s.get("c1@default/b"))
+	assert.Equal(t, []int64{7}, s.get("c2@default/a"))
+	assert.Empty(t, s.get("c1@default/c"))
+
+	s.prune("c1@default/", map[string]struct{}{"c1@default/a": {}})
+	assert.Empty(t, s.get("c1@default/b"))
+	assert.Equal(t, []int64{3, 4, 6}, s.get("c1@default/a"))
+	assert.Equal(t, []int64{7}, s.get("c2@default/a"))
+}
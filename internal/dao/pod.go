@@ -87,32 +87,13 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); ok && withMx {
 		pmx, _ = client.DialMetrics(p.Client()).FetchPodsMetricsMap(ctx, ns)
 	}
-	sel, _ := ctx.Value(internal.KeyFields).(string)
-	fsel, err := labels.ConvertSelectorToLabelsMap(sel)
-	if err != nil {
-		return nil, err
-	}
-	nodeName := fsel["spec.nodeName"]
-
 	res := make([]runtime.Object, 0, len(oo))
 	for _, o := range oo {
 		u, ok := o.(*unstructured.Unstructured)
 		if !ok {
 			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
 		}
-		fqn := extractFQN(o)
-		if nodeName == "" {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: pmx[fqn]})
-			continue
-		}
-
-		spec, ok := u.Object["spec"].(map[string]interface{})
-		if !ok {
-			return res, fmt.Errorf("expecting interface map but got `%T", o)
-		}
-		if spec["nodeName"] == nodeName {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: pmx[fqn]})
-		}
+		res = append(res, &render.PodWithMetrics{Raw: u, MX: pmx[extractFQN(o)]})
 	}
 
 	return res, nil
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Query)(nil)
+
+// Query tracks saved resource view queries.
+type Query struct {
+	NonResource
+}
+
+// List returns a collection of saved queries.
+func (q *Query) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	qq, ok := ctx.Value(internal.KeyQueries).(config.Queries)
+	if !ok {
+		return nil, fmt.Errorf("expecting config.Queries but got %T", ctx.Value(internal.KeyQueries))
+	}
+	oo := make([]runtime.Object, 0, len(qq.Query))
+	for name, query := range qq.Query {
+		oo = append(oo, render.QueryRes{Name: name, Query: query})
+	}
+
+	return oo, nil
+}
@@ -74,7 +74,7 @@ func (p *Policy) loadClusterRoleBinding(kind, name string) (render.Policies, err
 			}
 		}
 	}
-	crs, err := p.fetchClusterRoles()
+	crs, err := fetchClusterRoles(p.getFactory())
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +95,7 @@ func (p *Policy) loadRoleBinding(kind, name string) (render.Policies, error) {
 	if err != nil {
 		return nil, err
 	}
-	crs, err := p.fetchClusterRoles()
+	crs, err := fetchClusterRoles(p.getFactory())
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +107,7 @@ func (p *Policy) loadRoleBinding(kind, name string) (render.Policies, error) {
 		}
 	}
 
-	ros, err := p.fetchRoles()
+	ros, err := fetchRoles(p.getFactory())
 	if err != nil {
 		return nil, err
 	}
@@ -192,10 +192,8 @@ func isSameSubject(kind, ns, name string, subject *rbacv1.Subject) bool {
 	return true
 }
 
-func (p *Policy) fetchClusterRoles() ([]rbacv1.ClusterRole, error) {
-	const gvr = "rbac.authorization.k8s.io/v1/clusterroles"
-
-	oo, err := p.getFactory().List(gvr, client.ClusterScope, false, labels.Everything())
+func fetchClusterRoles(f Factory) ([]rbacv1.ClusterRole, error) {
+	oo, err := f.List(crGVR, client.ClusterScope, false, labels.Everything())
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +202,7 @@ func (p *Policy) fetchClusterRoles() ([]rbacv1.ClusterRole, error) {
 	for i, o := range oo {
 		var cr rbacv1.ClusterRole
 		if e := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &cr); e != nil {
-			return nil, err
+			return nil, e
 		}
 		crs[i] = cr
 	}
@@ -212,10 +210,8 @@ func (p *Policy) fetchClusterRoles() ([]rbacv1.ClusterRole, error) {
 	return crs, nil
 }
 
-func (p *Policy) fetchRoles() ([]rbacv1.Role, error) {
-	const gvr = "rbac.authorization.k8s.io/v1/roles"
-
-	oo, err := p.getFactory().List(gvr, client.BlankNamespace, false, labels.Everything())
+func fetchRoles(f Factory) ([]rbacv1.Role, error) {
+	oo, err := f.List(rGVR, client.BlankNamespace, false, labels.Everything())
 	if err != nil {
 		return nil, err
 	}
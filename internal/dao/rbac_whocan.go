@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor = (*WhoCan)(nil)
+	_ Nuker    = (*WhoCan)(nil)
+)
+
+// WhoCan represents a reverse rbac lookup, ie which subjects can perform
+// a given verb on a resource.
+type WhoCan struct {
+	Resource
+}
+
+// List returns all subjects granted the requested verb on the given resource.
+func (w *WhoCan) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	verb, ok := ctx.Value(internal.KeyVerb).(string)
+	if !ok || verb == "" {
+		return nil, errors.New("expecting a context verb")
+	}
+	gvr, ok := ctx.Value(internal.KeyGVR).(client.GVR)
+	if !ok {
+		return nil, errors.New("expecting a context gvr")
+	}
+	name, _ := ctx.Value(internal.KeyPath).(string)
+	ns, _ := ctx.Value(internal.KeyNamespace).(string)
+
+	crs, err := fetchClusterRoles(w.getFactory())
+	if err != nil {
+		return nil, err
+	}
+	crRules := aggregateClusterRoles(crs)
+	q := newAccessQuery(verb, gvr, name)
+
+	oo := make(render.WhoCans, 0, 10)
+	crbs, err := fetchClusterRoleBindings(w.getFactory())
+	if err != nil {
+		return nil, err
+	}
+	for _, crb := range crbs {
+		names, ok := q.grantedBy(crRules[crb.RoleRef.Name])
+		if !ok {
+			continue
+		}
+		for _, s := range crb.Subjects {
+			oo = oo.Upsert(render.NewWhoCanRes(s, client.NotNamespaced, "CRB:"+crb.Name, "CR:"+crb.RoleRef.Name, names))
+		}
+	}
+
+	if !w.isNamespaced(gvr) {
+		return asWhoCanObjects(oo), nil
+	}
+
+	ros, err := fetchRoles(w.getFactory())
+	if err != nil {
+		return nil, err
+	}
+	roRules := make(map[string][]rbacv1.PolicyRule, len(ros))
+	for _, ro := range ros {
+		roRules[client.FQN(ro.Namespace, ro.Name)] = ro.Rules
+	}
+	rbs, err := fetchRoleBindings(w.getFactory())
+	if err != nil {
+		return nil, err
+	}
+	for _, rb := range rbs {
+		if client.IsNamespaced(ns) && rb.Namespace != ns {
+			continue
+		}
+		var (
+			rules []rbacv1.PolicyRule
+			role  string
+		)
+		switch rb.RoleRef.Kind {
+		case "ClusterRole":
+			rules, role = crRules[rb.RoleRef.Name], "CR:"+rb.RoleRef.Name
+		default:
+			rules, role = roRules[client.FQN(rb.Namespace, rb.RoleRef.Name)], "RO:"+rb.RoleRef.Name
+		}
+		names, ok := q.grantedBy(rules)
+		if !ok {
+			continue
+		}
+		for _, s := range rb.Subjects {
+			oo = oo.Upsert(render.NewWhoCanRes(s, rb.Namespace, "RB:"+client.FQN(rb.Namespace, rb.Name), role, names))
+		}
+	}
+
+	return asWhoCanObjects(oo), nil
+}
+
+func (w *WhoCan) isNamespaced(gvr client.GVR) bool {
+	meta, err := MetaAccess.MetaFor(gvr)
+	if err != nil {
+		log.Warn().Err(err).Msgf("No meta found for %q. Assuming namespaced", gvr)
+		return true
+	}
+
+	return meta.Namespaced
+}
+
+func asWhoCanObjects(ww render.WhoCans) []runtime.Object {
+	oo := make([]runtime.Object, len(ww))
+	for i, w := range ww {
+		oo[i] = w
+	}
+
+	return oo
+}
+
+// aggregateClusterRoles returns the effective rules for each cluster role,
+// folding in the rules of cluster roles selected via aggregation rules.
+func aggregateClusterRoles(crs []rbacv1.ClusterRole) map[string][]rbacv1.PolicyRule {
+	byName := make(map[string]rbacv1.ClusterRole, len(crs))
+	for _, cr := range crs {
+		byName[cr.Name] = cr
+	}
+
+	rules := make(map[string][]rbacv1.PolicyRule, len(crs))
+	for _, cr := range crs {
+		rules[cr.Name] = aggregatedRules(cr, byName, make(map[string]struct{}))
+	}
+
+	return rules
+}
+
+func aggregatedRules(cr rbacv1.ClusterRole, crs map[string]rbacv1.ClusterRole, visited map[string]struct{}) []rbacv1.PolicyRule {
+	if _, ok := visited[cr.Name]; ok {
+		return nil
+	}
+	visited[cr.Name] = struct{}{}
+
+	rr := append([]rbacv1.PolicyRule{}, cr.Rules...)
+	if cr.AggregationRule == nil {
+		return rr
+	}
+	for _, lsel := range cr.AggregationRule.ClusterRoleSelectors {
+		lsel := lsel
+		sel, err := metav1.LabelSelectorAsSelector(&lsel)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Invalid aggregation selector on clusterrole %q", cr.Name)
+			continue
+		}
+		for _, c := range crs {
+			if c.Name == cr.Name || !sel.Matches(labels.Set(c.Labels)) {
+				continue
+			}
+			rr = append(rr, aggregatedRules(c, crs, visited)...)
+		}
+	}
+
+	return rr
+}
+
+type accessQuery struct {
+	verb, group, resource, name string
+}
+
+func newAccessQuery(verb string, gvr client.GVR, name string) accessQuery {
+	return accessQuery{
+		verb:     strings.ToLower(verb),
+		group:    gvr.G(),
+		resource: gvr.R(),
+		name:     name,
+	}
+}
+
+// grantedBy checks if any of the rules grants access. It returns the resource
+// names the access is restricted to, if any.
+func (q accessQuery) grantedBy(rules []rbacv1.PolicyRule) ([]string, bool) {
+	var (
+		names   []string
+		granted bool
+	)
+	for _, r := range rules {
+		if !matchRuleItem(r.Verbs, q.verb) || !matchRuleItem(r.APIGroups, q.group) || !q.matchResource(r.Resources) {
+			continue
+		}
+		if len(r.ResourceNames) == 0 {
+			return nil, true
+		}
+		if q.name != "" {
+			if inList(r.ResourceNames, q.name) {
+				return nil, true
+			}
+			continue
+		}
+		granted, names = true, append(names, r.ResourceNames...)
+	}
+
+	return names, granted
+}
+
+func (q accessQuery) matchResource(rr []string) bool {
+	if matchRuleItem(rr, q.resource) {
+		return true
+	}
+	res, sub, ok := strings.Cut(q.resource, "/")
+	if !ok {
+		return false
+	}
+
+	return inList(rr, res+"/*") || inList(rr, "*/"+sub)
+}
+
+func matchRuleItem(ii []string, s string) bool {
+	return inList(ii, rbacv1.VerbAll) || inList(ii, s)
+}
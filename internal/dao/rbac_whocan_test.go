@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccessQueryGrantedBy(t *testing.T) {
+	uu := map[string]struct {
+		verb, gvr, name string
+		rules           []rbacv1.PolicyRule
+		names           []string
+		ok              bool
+	}{
+		"empty": {
+			verb: "get",
+			gvr:  "v1/secrets",
+		},
+		"exact": {
+			verb: "delete",
+			gvr:  "v1/secrets",
+			rules: []rbacv1.PolicyRule{
+				{Verbs: []string{"get", "delete"}, APIGroups: []string{""}, Resources: []string{"secrets"}},
+			},
+			ok: true,
+		},
+		"verb-mismatch": {
+			verb: "delete",
+			gvr:  "v1/secrets",
+			rules: []rbacv1.PolicyRule{
+				{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}},
+			},
+		},
+		"group-mismatch": {
+			verb: "get",
+			gvr:  "apps/v1/deployments",
+			rules: []rbacv1.PolicyRule{
+				{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"deployments"}},
+			},
+		},
+		"wildcards": {
+			verb: "patch",
+			gvr:  "apps/v1/deployments",
+			rules: []rbacv1.PolicyRule{
+				{Verbs: []string{"*"}, APIGroups: []string{"*"}, Resources: []string{"*"}},
+			},
+			ok: true,
+		},
+		"resource-names": {
+			verb: "get",
+			gvr:  "v1/secrets",
+			rules: []rbacv1.PolicyRule{
+				{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"s1"}},
+				{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"s2"}},
+			},
+			names: []string{"s1", "s2"},
+			ok:    true,
+		},
+		"resource-name-match": {
+			verb: "get",
+			gvr:  "v1/secrets",
+			name: "s2",
+			rules: []rbacv1.PolicyRule{
+				{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"s1", "s2"}},
+			},
+			ok: true,
+		},
+		"resource-name-mismatch": {
+			verb: "get",
+			gvr:  "v1/secrets",
+			name: "s3",
+			rules: []rbacv1.PolicyRule{
+				{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"s1", "s2"}},
+			},
+		},
+		"unrestricted-wins": {
+			verb: "get",
+			gvr:  "v1/secrets",
+			rules: []rbacv1.PolicyRule{
+				{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"s1"}},
+				{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}},
+			},
+			ok: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			q := newAccessQuery(u.verb, client.NewGVR(u.gvr), u.name)
+			names, ok := q.grantedBy(u.rules)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.names, names)
+		})
+	}
+}
+
+func TestAggregateClusterRoles(t *testing.T) {
+	r1 := rbacv1.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}
+	r2 := rbacv1.PolicyRule{Verbs: []string{"delete"}, APIGroups: []string{""}, Resources: []string{"secrets"}}
+	crs := []rbacv1.ClusterRole{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "agg"},
+			AggregationRule: &rbacv1.AggregationRule{
+				ClusterRoleSelectors: []metav1.LabelSelector{
+					{MatchLabels: map[string]string{"aggregate-to-agg": "true"}},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "cr1", Labels: map[string]string{"aggregate-to-agg": "true"}},
+			Rules:      []rbacv1.PolicyRule{r1},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "cr2", Labels: map[string]string{"aggregate-to-agg": "true"}},
+			Rules:      []rbacv1.PolicyRule{r2},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "cr3"},
+			Rules:      []rbacv1.PolicyRule{r2},
+		},
+	}
+
+	rr := aggregateClusterRoles(crs)
+	assert.Equal(t, 4, len(rr))
+	assert.ElementsMatch(t, []rbacv1.PolicyRule{r1, r2}, rr["agg"])
+	assert.Equal(t, []rbacv1.PolicyRule{r1}, rr["cr1"])
+	assert.Equal(t, []rbacv1.PolicyRule{r2}, rr["cr3"])
+}
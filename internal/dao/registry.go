@@ -85,8 +85,13 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 		client.NewGVR("batch/v1/cronjobs"):                                 &CronJob{},
 		client.NewGVR("batch/v1beta1/cronjobs"):                            &CronJob{},
 		client.NewGVR("batch/v1/jobs"):                                     &Job{},
+		client.NewGVR("autoscaling/v2/horizontalpodautoscalers"):           &HPA{},
 		client.NewGVR("helm"):                                              &HelmChart{},
 		client.NewGVR("helm-history"):                                      &HelmHistory{},
+		client.NewGVR("rollout-history"):                                   &RolloutHistory{},
+		client.NewGVR("rightsizings"):                                      &RightSizing{},
+		client.NewGVR("diagnostics"):                                       &Diagnostic{},
+		client.NewGVR("queries"):                                           &Query{},
 		client.NewGVR("apiextensions.k8s.io/v1/customresourcedefinitions"): &CustomResourceDefinition{},
 		// !!BOZO!! Popeye
 		//client.NewGVR("popeye"):                 &Popeye{},
@@ -220,6 +225,36 @@ func loadK9s(m ResourceMetas) {
 		ShortNames:   []string{"hz", "pu"},
 		Categories:   []string{k9sCat},
 	}
+	m[client.NewGVR("timelines")] = metav1.APIResource{
+		Name:         "timelines",
+		Kind:         "Timeline",
+		SingularName: "timeline",
+		Namespaced:   true,
+		ShortNames:   []string{"tl"},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("rightsizings")] = metav1.APIResource{
+		Name:         "rightsizings",
+		Kind:         "RightSizing",
+		SingularName: "rightsizing",
+		Namespaced:   true,
+		ShortNames:   []string{"rz"},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("diagnostics")] = metav1.APIResource{
+		Name:         "diagnostics",
+		Kind:         "Diagnostic",
+		SingularName: "diagnostic",
+		ShortNames:   []string{"diag"},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NewGVR("rollout-history")] = metav1.APIResource{
+		Name:         "rollouts",
+		Kind:         "Rollout",
+		SingularName: "rollout",
+		Namespaced:   true,
+		Categories:   []string{k9sCat},
+	}
 	m[client.NewGVR("dir")] = metav1.APIResource{
 		Name:         "dir",
 		Kind:         "Dir",
@@ -239,6 +274,13 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{k9sCat},
 	}
+	m[client.NewGVR("queries")] = metav1.APIResource{
+		Name:         "queries",
+		Kind:         "Query",
+		SingularName: "query",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
 	m[client.NewGVR("aliases")] = metav1.APIResource{
 		Name:         "aliases",
 		Kind:         "Aliases",
@@ -349,6 +391,11 @@ func loadRBAC(m ResourceMetas) {
 		Kind:       "Group",
 		Categories: []string{k9sCat},
 	}
+	m[client.NewGVR("whocan")] = metav1.APIResource{
+		Name:       "whocan",
+		Kind:       "WhoCan",
+		Categories: []string{k9sCat},
+	}
 }
 
 func loadPreferred(f Factory, m ResourceMetas) error {
@@ -33,6 +33,11 @@ func (r *Resource) List(ctx context.Context, ns string) ([]runtime.Object, error
 		}
 	}
 
+	// Informers cache all resources so field selectors are served by the api server.
+	if fsel, _ := ctx.Value(internal.KeyFields).(string); fsel != "" {
+		return r.Generic.List(ctx, ns)
+	}
+
 	return r.getFactory().List(r.gvrStr(), ns, false, lsel)
 }
 
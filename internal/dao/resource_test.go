@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestResourceListFields(t *testing.T) {
+	uu := map[string]struct {
+		fields  string
+		actions int
+		e       string
+	}{
+		"informer": {},
+		"fields": {
+			fields:  "spec.nodeName=n1",
+			actions: 1,
+			e:       "spec.nodeName=n1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dial := fake.NewSimpleDynamicClientWithCustomListKinds(
+				runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					{Version: "v1", Resource: "pods"}: "PodList",
+				},
+				&unstructured.Unstructured{Object: map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "Pod",
+					"metadata":   map[string]interface{}{"namespace": "ns1", "name": "p1"},
+				}},
+			)
+			var r dao.Resource
+			r.Init(&dynFactory{conn: &dynConn{dial: dial}}, client.NewGVR("v1/pods"))
+
+			ctx := context.WithValue(context.Background(), internal.KeyFields, u.fields)
+			_, err := r.List(ctx, "ns1")
+			require.NoError(t, err)
+
+			aa := dial.Actions()
+			require.Len(t, aa, u.actions)
+			if u.actions == 0 {
+				return
+			}
+			l, ok := aa[0].(k8stesting.ListAction)
+			require.True(t, ok)
+			assert.Equal(t, "ns1", l.GetNamespace())
+			assert.Equal(t, u.e, l.GetListRestrictions().Fields.String())
+		})
+	}
+}
+
+// Helpers...
+
+type dynConn struct {
+	conn
+	dial dynamic.Interface
+}
+
+func (c *dynConn) DynDial() (dynamic.Interface, error) {
+	return c.dial, nil
+}
+
+type dynFactory struct {
+	testFactory
+	conn *dynConn
+}
+
+func (f *dynFactory) Client() client.Connection {
+	return f.conn
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/pmezard/go-difflib/difflib"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const rsGVR = "apps/v1/replicasets"
+
+var _ Accessor = (*RolloutHistory)(nil)
+
+// RolloutHistory represents a deployment rollout revisions.
+type RolloutHistory struct {
+	NonResource
+}
+
+// List returns a deployment revisions.
+func (r *RolloutHistory) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyFQN).(string)
+	if !ok {
+		return nil, fmt.Errorf("expecting FQN in context")
+	}
+	dp, rr, err := r.revisions(path)
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, res := range rr {
+		res.Deployment = dp
+		oo = append(oo, res)
+	}
+
+	return oo, nil
+}
+
+// Get returns a deployment revision given a ns/name:revision path.
+func (r *RolloutHistory) Get(_ context.Context, path string) (runtime.Object, error) {
+	fqn, rev, err := parseRevisionPath(path)
+	if err != nil {
+		return nil, err
+	}
+	dp, rr, err := r.revisions(fqn)
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range rr {
+		if res.Revision == rev {
+			res.Deployment = dp
+			return res, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no revision %d found for %s", rev, fqn)
+}
+
+// Diff returns the pod template diff between a given revision and the current
+// revision. The current revision is diffed against its previous revision.
+func (r *RolloutHistory) Diff(path string) (string, error) {
+	fqn, rev, err := parseRevisionPath(path)
+	if err != nil {
+		return "", err
+	}
+	_, rr, err := r.revisions(fqn)
+	if err != nil {
+		return "", err
+	}
+	from, to := -1, -1
+	for i, res := range rr {
+		if res.Revision == rev {
+			from = i
+		}
+		if res.Current {
+			to = i
+		}
+	}
+	if from == -1 || to == -1 {
+		return "", fmt.Errorf("no revision %d found for %s", rev, fqn)
+	}
+	if from == to {
+		if from == len(rr)-1 {
+			return "", errors.New("no previous revision to diff against")
+		}
+		from = to + 1
+	}
+
+	return TemplateDiff(rr[from].ReplicaSet, rr[to].ReplicaSet)
+}
+
+// TemplateDiff returns a unified diff between two revisions pod templates.
+func TemplateDiff(from, to *appsv1.ReplicaSet) (string, error) {
+	a, err := templateYAML(from)
+	if err != nil {
+		return "", err
+	}
+	b, err := templateYAML(to)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(a),
+		B:        difflib.SplitLines(b),
+		FromFile: "revision " + from.Annotations[render.RevisionAnnotation],
+		ToFile:   "revision " + to.Annotations[render.RevisionAnnotation],
+		Context:  3,
+	})
+}
+
+// revisions returns a deployment and its revisions, newest first.
+func (r *RolloutHistory) revisions(path string) (*appsv1.Deployment, []render.RolloutRes, error) {
+	var ddp Deployment
+	ddp.Init(r.Factory, client.NewGVR("apps/v1/deployments"))
+	dp, err := ddp.GetInstance(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	oo, err := r.Factory.List(rsGVR, dp.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rr := make([]render.RolloutRes, 0, len(oo))
+	for _, o := range oo {
+		var rs appsv1.ReplicaSet
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &rs)
+		if err != nil {
+			return nil, nil, errors.New("expecting ReplicaSet resource")
+		}
+		if ref := metav1.GetControllerOf(&rs); ref == nil || ref.UID != dp.UID {
+			continue
+		}
+		rev, err := render.Revision(&rs)
+		if err != nil {
+			continue
+		}
+		rr = append(rr, render.RolloutRes{ReplicaSet: &rs, Revision: rev})
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].Revision > rr[j].Revision
+	})
+	if len(rr) > 0 {
+		rr[0].Current = true
+	}
+
+	return dp, rr, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func parseRevisionPath(path string) (string, int64, error) {
+	fqn, rev, ok := strings.Cut(path, ":")
+	if !ok || rev == "" {
+		return "", 0, fmt.Errorf("invalid path %q", path)
+	}
+	v, err := strconv.ParseInt(rev, 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid revision %q: %w", rev, err)
+	}
+
+	return fqn, v, nil
+}
+
+func templateYAML(rs *appsv1.ReplicaSet) (string, error) {
+	tpl := rs.Spec.Template.DeepCopy()
+	delete(tpl.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+	raw, err := yaml.Marshal(tpl)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
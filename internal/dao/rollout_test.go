@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTemplateDiff(t *testing.T) {
+	from, to := makeRevision("1", "abc", "nginx:1.24"), makeRevision("2", "def", "nginx:1.25")
+
+	diff, err := dao.TemplateDiff(from, to)
+	assert.NoError(t, err)
+	assert.Contains(t, diff, "--- revision 1\n+++ revision 2\n")
+	assert.Contains(t, diff, "-  - image: nginx:1.24\n+  - image: nginx:1.25\n")
+	assert.NotContains(t, diff, appsv1.DefaultDeploymentUniqueLabelKey)
+
+	diff, err = dao.TemplateDiff(from, makeRevision("3", "ghi", "nginx:1.24"))
+	assert.NoError(t, err)
+	assert.Empty(t, diff)
+}
+
+// Helpers...
+
+func makeRevision(rev, hash, img string) *appsv1.ReplicaSet {
+	return &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{render.RevisionAnnotation: rev},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"app": "fred", appsv1.DefaultDeploymentUniqueLabelKey: hash},
+				},
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1", Image: img}}},
+			},
+		},
+	}
+}
@@ -0,0 +1,298 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/util/proto"
+	"k8s.io/kubectl/pkg/util/openapi"
+)
+
+const (
+	arrayItem           = "[]"
+	preserveUnknownsExt = "x-kubernetes-preserve-unknown-fields"
+	intOrStringFormat   = "int-or-string"
+)
+
+var yamlLineRX = regexp.MustCompile(`line (\d+)`)
+
+// SchemaIssue represents a schema validation issue.
+type SchemaIssue struct {
+	Line    int
+	Path    string
+	Message string
+}
+
+// Error returns the issue as a string.
+func (i SchemaIssue) Error() string {
+	return fmt.Sprintf("line %d: %s %s", i.Line, i.Path, i.Message)
+}
+
+// Schema validates and completes resource manifests using the cluster OpenAPI schema.
+type Schema struct {
+	resources openapi.Resources
+}
+
+// NewSchema returns a new schema loaded from the cached discovery client.
+func NewSchema(f Factory) (*Schema, error) {
+	dial, err := f.Client().CachedDiscovery()
+	if err != nil {
+		return nil, err
+	}
+	res, err := openapi.NewOpenAPIParser(dial).Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Schema{resources: res}, nil
+}
+
+func (s *Schema) lookup(apiVersion, kind string) proto.Schema {
+	if s == nil || s.resources == nil || kind == "" {
+		return nil
+	}
+
+	return s.resources.LookupResource(schema.FromAPIVersionAndKind(apiVersion, kind))
+}
+
+// Validate checks a yaml manifest against its resource schema.
+func (s *Schema) Validate(raw string) ([]SchemaIssue, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(raw), &doc); err != nil {
+		return []SchemaIssue{{Line: yamlErrorLine(err), Message: err.Error()}}, nil
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	root := doc.Content[0]
+	apiVersion, kind := topLevelScalar(root, "apiVersion"), topLevelScalar(root, "kind")
+	sc := s.lookup(apiVersion, kind)
+	if sc == nil {
+		return nil, fmt.Errorf("no schema found for %s %s", apiVersion, kind)
+	}
+
+	return validateNode(root, sc, ""), nil
+}
+
+// Complete returns field names that may appear at the given line.
+func (s *Schema) Complete(lines []string, row int, prefix string) []string {
+	var apiVersion, kind string
+	for _, l := range lines {
+		if k, v, ok := strings.Cut(l, ":"); ok {
+			switch k {
+			case "apiVersion":
+				apiVersion = strings.TrimSpace(v)
+			case "kind":
+				kind = strings.TrimSpace(v)
+			}
+		}
+	}
+
+	return completeFields(s.lookup(apiVersion, kind), YAMLPathAt(lines, row), prefix)
+}
+
+// YAMLPathAt returns the field path leading to a given line in a yaml document.
+// Array items are denoted with [].
+func YAMLPathAt(lines []string, row int) []string {
+	if row < 0 || row >= len(lines) {
+		return nil
+	}
+
+	var path []string
+	c, inItem := indentOf(lines[row]), false
+	if t := strings.TrimSpace(lines[row]); isItem(t) {
+		path, inItem = []string{arrayItem}, true
+	}
+	for i := row - 1; i >= 0 && c > 0; i-- {
+		t := strings.TrimSpace(lines[i])
+		if t == "" || strings.HasPrefix(t, "#") {
+			continue
+		}
+		ind := indentOf(lines[i])
+		if isItem(t) {
+			if ind < c {
+				path, c, inItem = append([]string{arrayItem}, path...), ind, true
+			}
+			continue
+		}
+		if ind < c || (inItem && ind == c) {
+			k, _, _ := strings.Cut(t, ":")
+			path, c, inItem = append([]string{k}, path...), ind, false
+		}
+	}
+
+	return path
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func yamlErrorLine(err error) int {
+	mm := yamlLineRX.FindStringSubmatch(err.Error())
+	if len(mm) < 2 {
+		return 1
+	}
+	l, err := strconv.Atoi(mm[1])
+	if err != nil {
+		return 1
+	}
+
+	return l
+}
+
+func isItem(s string) bool {
+	return s == "-" || strings.HasPrefix(s, "- ")
+}
+
+func indentOf(s string) int {
+	return len(s) - len(strings.TrimLeft(s, " "))
+}
+
+func topLevelScalar(n *yaml.Node, key string) string {
+	if n.Kind != yaml.MappingNode {
+		return ""
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1].Value
+		}
+	}
+
+	return ""
+}
+
+func deref(s proto.Schema) proto.Schema {
+	for {
+		r, ok := s.(proto.Reference)
+		if !ok {
+			return s
+		}
+		s = r.SubSchema()
+	}
+}
+
+func preservesUnknowns(k *proto.Kind) bool {
+	v, ok := k.GetExtensions()[preserveUnknownsExt].(bool)
+
+	return ok && v
+}
+
+func completeFields(s proto.Schema, path []string, prefix string) []string {
+	for _, p := range path {
+		switch t := deref(s).(type) {
+		case *proto.Kind:
+			s = t.Fields[p]
+		case *proto.Map:
+			s = t.SubType
+		case *proto.Array:
+			if p != arrayItem {
+				return nil
+			}
+			s = t.SubType
+		default:
+			return nil
+		}
+	}
+	k, ok := deref(s).(*proto.Kind)
+	if !ok {
+		return nil
+	}
+	ff := make([]string, 0, len(k.Fields))
+	for _, f := range k.Keys() {
+		if strings.HasPrefix(f, prefix) {
+			ff = append(ff, f)
+		}
+	}
+	sort.Strings(ff)
+
+	return ff
+}
+
+func validateNode(n *yaml.Node, s proto.Schema, path string) []SchemaIssue {
+	s = deref(s)
+	if s == nil || n.Kind == yaml.AliasNode || n.Tag == "!!null" {
+		return nil
+	}
+
+	var ii []SchemaIssue
+	issue := func(n *yaml.Node, p, msg string) {
+		ii = append(ii, SchemaIssue{Line: n.Line, Path: p, Message: msg})
+	}
+	switch t := s.(type) {
+	case *proto.Kind:
+		if n.Kind != yaml.MappingNode {
+			issue(n, path, "expecting an object")
+			return ii
+		}
+		seen := make(map[string]struct{}, len(n.Content)/2)
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k, v := n.Content[i], n.Content[i+1]
+			seen[k.Value] = struct{}{}
+			f, ok := t.Fields[k.Value]
+			if !ok {
+				if !preservesUnknowns(t) {
+					issue(k, path+"."+k.Value, "unknown field")
+				}
+				continue
+			}
+			ii = append(ii, validateNode(v, f, path+"."+k.Value)...)
+		}
+		for _, r := range t.RequiredFields {
+			if _, ok := seen[r]; !ok {
+				issue(n, path+"."+r, "missing required field")
+			}
+		}
+	case *proto.Map:
+		if n.Kind != yaml.MappingNode {
+			issue(n, path, "expecting a map")
+			return ii
+		}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			ii = append(ii, validateNode(n.Content[i+1], t.SubType, path+"."+n.Content[i].Value)...)
+		}
+	case *proto.Array:
+		if n.Kind != yaml.SequenceNode {
+			issue(n, path, "expecting an array")
+			return ii
+		}
+		for i, c := range n.Content {
+			ii = append(ii, validateNode(c, t.SubType, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	case *proto.Primitive:
+		if n.Kind != yaml.ScalarNode {
+			issue(n, path, "expecting a "+t.Type)
+			return ii
+		}
+		if !primitiveOK(t, n.Tag) {
+			issue(n, path, fmt.Sprintf("expecting a %s but got %q", t.Type, n.Value))
+		}
+	}
+
+	return ii
+}
+
+func primitiveOK(p *proto.Primitive, tag string) bool {
+	if p.Format == intOrStringFormat {
+		return tag == "!!int" || tag == "!!str"
+	}
+	switch p.Type {
+	case "integer":
+		return tag == "!!int"
+	case "number":
+		return tag == "!!int" || tag == "!!float"
+	case "boolean":
+		return tag == "!!bool"
+	case "string":
+		return tag == "!!str" || tag == "!!timestamp"
+	default:
+		return true
+	}
+}
@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+	"k8s.io/kube-openapi/pkg/util/proto"
+)
+
+func testPodSchema() proto.Schema {
+	str, num := &proto.Primitive{Type: "string"}, &proto.Primitive{Type: "integer"}
+	port := &proto.Primitive{Type: "string", Format: intOrStringFormat}
+	co := &proto.Kind{
+		RequiredFields: []string{"name"},
+		Fields: map[string]proto.Schema{
+			"name":          str,
+			"image":         str,
+			"containerPort": num,
+			"targetPort":    port,
+		},
+	}
+
+	return &proto.Kind{
+		Fields: map[string]proto.Schema{
+			"apiVersion": str,
+			"kind":       str,
+			"metadata": &proto.Kind{
+				Fields: map[string]proto.Schema{
+					"name":   str,
+					"labels": &proto.Map{SubType: str},
+				},
+			},
+			"spec": &proto.Kind{
+				Fields: map[string]proto.Schema{
+					"containers": &proto.Array{SubType: co},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateNode(t *testing.T) {
+	uu := map[string]struct {
+		raw string
+		e   []SchemaIssue
+	}{
+		"happy": {
+			raw: `apiVersion: v1
+kind: Pod
+metadata:
+  name: fred
+  labels:
+    app: blee
+spec:
+  containers:
+  - name: c1
+    image: nginx
+    containerPort: 80
+    targetPort: http
+`,
+		},
+		"unknown": {
+			raw: `metadata:
+  name: fred
+  zorg: blee
+`,
+			e: []SchemaIssue{{Line: 3, Path: ".metadata.zorg", Message: "unknown field"}},
+		},
+		"types": {
+			raw: `spec:
+  containers:
+  - name: c1
+    containerPort: eighty
+`,
+			e: []SchemaIssue{{Line: 4, Path: ".spec.containers[0].containerPort", Message: `expecting a integer but got "eighty"`}},
+		},
+		"required": {
+			raw: `spec:
+  containers:
+  - image: nginx
+`,
+			e: []SchemaIssue{{Line: 3, Path: ".spec.containers[0].name", Message: "missing required field"}},
+		},
+		"object": {
+			raw: `metadata: fred
+`,
+			e: []SchemaIssue{{Line: 1, Path: ".metadata", Message: "expecting an object"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var doc yaml.Node
+			assert.NoError(t, yaml.Unmarshal([]byte(u.raw), &doc))
+			assert.Equal(t, u.e, validateNode(doc.Content[0], testPodSchema(), ""))
+		})
+	}
+}
+
+func TestYAMLPathAt(t *testing.T) {
+	lines := []string{
+		"metadata:",
+		"  name: fred",
+		"spec:",
+		"  containers:",
+		"  - name: c1",
+		"    ima",
+		"  - ",
+	}
+	uu := map[string]struct {
+		row int
+		e   []string
+	}{
+		"top":        {row: 0},
+		"metadata":   {row: 1, e: []string{"metadata"}},
+		"spec":       {row: 3, e: []string{"spec"}},
+		"item":       {row: 4, e: []string{"spec", "containers", "[]"}},
+		"inItem":     {row: 5, e: []string{"spec", "containers", "[]"}},
+		"newItem":    {row: 6, e: []string{"spec", "containers", "[]"}},
+		"outOfBound": {row: 10},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, YAMLPathAt(lines, u.row))
+		})
+	}
+}
+
+func TestCompleteFields(t *testing.T) {
+	uu := map[string]struct {
+		path   []string
+		prefix string
+		e      []string
+	}{
+		"top":      {e: []string{"apiVersion", "kind", "metadata", "spec"}},
+		"prefix":   {path: []string{"metadata"}, prefix: "l", e: []string{"labels"}},
+		"item":     {path: []string{"spec", "containers", "[]"}, prefix: "i", e: []string{"image"}},
+		"map":      {path: []string{"metadata", "labels"}},
+		"noSchema": {path: []string{"zorg"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, completeFields(testPodSchema(), u.path, u.prefix))
+		})
+	}
+}
+
+func TestSchemaValidateSyntax(t *testing.T) {
+	var s *Schema
+	ii, err := s.Validate("a: b\n  c: d\n")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(ii))
+	assert.Equal(t, 2, ii[0].Line)
+}
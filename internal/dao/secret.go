@@ -4,15 +4,38 @@
 package dao
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"sort"
 	"strings"
+	"unicode/utf8"
 
+	"github.com/derailed/k9s/internal/client"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
 )
 
+const (
+	secretEditHeader = `# Edit the decoded secret values below. Values are re-encoded on save.
+# Add or remove keys as needed. To import values from local files, list them
+# under a fromFile section ie fromFile: {tls.crt: /path/to/cert.pem}
+`
+	secretBinaryHeader = "# Binary keys are preserved as is: "
+)
+
+// secretEdit represents an editable secret document.
+type secretEdit struct {
+	StringData map[string]string `json:"stringData"`
+	FromFile   map[string]string `json:"fromFile,omitempty"`
+}
+
 // Secret represents a secret K8s resource.
 type Secret struct {
 	Resource
@@ -100,3 +123,128 @@ func ExtractSecrets(o *unstructured.Unstructured) (map[string]string, error) {
 
 	return secretData, nil
 }
+
+// ToEditYAML returns a secret along with its decoded string data ready to edit.
+// The secret must be handed back on update to detect concurrent changes.
+func (s *Secret) ToEditYAML(ctx context.Context, path string) (*v1.Secret, string, error) {
+	sec, err := s.fetchSecret(ctx, path)
+	if err != nil {
+		return nil, "", err
+	}
+	raw, err := DecodeSecretData(sec)
+
+	return sec, raw, err
+}
+
+// UpdateFromEditYAML re-encodes an edited secret document and updates the secret
+// as of its edited resource version. Changes made since the edit started are not
+// overwritten and a conflict error is returned instead.
+func (s *Secret) UpdateFromEditYAML(ctx context.Context, sec *v1.Secret, raw string) error {
+	path := client.FQN(sec.Namespace, sec.Name)
+	auth, err := s.Client().CanI(sec.Namespace, s.gvrStr(), sec.Name, []string{client.UpdateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update %s", path)
+	}
+
+	upd := sec.DeepCopy()
+	if upd.Data, err = EncodeSecretData(sec, raw, os.ReadFile); err != nil {
+		return err
+	}
+	upd.StringData = nil
+	dial, err := s.Client().Dial()
+	if err != nil {
+		return err
+	}
+	_, err = dial.CoreV1().Secrets(sec.Namespace).Update(ctx, upd, metav1.UpdateOptions{})
+	if apierrors.IsConflict(err) {
+		return fmt.Errorf("secret %s was modified while being edited, please retry: %w", path, err)
+	}
+
+	return err
+}
+
+func (s *Secret) fetchSecret(ctx context.Context, path string) (*v1.Secret, error) {
+	dial, err := s.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	ns, n := client.Namespaced(path)
+
+	return dial.CoreV1().Secrets(ns).Get(ctx, n, metav1.GetOptions{})
+}
+
+// DecodeSecretData returns a yaml document listing a secret decoded values.
+// Binary values are omitted.
+func DecodeSecretData(sec *v1.Secret) (string, error) {
+	edit := secretEdit{StringData: make(map[string]string, len(sec.Data))}
+	var bins []string
+	for k, v := range sec.Data {
+		if !utf8.Valid(v) {
+			bins = append(bins, k)
+			continue
+		}
+		edit.StringData[k] = string(v)
+	}
+	raw, err := yaml.Marshal(edit)
+	if err != nil {
+		return "", err
+	}
+
+	header := secretEditHeader
+	if len(bins) > 0 {
+		sort.Strings(bins)
+		header += secretBinaryHeader + strings.Join(bins, ", ") + "\n"
+	}
+
+	return header + string(raw), nil
+}
+
+// EncodeSecretData merges an edited yaml document with a secret binary values.
+func EncodeSecretData(sec *v1.Secret, raw string, readFile func(string) ([]byte, error)) (map[string][]byte, error) {
+	var edit secretEdit
+	if err := yaml.Unmarshal([]byte(raw), &edit); err != nil {
+		return nil, err
+	}
+
+	data := make(map[string][]byte, len(edit.StringData))
+	for k, v := range sec.Data {
+		if !utf8.Valid(v) {
+			data[k] = v
+		}
+	}
+	for k, v := range edit.StringData {
+		if err := checkSecretKey(data, k); err != nil {
+			return nil, err
+		}
+		data[k] = []byte(v)
+	}
+	for k, f := range edit.FromFile {
+		if _, ok := edit.StringData[k]; ok {
+			return nil, fmt.Errorf("key %q can not be both set and imported", k)
+		}
+		if err := checkSecretKey(data, k); err != nil {
+			return nil, err
+		}
+		bb, err := readFile(strings.TrimSpace(f))
+		if err != nil {
+			return nil, fmt.Errorf("unable to import key %q: %w", k, err)
+		}
+		data[k] = bb
+	}
+
+	return data, nil
+}
+
+func checkSecretKey(data map[string][]byte, k string) error {
+	if errs := validation.IsConfigMapKey(k); len(errs) > 0 {
+		return fmt.Errorf("invalid secret key %q: %s", k, strings.Join(errs, ", "))
+	}
+	if _, ok := data[k]; ok {
+		return fmt.Errorf("binary key %q can not be edited", k)
+	}
+
+	return nil
+}
@@ -4,11 +4,13 @@
 package dao_test
 
 import (
+	"os"
 	"testing"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
 )
 
 func TestEncodedSecretDescribe(t *testing.T) {
@@ -42,3 +44,93 @@ token-secret:  24 bytes`
 	decodedDescription, _ := s.Decode(encodedString, "kube-system/bootstrap-token-abcdef")
 	assert.Equal(t, expected, decodedDescription)
 }
+
+func TestSecretDataRoundTrip(t *testing.T) {
+	sec := v1.Secret{
+		Data: map[string][]byte{
+			"user": []byte("fred"),
+			"pwd":  []byte("s3cr3t"),
+			"bin":  {0xff, 0xfe, 0x00},
+		},
+	}
+
+	raw, err := dao.DecodeSecretData(&sec)
+	assert.NoError(t, err)
+	assert.Contains(t, raw, "# Binary keys are preserved as is: bin\n")
+	assert.Contains(t, raw, "stringData:\n  pwd: s3cr3t\n  user: fred\n")
+
+	files := map[string][]byte{"/tmp/cert.pem": []byte("CERT")}
+	readFile := func(p string) ([]byte, error) {
+		if bb, ok := files[p]; ok {
+			return bb, nil
+		}
+		return nil, os.ErrNotExist
+	}
+
+	uu := map[string]struct {
+		raw string
+		e   map[string][]byte
+		err string
+	}{
+		"unchanged": {
+			raw: raw,
+			e:   sec.Data,
+		},
+		"addRemove": {
+			raw: "stringData:\n  user: blee\n  token: abc\n",
+			e: map[string][]byte{
+				"user":  []byte("blee"),
+				"token": []byte("abc"),
+				"bin":   {0xff, 0xfe, 0x00},
+			},
+		},
+		"import": {
+			raw: "stringData:\n  user: fred\nfromFile:\n  cert: /tmp/cert.pem\n",
+			e: map[string][]byte{
+				"user": []byte("fred"),
+				"cert": []byte("CERT"),
+				"bin":  {0xff, 0xfe, 0x00},
+			},
+		},
+		"literalFileRef": {
+			raw: "stringData:\n  ref: \"@file:/tmp/cert.pem\"\n",
+			e: map[string][]byte{
+				"ref": []byte("@file:/tmp/cert.pem"),
+				"bin": {0xff, 0xfe, 0x00},
+			},
+		},
+		"missingFile": {
+			raw: "fromFile:\n  cert: /tmp/zorg\n",
+			err: `unable to import key "cert": file does not exist`,
+		},
+		"setAndImport": {
+			raw: "stringData:\n  cert: fred\nfromFile:\n  cert: /tmp/cert.pem\n",
+			err: `key "cert" can not be both set and imported`,
+		},
+		"importBinary": {
+			raw: "fromFile:\n  bin: /tmp/cert.pem\n",
+			err: `binary key "bin" can not be edited`,
+		},
+		"binary": {
+			raw: "stringData:\n  bin: fred\n",
+			err: `binary key "bin" can not be edited`,
+		},
+		"badKey": {
+			raw: "stringData:\n  a/b: fred\n",
+			err: `invalid secret key "a/b"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			data, err := dao.EncodeSecretData(&sec, u.raw, readFile)
+			if u.err != "" {
+				assert.ErrorContains(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, data)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -39,7 +40,7 @@ func (t *Table) Get(ctx context.Context, path string) (runtime.Object, error) {
 		SetHeader("Accept", a).
 		Name(n).
 		Resource(t.gvr.R()).
-		VersionedParams(&metav1.TableOptions{}, p)
+		VersionedParams(t.tableOptions(ctx), p)
 	if ns != client.ClusterScope {
 		req = req.Namespace(ns)
 	}
@@ -58,16 +59,24 @@ func (t *Table) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		return nil, err
 	}
 	a := fmt.Sprintf(gvFmt, metav1.SchemeGroupVersion.Version, metav1.GroupName)
+	opts := metav1.ListOptions{
+		LabelSelector:        labelSel,
+		FieldSelector:        fieldSel,
+		ResourceVersion:      "0",
+		ResourceVersionMatch: v1.ResourceVersionMatchNotOlderThan,
+	}
+	topts := t.tableOptions(ctx)
+	if pager, ok := t.getFactory().(Pager); ok && pager.IsLarge(t.gvrStr(), ns) {
+		return t.listChunks(ctx, func() *rest.Request {
+			return c.Get().SetHeader("Accept", a).Namespace(ns).Resource(t.gvr.R()).VersionedParams(topts, p)
+		}, p, opts, pager.PageSize())
+	}
 	o, err := c.Get().
 		SetHeader("Accept", a).
 		Namespace(ns).
 		Resource(t.gvr.R()).
-		VersionedParams(&metav1.ListOptions{
-			LabelSelector:        labelSel,
-			FieldSelector:        fieldSel,
-			ResourceVersion:      "0",
-			ResourceVersionMatch: v1.ResourceVersionMatchNotOlderThan,
-		}, p).
+		VersionedParams(topts, p).
+		VersionedParams(&opts, p).
 		Do(ctx).Get()
 	if err != nil {
 		return nil, err
@@ -76,9 +85,52 @@ func (t *Table) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	return []runtime.Object{o}, nil
 }
 
+// listChunks lists very large resources in pages and merges them into a single table.
+func (*Table) listChunks(ctx context.Context, req func() *rest.Request, p runtime.ParameterCodec, opts metav1.ListOptions, size int) ([]runtime.Object, error) {
+	// Paginated lists must be served by etcd.
+	opts.ResourceVersion, opts.ResourceVersionMatch, opts.Limit = "", "", int64(size)
+
+	var table *metav1.Table
+	for {
+		o, err := req().VersionedParams(&opts, p).Do(ctx).Get()
+		if err != nil {
+			return nil, err
+		}
+		tt, ok := o.(*metav1.Table)
+		if !ok {
+			return nil, fmt.Errorf("expected Table but got %T", o)
+		}
+		if table == nil {
+			table = tt
+		} else {
+			table.Rows = append(table.Rows, tt.Rows...)
+		}
+		if opts.Continue = tt.Continue; opts.Continue == "" {
+			break
+		}
+	}
+	table.Continue, table.RemainingItemCount = "", nil
+
+	return []runtime.Object{table}, nil
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// tableOptions requests full row objects when custom columns are defined for the
+// resource, as they may reference any object field. Rows only carry metadata otherwise.
+func (t *Table) tableOptions(ctx context.Context) *metav1.TableOptions {
+	cfg, ok := ctx.Value(internal.KeyViewConfig).(*config.CustomView)
+	if !ok || cfg == nil {
+		return &metav1.TableOptions{}
+	}
+	if vs, ok := cfg.Views[t.gvrStr()]; ok && len(vs.CustomColumns()) > 0 {
+		return &metav1.TableOptions{IncludeObject: v1.IncludeObject}
+	}
+
+	return &metav1.TableOptions{}
+}
+
 func (t *Table) getClient(f serializer.CodecFactory) (*rest.RESTClient, error) {
 	cfg, err := t.Client().RestConfig()
 	if err != nil {
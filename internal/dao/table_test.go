@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	restclient "k8s.io/client-go/rest"
+)
+
+func TestTableListIncludeObject(t *testing.T) {
+	gvr := client.NewGVR("fred.com/v1/blees")
+	uu := map[string]struct {
+		views map[string]config.ViewSetting
+		e     string
+	}{
+		"none": {
+			e: "",
+		},
+		"columns": {
+			views: map[string]config.ViewSetting{
+				gvr.String(): {Columns: []string{"NAME", "AGE"}},
+			},
+			e: "",
+		},
+		"custom": {
+			views: map[string]config.ViewSetting{
+				gvr.String(): {Columns: []string{"NAME", "PHASE:.status.phase"}},
+			},
+			e: "Object",
+		},
+		"otherResource": {
+			views: map[string]config.ViewSetting{
+				"v1/pods": {Columns: []string{"PHASE:.status.phase"}},
+			},
+			e: "",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			srv := newTableServer(t, 1)
+			var tb dao.Table
+			tb.Init(newTableFactory(srv.URL), gvr)
+
+			cv := config.NewCustomView()
+			cv.Views = u.views
+			ctx := context.WithValue(context.Background(), internal.KeyViewConfig, cv)
+			oo, err := tb.List(ctx, "ns1")
+			require.NoError(t, err)
+			assert.Len(t, oo, 1)
+
+			qq := srv.queries()
+			require.Len(t, qq, 1)
+			assert.Equal(t, u.e, qq[0].Get("includeObject"))
+		})
+	}
+}
+
+func TestTableListChunks(t *testing.T) {
+	uu := map[string]struct {
+		large bool
+		pages int
+		rows  []string
+	}{
+		"small": {
+			pages: 3,
+			rows:  []string{"row"},
+		},
+		"large": {
+			large: true,
+			pages: 3,
+			rows:  []string{"row", "p1row", "p2row"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			srv := newTableServer(t, u.pages)
+			f := pagerFactory{tableFactory: newTableFactory(srv.URL), large: u.large}
+			var tb dao.Table
+			tb.Init(&f, client.NewGVR("fred.com/v1/blees"))
+
+			oo, err := tb.List(context.Background(), "ns1")
+			require.NoError(t, err)
+			require.Len(t, oo, 1)
+			table, ok := oo[0].(*metav1.Table)
+			require.True(t, ok)
+			rr := make([]string, 0, len(table.Rows))
+			for _, r := range table.Rows {
+				rr = append(rr, r.Cells[0].(string))
+			}
+			assert.Equal(t, u.rows, rr)
+			assert.Empty(t, table.Continue)
+
+			qq := srv.queries()
+			require.Len(t, qq, len(u.rows))
+			for _, q := range qq {
+				if u.large {
+					assert.Equal(t, "2", q.Get("limit"))
+					assert.Empty(t, q.Get("resourceVersion"))
+				} else {
+					assert.Empty(t, q.Get("limit"))
+					assert.Equal(t, "0", q.Get("resourceVersion"))
+				}
+			}
+		})
+	}
+}
+
+// Helpers...
+
+type tableServer struct {
+	*httptest.Server
+
+	qq []url.Values
+	mx sync.Mutex
+}
+
+// newTableServer serves meta tables in pages of a single row.
+func newTableServer(t *testing.T, rows int) *tableServer {
+	var s tableServer
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mx.Lock()
+		s.qq = append(s.qq, r.URL.Query())
+		page := len(s.qq)
+		s.mx.Unlock()
+
+		tt := metav1.Table{
+			TypeMeta:          metav1.TypeMeta{APIVersion: "meta.k8s.io/v1", Kind: "Table"},
+			ColumnDefinitions: []metav1.TableColumnDefinition{{Name: "Name"}},
+			Rows: []metav1.TableRow{
+				{Cells: []interface{}{r.URL.Query().Get("continue") + "row"}, Object: runtime.RawExtension{Raw: []byte(`{"metadata":{"name":"n"}}`)}},
+			},
+		}
+		if r.URL.Query().Get("limit") != "" && page < rows {
+			tt.Continue = "p" + string(rune('0'+page))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(tt)
+	}))
+	t.Cleanup(s.Close)
+
+	return &s
+}
+
+func (s *tableServer) queries() []url.Values {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return s.qq
+}
+
+type restConn struct {
+	conn
+	url string
+}
+
+func (c *restConn) RestConfig() (*restclient.Config, error) {
+	return &restclient.Config{Host: c.url}, nil
+}
+
+type tableFactory struct {
+	testFactory
+	conn *restConn
+}
+
+func newTableFactory(url string) *tableFactory {
+	return &tableFactory{conn: &restConn{url: url}}
+}
+
+func (f *tableFactory) Client() client.Connection {
+	return f.conn
+}
+
+type pagerFactory struct {
+	*tableFactory
+
+	large bool
+}
+
+var _ dao.Pager = (*pagerFactory)(nil)
+
+func (f *pagerFactory) IsLarge(string, string) bool               { return f.large }
+func (f *pagerFactory) IsSized(string, string) bool               { return true }
+func (f *pagerFactory) SizeResource(string, string) (bool, error) { return f.large, nil }
+func (*pagerFactory) PageSize() int                               { return 2 }
+func (*pagerFactory) Resync() time.Duration                       { return time.Minute }
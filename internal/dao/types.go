@@ -176,3 +176,21 @@ type Valuer interface {
 	// GetValues returns values for a resource.
 	GetValues(path string, allValues bool) ([]byte, error)
 }
+
+// Pager represents a factory listing very large resources in chunks.
+type Pager interface {
+	// IsLarge checks if a resource was sized as too large to be cached.
+	IsLarge(gvr, ns string) bool
+
+	// IsSized checks if a resource size is known.
+	IsSized(gvr, ns string) bool
+
+	// SizeResource probes a resource size.
+	SizeResource(gvr, ns string) (bool, error)
+
+	// PageSize returns the chunked listing page size.
+	PageSize() int
+
+	// Resync returns how often large resources are listed.
+	Resync() time.Duration
+}
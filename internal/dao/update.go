@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	sigyaml "sigs.k8s.io/yaml"
+)
+
+// UpdateYAML updates a resource from its yaml manifest. The update is
+// validated server side only if dryRun is set.
+func UpdateYAML(ctx context.Context, f Factory, gvr client.GVR, path, raw string, dryRun bool) error {
+	ns, n := client.Namespaced(path)
+	auth, err := f.Client().CanI(ns, gvr.String(), n, []string{client.UpdateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update %s", path)
+	}
+
+	var u unstructured.Unstructured
+	if err := sigyaml.Unmarshal([]byte(raw), &u.Object); err != nil {
+		return err
+	}
+	if u.GetName() != n {
+		return fmt.Errorf("resource name can not be changed (%s vs %s)", u.GetName(), n)
+	}
+	opts := metav1.UpdateOptions{FieldValidation: metav1.FieldValidationStrict}
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+
+	dial, err := f.Client().DynDial()
+	if err != nil {
+		return err
+	}
+	if client.IsClusterScoped(ns) {
+		_, err = dial.Resource(gvr.GVR()).Update(ctx, &u, opts)
+	} else {
+		_, err = dial.Resource(gvr.GVR()).Namespace(ns).Update(ctx, &u, opts)
+	}
+
+	return err
+}
@@ -0,0 +1,442 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// UsagePercentile represents the usage percentile used for recommendations.
+	UsagePercentile = 95
+
+	// MinUsageSamples represents the min number of samples required for a recommendation.
+	MinUsageSamples = 3
+
+	cpuHeadroom   = 1.1
+	memHeadroom   = 1.1
+	memLimitRoom  = 1.25
+	minCPURequest = 10
+	mebi          = 1024 * 1024
+)
+
+var (
+	sampler   *UsageSampler
+	samplerMx sync.RWMutex
+)
+
+// SetSampler sets the active context container usage sampler if any.
+func SetSampler(s *UsageSampler) {
+	samplerMx.Lock()
+	defer samplerMx.Unlock()
+	sampler = s
+}
+
+// Sampler returns the active context container usage sampler if enabled.
+func Sampler() *UsageSampler {
+	samplerMx.RLock()
+	defer samplerMx.RUnlock()
+
+	return sampler
+}
+
+var _ Accessor = (*RightSizing)(nil)
+
+// RightSizing represents containers resources recommendations.
+type RightSizing struct {
+	NonResource
+}
+
+// List returns containers usage and their recommendations.
+func (r *RightSizing) List(_ context.Context, ns string) ([]runtime.Object, error) {
+	s := Sampler()
+	if s == nil {
+		return nil, errors.New("right sizing is disabled. Check your k9s config")
+	}
+	uu := s.Usage()
+	oo := make([]runtime.Object, 0, len(uu))
+	for i := range uu {
+		u := &uu[i]
+		if !client.IsAllNamespaces(ns) && u.Namespace != ns {
+			continue
+		}
+		rec := u.Recommend()
+		oo = append(oo, render.RightSizingRes{
+			Namespace:  u.Namespace,
+			Kind:       u.Kind,
+			Workload:   u.Workload,
+			Container:  u.Container,
+			Samples:    len(u.Samples),
+			Requests:   u.Requests,
+			Limits:     u.Limits,
+			CPUP95:     rec.CPUP95,
+			MEMP95:     rec.MEMP95,
+			CPURequest: rec.CPURequest,
+			MEMRequest: rec.MEMRequest,
+			MEMLimit:   rec.MEMLimit,
+		})
+	}
+
+	return oo, nil
+}
+
+// UsageSample represents a container usage sample.
+type UsageSample struct {
+	At  time.Time `json:"at"`
+	CPU int64     `json:"cpu"`
+	MEM int64     `json:"mem"`
+}
+
+// ContainerUsage tracks a workload container usage samples.
+type ContainerUsage struct {
+	Namespace string          `json:"namespace"`
+	Kind      string          `json:"kind"`
+	Workload  string          `json:"workload"`
+	Container string          `json:"container"`
+	Requests  v1.ResourceList `json:"requests,omitempty"`
+	Limits    v1.ResourceList `json:"limits,omitempty"`
+	Samples   []UsageSample   `json:"samples"`
+}
+
+// ID returns a container usage unique identifier.
+func (c *ContainerUsage) ID() string {
+	return strings.Join([]string{c.Namespace, c.Kind, c.Workload, c.Container}, "/")
+}
+
+// Recommendation represents container resources suggestions based on observed usage.
+type Recommendation struct {
+	CPUP95, MEMP95, MEMMax int64
+	CPURequest, MEMRequest *resource.Quantity
+	MEMLimit               *resource.Quantity
+}
+
+// Recommend computes resources suggestions from the usage samples. Suggestions
+// are only issued once enough samples are collected.
+func (c *ContainerUsage) Recommend() Recommendation {
+	cc, mm := make([]int64, 0, len(c.Samples)), make([]int64, 0, len(c.Samples))
+	for _, s := range c.Samples {
+		cc, mm = append(cc, s.CPU), append(mm, s.MEM)
+	}
+	r := Recommendation{
+		CPUP95: Percentile(cc, UsagePercentile),
+		MEMP95: Percentile(mm, UsagePercentile),
+		MEMMax: Percentile(mm, 100),
+	}
+	if len(c.Samples) < MinUsageSamples {
+		return r
+	}
+	cpu := max(int64(math.Ceil(float64(r.CPUP95)*cpuHeadroom)), minCPURequest)
+	r.CPURequest = resource.NewMilliQuantity(cpu, resource.DecimalSI)
+	r.MEMRequest = resource.NewQuantity(roundMebi(float64(r.MEMP95)*memHeadroom), resource.BinarySI)
+	r.MEMLimit = resource.NewQuantity(roundMebi(float64(r.MEMMax)*memLimitRoom), resource.BinarySI)
+
+	return r
+}
+
+// Percentile returns the nearest rank percentile of a series.
+func Percentile(vv []int64, p int) int64 {
+	if len(vv) == 0 {
+		return 0
+	}
+	ss := append([]int64(nil), vv...)
+	sort.Slice(ss, func(i, j int) bool { return ss[i] < ss[j] })
+	rank := int(math.Ceil(float64(p)/100*float64(len(ss)))) - 1
+
+	return ss[max(0, min(rank, len(ss)-1))]
+}
+
+// UsageSampler periodically samples containers usage.
+type UsageSampler struct {
+	factory Factory
+	cfg     config.RightSizing
+	path    string
+	nsFn    func() string
+	usage   map[string]*ContainerUsage
+	mx      sync.RWMutex
+}
+
+// NewUsageSampler returns a new sampler. Samples are persisted to path if enabled.
+func NewUsageSampler(f Factory, cfg config.RightSizing, path string, nsFn func() string) *UsageSampler {
+	return &UsageSampler{
+		factory: f,
+		cfg:     cfg,
+		path:    path,
+		nsFn:    nsFn,
+		usage:   make(map[string]*ContainerUsage),
+	}
+}
+
+// Run samples usage until canceled. Samples are saved after each pass when
+// persistence is enabled.
+func (s *UsageSampler) Run(ctx context.Context) {
+	if s.cfg.Persist {
+		if err := s.load(); err != nil {
+			log.Warn().Err(err).Msgf("Usage samples load failed")
+		}
+	}
+
+	for {
+		if err := s.Sample(ctx); err != nil {
+			log.Warn().Err(err).Msgf("Usage sampling failed")
+		}
+		if s.cfg.Persist {
+			if err := s.save(); err != nil {
+				log.Warn().Err(err).Msgf("Usage samples save failed")
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.cfg.Interval()):
+		}
+	}
+}
+
+// Sample collects the current usage for all pods in the active namespace.
+func (s *UsageSampler) Sample(ctx context.Context) error {
+	if !s.factory.Client().HasMetrics() {
+		return nil
+	}
+	ns := client.CleanseNamespace(s.nsFn())
+	mx, err := client.DialMetrics(s.factory.Client()).FetchPodsMetrics(ctx, ns)
+	if err != nil {
+		return err
+	}
+	oo, err := s.factory.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	pods := make(map[string]*v1.Pod, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+		if err != nil {
+			return errors.New("expecting Pod resource")
+		}
+		pods[client.FQN(po.Namespace, po.Name)] = &po
+	}
+
+	now := time.Now()
+	for i := range mx.Items {
+		po, ok := pods[client.FQN(mx.Items[i].Namespace, mx.Items[i].Name)]
+		if !ok {
+			continue
+		}
+		kind, name := s.workloadFor(po)
+		s.Record(po, kind, name, &mx.Items[i], now)
+	}
+	s.prune(now)
+
+	return nil
+}
+
+// Record adds a pod containers usage sample.
+func (s *UsageSampler) Record(po *v1.Pod, kind, name string, mx *mv1beta1.PodMetrics, at time.Time) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	for _, cmx := range mx.Containers {
+		u := ContainerUsage{Namespace: po.Namespace, Kind: kind, Workload: name, Container: cmx.Name}
+		cu, ok := s.usage[u.ID()]
+		if !ok {
+			cu = &u
+			s.usage[u.ID()] = cu
+		}
+		for _, co := range po.Spec.Containers {
+			if co.Name == cmx.Name {
+				cu.Requests, cu.Limits = co.Resources.Requests, co.Resources.Limits
+				break
+			}
+		}
+		cu.Samples = append(cu.Samples, UsageSample{
+			At:  at,
+			CPU: cmx.Usage.Cpu().MilliValue(),
+			MEM: cmx.Usage.Memory().Value(),
+		})
+	}
+}
+
+// Usage returns a snapshot of all containers usage sorted by id.
+func (s *UsageSampler) Usage() []ContainerUsage {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	uu := make([]ContainerUsage, 0, len(s.usage))
+	for _, u := range s.usage {
+		c := *u
+		c.Samples = append([]UsageSample(nil), u.Samples...)
+		uu = append(uu, c)
+	}
+	sort.Slice(uu, func(i, j int) bool {
+		return uu[i].ID() < uu[j].ID()
+	})
+
+	return uu
+}
+
+func (s *UsageSampler) prune(now time.Time) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	cutoff := now.Add(-s.cfg.RetentionDuration())
+	for id, u := range s.usage {
+		idx := sort.Search(len(u.Samples), func(i int) bool {
+			return u.Samples[i].At.After(cutoff)
+		})
+		u.Samples = u.Samples[idx:]
+		if len(u.Samples) == 0 {
+			delete(s.usage, id)
+		}
+	}
+}
+
+func (s *UsageSampler) workloadFor(po *v1.Pod) (string, string) {
+	ref := metav1.GetControllerOf(po)
+	if ref == nil {
+		return "Pod", po.Name
+	}
+	var gvr string
+	switch ref.Kind {
+	case "ReplicaSet":
+		gvr = rsGVR
+	case "Job":
+		gvr = jobGVR
+	default:
+		return ref.Kind, ref.Name
+	}
+	o, err := s.factory.Get(gvr, client.FQN(po.Namespace, ref.Name), true, labels.Everything())
+	if err != nil {
+		return ref.Kind, ref.Name
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return ref.Kind, ref.Name
+	}
+	if owner := metav1.GetControllerOf(u); owner != nil {
+		return owner.Kind, owner.Name
+	}
+
+	return ref.Kind, ref.Name
+}
+
+func (s *UsageSampler) load() error {
+	raw, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var uu []ContainerUsage
+	if err := json.Unmarshal(raw, &uu); err != nil {
+		return err
+	}
+
+	s.mx.Lock()
+	for i := range uu {
+		s.usage[uu[i].ID()] = &uu[i]
+	}
+	s.mx.Unlock()
+	s.prune(time.Now())
+
+	return nil
+}
+
+func (s *UsageSampler) save() error {
+	if err := data.EnsureDirPath(s.path, data.DefaultDirMod); err != nil {
+		return err
+	}
+	raw, err := json.Marshal(s.Usage())
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.path, raw, data.DefaultFileMod)
+}
+
+// RightSizingPatch returns strategic merge patches applying the recommended
+// resources to their workloads. Bare pods are skipped.
+func RightSizingPatch(uu []ContainerUsage) (string, error) {
+	type workload struct {
+		ns, kind, name string
+		cc             []interface{}
+	}
+	var ww []*workload
+	idx := make(map[string]*workload)
+	for i := range uu {
+		u := &uu[i]
+		r := u.Recommend()
+		if r.CPURequest == nil || u.Kind == "Pod" {
+			continue
+		}
+		id := filepath.Join(u.Namespace, u.Kind, u.Workload)
+		w, ok := idx[id]
+		if !ok {
+			w = &workload{ns: u.Namespace, kind: u.Kind, name: u.Workload}
+			idx[id] = w
+			ww = append(ww, w)
+		}
+		w.cc = append(w.cc, map[string]interface{}{
+			"name": u.Container,
+			"resources": map[string]interface{}{
+				"requests": map[string]string{
+					"cpu":    r.CPURequest.String(),
+					"memory": r.MEMRequest.String(),
+				},
+				"limits": map[string]string{
+					"memory": r.MEMLimit.String(),
+				},
+			},
+		})
+	}
+
+	var b strings.Builder
+	for i, w := range ww {
+		podSpec := map[string]interface{}{"containers": w.cc}
+		tpl := map[string]interface{}{"template": map[string]interface{}{"spec": podSpec}}
+		if w.kind == "CronJob" {
+			tpl = map[string]interface{}{"jobTemplate": map[string]interface{}{"spec": tpl}}
+		}
+		raw, err := yaml.Marshal(map[string]interface{}{"spec": tpl})
+		if err != nil {
+			return "", err
+		}
+		if i > 0 {
+			b.WriteString("---\n")
+		}
+		fmt.Fprintf(&b, "# kubectl patch %s %s -n %s --patch-file <file>\n", strings.ToLower(w.kind), w.name, w.ns)
+		b.Write(raw)
+	}
+
+	return b.String(), nil
+}
+
+func roundMebi(v float64) int64 {
+	return int64(math.Ceil(v/mebi)) * mebi
+}
@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestPercentile(t *testing.T) {
+	uu := map[string]struct {
+		vv []int64
+		p  int
+		e  int64
+	}{
+		"empty": {
+			p: 95,
+		},
+		"single": {
+			vv: []int64{10},
+			p:  95,
+			e:  10,
+		},
+		"p95": {
+			vv: []int64{5, 1, 4, 2, 3, 10, 9, 8, 7, 6, 20, 19, 18, 17, 16, 15, 14, 13, 12, 11},
+			p:  95,
+			e:  19,
+		},
+		"p50": {
+			vv: []int64{4, 1, 3, 2},
+			p:  50,
+			e:  2,
+		},
+		"max": {
+			vv: []int64{4, 1, 3, 2},
+			p:  100,
+			e:  4,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.Percentile(u.vv, u.p))
+		})
+	}
+}
+
+func TestRecommend(t *testing.T) {
+	uu := map[string]struct {
+		samples      []dao.UsageSample
+		cpu, mem, ml string
+	}{
+		"not-enough": {
+			samples: makeUsageSamples([]int64{100, 200}, []int64{mebi(10), mebi(20)}),
+		},
+		"plain": {
+			samples: makeUsageSamples([]int64{100, 200, 300}, []int64{mebi(100), mebi(200), mebi(300)}),
+			cpu:     "330m",
+			mem:     "330Mi",
+			ml:      "375Mi",
+		},
+		"min-cpu": {
+			samples: makeUsageSamples([]int64{1, 2, 3}, []int64{mebi(1), mebi(1), mebi(1)}),
+			cpu:     "10m",
+			mem:     "2Mi",
+			ml:      "2Mi",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := dao.ContainerUsage{Samples: u.samples}
+			r := c.Recommend()
+			if u.cpu == "" {
+				assert.Nil(t, r.CPURequest)
+				assert.Nil(t, r.MEMRequest)
+				assert.Nil(t, r.MEMLimit)
+				return
+			}
+			assert.Equal(t, u.cpu, r.CPURequest.String())
+			assert.Equal(t, u.mem, r.MEMRequest.String())
+			assert.Equal(t, u.ml, r.MEMLimit.String())
+		})
+	}
+}
+
+func TestUsageSamplerRecord(t *testing.T) {
+	s := dao.NewUsageSampler(nil, config.NewRightSizing(), "", nil)
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fred-abc"},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name: "c1",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("100m")},
+					},
+				},
+			},
+		},
+	}
+	mx := mv1beta1.PodMetrics{
+		Containers: []mv1beta1.ContainerMetrics{
+			{
+				Name: "c1",
+				Usage: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("20m"),
+					v1.ResourceMemory: resource.MustParse("10Mi"),
+				},
+			},
+		},
+	}
+	now := time.Now()
+	s.Record(&po, "Deployment", "fred", &mx, now.Add(-time.Minute))
+	s.Record(&po, "Deployment", "fred", &mx, now)
+
+	uu := s.Usage()
+	assert.Equal(t, 1, len(uu))
+	assert.Equal(t, "default/Deployment/fred/c1", uu[0].ID())
+	assert.Equal(t, 2, len(uu[0].Samples))
+	assert.Equal(t, int64(20), uu[0].Samples[0].CPU)
+	assert.Equal(t, int64(mebi(10)), uu[0].Samples[0].MEM)
+	assert.Equal(t, "100m", uu[0].Requests.Cpu().String())
+}
+
+func TestSampler(t *testing.T) {
+	defer dao.SetSampler(nil)
+
+	s := dao.NewUsageSampler(nil, config.NewRightSizing(), "", nil)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		dao.SetSampler(s)
+	}()
+	go func() {
+		defer wg.Done()
+		_ = dao.Sampler()
+	}()
+	wg.Wait()
+	assert.Same(t, s, dao.Sampler())
+
+	dao.SetSampler(nil)
+	_, err := new(dao.RightSizing).List(context.Background(), "")
+	assert.ErrorContains(t, err, "right sizing is disabled")
+}
+
+func TestRightSizingPatch(t *testing.T) {
+	ss := makeUsageSamples([]int64{100, 200, 300}, []int64{mebi(100), mebi(200), mebi(300)})
+	uu := []dao.ContainerUsage{
+		{Namespace: "default", Kind: "Deployment", Workload: "fred", Container: "c1", Samples: ss},
+		{Namespace: "default", Kind: "Deployment", Workload: "fred", Container: "c2", Samples: ss[:1]},
+		{Namespace: "default", Kind: "Pod", Workload: "blee", Container: "c1", Samples: ss},
+		{Namespace: "ns1", Kind: "CronJob", Workload: "zorg", Container: "c1", Samples: ss},
+	}
+
+	patch, err := dao.RightSizingPatch(uu)
+	assert.NoError(t, err)
+	assert.Equal(t, `# kubectl patch deployment fred -n default --patch-file <file>
+spec:
+  template:
+    spec:
+      containers:
+      - name: c1
+        resources:
+          limits:
+            memory: 375Mi
+          requests:
+            cpu: 330m
+            memory: 330Mi
+---
+# kubectl patch cronjob zorg -n ns1 --patch-file <file>
+spec:
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+          - name: c1
+            resources:
+              limits:
+                memory: 375Mi
+              requests:
+                cpu: 330m
+                memory: 330Mi
+`, patch)
+}
+
+// Helpers...
+
+func mebi(v int64) int64 {
+	return v * 1024 * 1024
+}
+
+func makeUsageSamples(cc, mm []int64) []dao.UsageSample {
+	now := time.Now()
+	ss := make([]dao.UsageSample, 0, len(cc))
+	for i := range cc {
+		ss = append(ss, dao.UsageSample{At: now, CPU: cc[i], MEM: mm[i]})
+	}
+
+	return ss
+}
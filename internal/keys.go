@@ -36,4 +36,7 @@ const (
 	KeyWait          ContextKey = "wait"
 	KeyPodCounting   ContextKey = "podCounting"
 	KeyEnableImgScan ContextKey = "vulScan"
+	KeyVerb          ContextKey = "verb"
+	KeyQueries       ContextKey = "queries"
+	KeyPrometheus    ContextKey = "prometheus"
 )
@@ -0,0 +1,333 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// DeletedState represents the state of a deleted resource.
+	DeletedState = "Deleted"
+
+	availableState   = "Available"
+	progressingState = "Progressing"
+	completeState    = "Complete"
+	failedState      = "Failed"
+	runningState     = "Running"
+	readyState       = "Ready"
+	notReadyState    = "NotReady"
+)
+
+// AlertListener represents a watch alerts listener.
+type AlertListener interface {
+	// AlertFired notifies a watched resource state transition matched a rule.
+	AlertFired(Alert)
+}
+
+// Alert represents a watched resource state transition.
+type Alert struct {
+	Rule     string
+	Spec     config.AlertRule
+	GVR      string
+	FQN      string
+	From, To string
+	Time     time.Time
+}
+
+// AlertWatch represents a collection of watched resources.
+type AlertWatch struct {
+	GVR       string
+	Namespace string
+	Labels    labels.Selector
+	Names     map[string]struct{}
+}
+
+// Matches checks if a resource is being watched.
+func (w AlertWatch) Matches(o *unstructured.Unstructured) bool {
+	if !client.IsClusterWide(w.Namespace) && w.Namespace != o.GetNamespace() {
+		return false
+	}
+	if w.Labels != nil && !w.Labels.Matches(labels.Set(o.GetLabels())) {
+		return false
+	}
+	if len(w.Names) == 0 {
+		return true
+	}
+	_, ok := w.Names[client.FQN(o.GetNamespace(), o.GetName())]
+
+	return ok
+}
+
+type alertWatch struct {
+	AlertWatch
+
+	informer cache.SharedIndexInformer
+	reg      cache.ResourceEventHandlerRegistration
+	states   map[string]string
+}
+
+// Alerter notifies state transitions of watched resources.
+type Alerter struct {
+	factory   dao.Factory
+	rules     config.Alerts
+	watches   []*alertWatch
+	listeners []AlertListener
+	mx        sync.RWMutex
+}
+
+// NewAlerter returns a new alerter.
+func NewAlerter(f dao.Factory) *Alerter {
+	return &Alerter{
+		factory: f,
+		rules:   config.DefaultAlerts(),
+	}
+}
+
+// SetRules sets the alert rules.
+func (a *Alerter) SetRules(rr config.Alerts) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	a.rules = rr
+}
+
+// AddListener adds a new alerts listener.
+func (a *Alerter) AddListener(l AlertListener) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	a.listeners = append(a.listeners, l)
+}
+
+// RemoveListener deletes a alerts listener.
+func (a *Alerter) RemoveListener(l AlertListener) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	victim := -1
+	for i, lis := range a.listeners {
+		if lis == l {
+			victim = i
+			break
+		}
+	}
+	if victim >= 0 {
+		a.listeners = append(a.listeners[:victim], a.listeners[victim+1:]...)
+	}
+}
+
+// Count returns the number of active watches.
+func (a *Alerter) Count() int {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	return len(a.watches)
+}
+
+// Watch starts watching resources state transitions.
+func (a *Alerter) Watch(w AlertWatch) error {
+	inf, err := a.factory.CanForResource(w.Namespace, w.GVR, client.ListAccess)
+	if err != nil {
+		return err
+	}
+	if inf == nil {
+		return fmt.Errorf("no informer found for %q", w.GVR)
+	}
+
+	aw := alertWatch{
+		AlertWatch: w,
+		informer:   inf.Informer(),
+		states:     make(map[string]string),
+	}
+	a.mx.Lock()
+	a.watches = append(a.watches, &aw)
+	a.mx.Unlock()
+
+	aw.reg, err = aw.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			a.transition(&aw, obj, false)
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			a.transition(&aw, obj, false)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if d, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = d.Obj
+			}
+			a.transition(&aw, obj, true)
+		},
+	})
+	if err != nil {
+		a.remove(&aw)
+		return err
+	}
+
+	return nil
+}
+
+// Clear stops all watches.
+func (a *Alerter) Clear() {
+	a.mx.Lock()
+	ww := a.watches
+	a.watches = nil
+	a.mx.Unlock()
+
+	for _, w := range ww {
+		if w.reg == nil {
+			continue
+		}
+		if err := w.informer.RemoveEventHandler(w.reg); err != nil {
+			log.Warn().Err(err).Msgf("Alert watch removal failed for %q", w.GVR)
+		}
+	}
+}
+
+func (a *Alerter) remove(w *alertWatch) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	for i, aw := range a.watches {
+		if aw == w {
+			a.watches = append(a.watches[:i], a.watches[i+1:]...)
+			return
+		}
+	}
+}
+
+func (a *Alerter) transition(w *alertWatch, obj interface{}, deleted bool) {
+	o, ok := obj.(*unstructured.Unstructured)
+	if !ok || !w.Matches(o) {
+		return
+	}
+	fqn := client.FQN(o.GetNamespace(), o.GetName())
+	state := DeletedState
+	if !deleted {
+		state = AlertState(w.GVR, o)
+	}
+
+	a.mx.Lock()
+	prev, ok := w.states[fqn]
+	if deleted {
+		delete(w.states, fqn)
+	} else {
+		w.states[fqn] = state
+	}
+	rules := a.rules
+	a.mx.Unlock()
+	if !ok || prev == state {
+		return
+	}
+
+	names := make([]string, 0, len(rules.Alert))
+	for n := range rules.Alert {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		r := rules.Alert[n]
+		if !r.Matches(w.GVR, state) {
+			continue
+		}
+		a.fireAlert(Alert{
+			Rule: n,
+			Spec: r,
+			GVR:  w.GVR,
+			FQN:  fqn,
+			From: prev,
+			To:   state,
+			Time: time.Now(),
+		})
+	}
+}
+
+func (a *Alerter) fireAlert(al Alert) {
+	a.mx.RLock()
+	ll := make([]AlertListener, len(a.listeners))
+	copy(ll, a.listeners)
+	a.mx.RUnlock()
+
+	for _, l := range ll {
+		l.AlertFired(al)
+	}
+}
+
+// AlertState computes a resource state for alert rules evaluation.
+func AlertState(gvr string, o *unstructured.Unstructured) string {
+	switch gvr {
+	case "v1/pods":
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.Object, &po); err != nil {
+			return ""
+		}
+		var p render.Pod
+		return p.Phase(&po)
+	case "apps/v1/deployments":
+		desired, ok, _ := unstructured.NestedInt64(o.Object, "spec", "replicas")
+		if !ok {
+			desired = 1
+		}
+		available, _, _ := unstructured.NestedInt64(o.Object, "status", "availableReplicas")
+		updated, _, _ := unstructured.NestedInt64(o.Object, "status", "updatedReplicas")
+		if available >= desired && updated >= desired {
+			return availableState
+		}
+		return progressingState
+	case "batch/v1/jobs":
+		switch {
+		case hasCondition(o, completeState):
+			return completeState
+		case hasCondition(o, failedState):
+			return failedState
+		default:
+			return runningState
+		}
+	}
+
+	if phase, ok, _ := unstructured.NestedString(o.Object, "status", "phase"); ok {
+		return phase
+	}
+	cc, ok, _ := unstructured.NestedSlice(o.Object, "status", "conditions")
+	if !ok {
+		return ""
+	}
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok || m["type"] != readyState {
+			continue
+		}
+		if m["status"] == string(v1.ConditionTrue) {
+			return readyState
+		}
+		return notReadyState
+	}
+
+	return ""
+}
+
+func hasCondition(o *unstructured.Unstructured, t string) bool {
+	cc, _, _ := unstructured.NestedSlice(o.Object, "status", "conditions")
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if ok && m["type"] == t && m["status"] == string(v1.ConditionTrue) {
+			return true
+		}
+	}
+
+	return false
+}
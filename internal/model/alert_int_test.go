@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestAlerterTransition(t *testing.T) {
+	var l alertListener
+	a := NewAlerter(nil)
+	a.SetRules(config.Alerts{
+		Alert: map[string]config.AlertRule{
+			"done": {GVR: "batch/v1/jobs", State: "^(Complete|Failed)$"},
+			"gone": {GVR: config.AllGVRs, State: "^Deleted$"},
+		},
+	})
+	a.AddListener(&l)
+	w := alertWatch{
+		AlertWatch: AlertWatch{GVR: "batch/v1/jobs", Namespace: "ns1"},
+		states:     make(map[string]string),
+	}
+
+	a.transition(&w, makeJob("ns1", "j1", ""), false)
+	a.transition(&w, makeJob("ns2", "j2", ""), false)
+	assert.Empty(t, l.alerts)
+	assert.Equal(t, map[string]string{"ns1/j1": "Running"}, w.states)
+
+	a.transition(&w, makeJob("ns1", "j1", ""), false)
+	assert.Empty(t, l.alerts)
+
+	a.transition(&w, makeJob("ns1", "j1", "Complete"), false)
+	assert.Equal(t, 1, len(l.alerts))
+	assert.Equal(t, "done", l.alerts[0].Rule)
+	assert.Equal(t, "ns1/j1", l.alerts[0].FQN)
+	assert.Equal(t, "Running", l.alerts[0].From)
+	assert.Equal(t, "Complete", l.alerts[0].To)
+
+	a.transition(&w, makeJob("ns1", "j1", "Complete"), true)
+	assert.Equal(t, 2, len(l.alerts))
+	assert.Equal(t, "gone", l.alerts[1].Rule)
+	assert.Empty(t, w.states)
+
+	a.RemoveListener(&l)
+	a.transition(&w, makeJob("ns1", "j1", ""), false)
+	a.transition(&w, makeJob("ns1", "j1", "Failed"), false)
+	assert.Equal(t, 2, len(l.alerts))
+}
+
+func TestAlertWatchMatches(t *testing.T) {
+	uu := map[string]struct {
+		w AlertWatch
+		e bool
+	}{
+		"all": {
+			w: AlertWatch{GVR: "batch/v1/jobs"},
+			e: true,
+		},
+		"ns": {
+			w: AlertWatch{GVR: "batch/v1/jobs", Namespace: "ns2"},
+		},
+		"names": {
+			w: AlertWatch{GVR: "batch/v1/jobs", Names: map[string]struct{}{"ns1/j1": {}}},
+			e: true,
+		},
+		"other": {
+			w: AlertWatch{GVR: "batch/v1/jobs", Names: map[string]struct{}{"ns1/j2": {}}},
+		},
+	}
+
+	o := makeJob("ns1", "j1", "")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.w.Matches(o))
+		})
+	}
+}
+
+func TestAlertState(t *testing.T) {
+	uu := map[string]struct {
+		gvr string
+		o   map[string]interface{}
+		e   string
+	}{
+		"job-running": {
+			gvr: "batch/v1/jobs",
+			o:   makeJob("ns1", "j1", "").Object,
+			e:   "Running",
+		},
+		"job-failed": {
+			gvr: "batch/v1/jobs",
+			o:   makeJob("ns1", "j1", "Failed").Object,
+			e:   "Failed",
+		},
+		"dp-available": {
+			gvr: "apps/v1/deployments",
+			o: map[string]interface{}{
+				"spec":   map[string]interface{}{"replicas": int64(2)},
+				"status": map[string]interface{}{"availableReplicas": int64(2), "updatedReplicas": int64(2)},
+			},
+			e: "Available",
+		},
+		"dp-progressing": {
+			gvr: "apps/v1/deployments",
+			o: map[string]interface{}{
+				"spec":   map[string]interface{}{"replicas": int64(2)},
+				"status": map[string]interface{}{"availableReplicas": int64(1), "updatedReplicas": int64(2)},
+			},
+			e: "Progressing",
+		},
+		"pod": {
+			gvr: "v1/pods",
+			o: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "p1"},
+				"status": map[string]interface{}{
+					"phase": "Running",
+					"containerStatuses": []interface{}{
+						map[string]interface{}{
+							"name":  "c1",
+							"state": map[string]interface{}{"waiting": map[string]interface{}{"reason": "CrashLoopBackOff"}},
+						},
+					},
+				},
+			},
+			e: "CrashLoopBackOff",
+		},
+		"phase": {
+			gvr: "v1/persistentvolumeclaims",
+			o:   map[string]interface{}{"status": map[string]interface{}{"phase": "Bound"}},
+			e:   "Bound",
+		},
+		"ready": {
+			gvr: "v1/nodes",
+			o: map[string]interface{}{"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "False"},
+				},
+			}},
+			e: "NotReady",
+		},
+		"none": {
+			gvr: "v1/configmaps",
+			o:   map[string]interface{}{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, AlertState(u.gvr, &unstructured.Unstructured{Object: u.o}))
+		})
+	}
+}
+
+// Helpers...
+
+type alertListener struct {
+	alerts []Alert
+}
+
+func (l *alertListener) AlertFired(a Alert) {
+	l.alerts = append(l.alerts, a)
+}
+
+func makeJob(ns, n, cond string) *unstructured.Unstructured {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"namespace": ns,
+			"name":      n,
+		},
+	}}
+	if cond != "" {
+		o.Object["status"] = map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": cond, "status": "True"},
+			},
+		}
+	}
+
+	return &o
+}
@@ -37,6 +37,22 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.HelmHistory{},
 		Renderer: &helm.History{},
 	},
+	"rightsizings": {
+		DAO:      &dao.RightSizing{},
+		Renderer: &render.RightSizing{},
+	},
+	"diagnostics": {
+		DAO:      &dao.Diagnostic{},
+		Renderer: &render.Diagnostic{},
+	},
+	"queries": {
+		DAO:      &dao.Query{},
+		Renderer: &render.Query{},
+	},
+	"rollout-history": {
+		DAO:      &dao.RolloutHistory{},
+		Renderer: &render.Rollout{},
+	},
 	"containers": {
 		DAO:          &dao.Container{},
 		Renderer:     &render.Container{},
@@ -70,6 +86,10 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Subject{},
 		Renderer: &render.Subject{},
 	},
+	"whocan": {
+		DAO:      &dao.WhoCan{},
+		Renderer: &render.WhoCan{},
+	},
 	"portforwards": {
 		DAO:      &dao.PortForward{},
 		Renderer: &render.PortForward{},
@@ -183,6 +203,12 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.StorageClass{},
 	},
 
+	// Autoscaling...
+	"autoscaling/v2/horizontalpodautoscalers": {
+		DAO:      &dao.HPA{},
+		Renderer: &render.HPA{},
+	},
+
 	// Policy...
 	"policy/v1/poddisruptionbudgets": {
 		Renderer: &render.PodDisruptionBudget{},
@@ -5,6 +5,7 @@ package model
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -15,6 +16,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -22,6 +24,9 @@ import (
 
 const initRefreshRate = 300 * time.Millisecond
 
+// errFresh indicates a large resource was listed within its resync interval.
+var errFresh = errors.New("resource listing is still fresh")
+
 // TableListener represents a table model listener.
 type TableListener interface {
 	// TableDataChanged notifies the model data changed.
@@ -40,6 +45,7 @@ type Table struct {
 	refreshRate time.Duration
 	instance    string
 	labelFilter string
+	listedAt    time.Time
 	mx          sync.RWMutex
 }
 
@@ -57,7 +63,7 @@ func (t *Table) SetLabelFilter(f string) {
 	t.mx.Lock()
 	defer t.mx.Unlock()
 
-	t.labelFilter = f
+	t.labelFilter, t.listedAt = f, time.Time{}
 }
 
 // GetLabelFilter sets the labels filter.
@@ -100,6 +106,13 @@ func (t *Table) RemoveListener(l TableListener) {
 
 // Watch initiates model updates.
 func (t *Table) Watch(ctx context.Context) error {
+	if pager, ok := t.pager(ctx); ok && !pager.IsSized(t.gvr.String(), t.listNamespace()) {
+		go func() {
+			t.sizeResource(pager)
+			t.updater(ctx)
+		}()
+		return nil
+	}
 	if err := t.refresh(ctx); err != nil {
 		return err
 	}
@@ -110,6 +123,8 @@ func (t *Table) Watch(ctx context.Context) error {
 
 // Refresh updates the table content.
 func (t *Table) Refresh(ctx context.Context) error {
+	t.resetListedAt()
+
 	return t.refresh(ctx)
 }
 
@@ -145,6 +160,7 @@ func (t *Table) GetNamespace() string {
 
 // SetNamespace sets up model namespace.
 func (t *Table) SetNamespace(ns string) {
+	t.resetListedAt()
 	t.data.Reset(ns)
 }
 
@@ -160,7 +176,9 @@ func (t *Table) SetRefreshRate(d time.Duration) {
 
 // ClusterWide checks if resource is scope for all namespaces.
 func (t *Table) ClusterWide() bool {
-	return client.IsClusterWide(t.data.GetNamespace())
+	ns := t.data.GetNamespace()
+
+	return client.IsClusterWide(ns) || client.IsMultiNamespace(ns)
 }
 
 // Empty returns true if no model data.
@@ -214,7 +232,9 @@ func (t *Table) refresh(ctx context.Context) error {
 	}
 	defer atomic.StoreInt32(&t.inUpdate, 0)
 
-	if err := t.reconcile(ctx); err != nil {
+	if err := t.reconcile(ctx); errors.Is(err, errFresh) {
+		return nil
+	} else if err != nil {
 		return err
 	}
 	t.fireTableChanged(t.Peek())
@@ -233,12 +253,99 @@ func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, err
 	ctx = context.WithValue(ctx, internal.KeyLabels, t.labelFilter)
 	t.mx.RUnlock()
 
+	ns := t.listNamespace()
+	if !client.IsMultiNamespace(ns) {
+		return a.List(ctx, ns)
+	}
+
+	var oo []runtime.Object
+	for _, n := range client.Namespaces(ns) {
+		ll, err := a.List(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		oo = mergeObjects(oo, ll)
+	}
+
+	return oo, nil
+}
+
+// mergeObjects unions resources listed across namespaces. Generic resources
+// are listed as a single meta table, so table rows are merged instead.
+func mergeObjects(oo, ll []runtime.Object) []runtime.Object {
+	if len(oo) == 1 && len(ll) == 1 {
+		t1, ok1 := oo[0].(*metav1.Table)
+		t2, ok2 := ll[0].(*metav1.Table)
+		if ok1 && ok2 {
+			t1.Rows = append(t1.Rows, t2.Rows...)
+			return oo
+		}
+	}
+
+	return append(oo, ll...)
+}
+
+func (t *Table) listNamespace() string {
 	ns := client.CleanseNamespace(t.data.GetNamespace())
 	if client.IsClusterScoped(ns) {
-		ns = client.BlankNamespace
+		return client.BlankNamespace
+	}
+
+	return ns
+}
+
+// pager returns the factory pager if the resource may be too large to be cached.
+func (t *Table) pager(ctx context.Context) (dao.Pager, bool) {
+	pager, ok := ctx.Value(internal.KeyFactory).(dao.Pager)
+	if !ok {
+		return nil, false
 	}
+	if m, err := dao.MetaAccess.MetaFor(t.gvr); err != nil || !dao.IsK8sMeta(m) {
+		return nil, false
+	}
+
+	return pager, true
+}
+
+// sizeResource probes the resource size prior to listing it.
+func (t *Table) sizeResource(pager dao.Pager) {
+	if _, err := pager.SizeResource(t.gvr.String(), t.listNamespace()); err != nil {
+		log.Warn().Err(err).Msgf("Unable to size resource %q", t.gvr)
+	}
+}
 
-	return a.List(ctx, ns)
+func (t *Table) resetListedAt() {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.listedAt = time.Time{}
+}
+
+// isStale checks if a large resource must be listed again. Large resources are
+// rendered from server side tables listed every resync interval instead of informers.
+func (t *Table) isStale(resync time.Duration) bool {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if !t.listedAt.IsZero() && time.Since(t.listedAt) < resync {
+		return false
+	}
+	t.listedAt = time.Now()
+
+	return true
+}
+
+// largeMeta renders large resources from server side tables. Views retain the
+// resource colorer and custom columns objects are included in the tables as needed.
+func largeMeta(meta ResourceMeta) ResourceMeta {
+	if _, ok := meta.DAO.(*dao.Table); ok {
+		return meta
+	}
+
+	return ResourceMeta{
+		DAO:      &dao.Table{},
+		Renderer: &render.Generic{},
+	}
 }
 
 func (t *Table) reconcile(ctx context.Context) error {
@@ -249,12 +356,19 @@ func (t *Table) reconcile(ctx context.Context) error {
 	meta := resourceMeta(t.gvr)
 	ctx = context.WithValue(ctx, internal.KeyLabels, t.labelFilter)
 	if t.instance == "" {
+		if pager, ok := t.pager(ctx); ok && pager.IsLarge(t.gvr.String(), t.listNamespace()) {
+			if !t.isStale(pager.Resync()) {
+				return errFresh
+			}
+			meta = largeMeta(meta)
+		}
 		oo, err = t.list(ctx, meta.DAO)
 	} else {
 		o, e := t.Get(ctx, t.instance)
 		oo, err = []runtime.Object{o}, e
 	}
 	if err != nil {
+		t.resetListedAt()
 		return err
 	}
 
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -23,6 +24,22 @@ import (
 	"k8s.io/client-go/informers"
 )
 
+func TestTableIsStale(t *testing.T) {
+	ta := NewTable(client.NewGVR("v1/pods"))
+
+	assert.True(t, ta.isStale(time.Minute))
+	assert.False(t, ta.isStale(time.Minute))
+	assert.True(t, ta.isStale(0))
+
+	ta.SetLabelFilter("app=fred")
+	assert.True(t, ta.isStale(time.Minute))
+	ta.SetNamespace("ns1")
+	assert.True(t, ta.isStale(time.Minute))
+	ta.resetListedAt()
+	assert.True(t, ta.isStale(time.Minute))
+	assert.False(t, ta.isStale(time.Minute))
+}
+
 func TestTableReconcile(t *testing.T) {
 	ta := NewTable(client.NewGVR("v1/pods"))
 	ta.SetNamespace(client.NamespaceAll)
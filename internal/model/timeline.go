@@ -0,0 +1,472 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/rs/zerolog/log"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	revisionAnnotation = "deployment.kubernetes.io/revision"
+	// maxOwnerDepth guards against owner references cycles.
+	maxOwnerDepth = 5
+	// DefaultTimelineWindow represents the default timeline time window.
+	DefaultTimelineWindow = time.Hour
+)
+
+// TimelineListener represents a timeline model listener.
+type TimelineListener interface {
+	// TimelineChanged notifies the model data changed.
+	TimelineChanged([]tchart.Lane)
+
+	// TimelineFailed notifies the timeline load failed.
+	TimelineFailed(error)
+}
+
+// Timeline tracks events, container restarts and rollouts correlated per object.
+type Timeline struct {
+	namespace   string
+	window      time.Duration
+	inUpdate    int32
+	listeners   []TimelineListener
+	refreshRate time.Duration
+	mx          sync.RWMutex
+}
+
+// NewTimeline returns a new timeline model.
+func NewTimeline(ns string) *Timeline {
+	return &Timeline{
+		namespace:   ns,
+		window:      DefaultTimelineWindow,
+		refreshRate: defaultRefreshRate,
+	}
+}
+
+// SetWindow sets the timeline time window.
+func (t *Timeline) SetWindow(d time.Duration) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	t.window = d
+}
+
+// Window returns the timeline time window.
+func (t *Timeline) Window() time.Duration {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+	return t.window
+}
+
+// GetNamespace returns the model namespace.
+func (t *Timeline) GetNamespace() string {
+	return t.namespace
+}
+
+// Watch monitors the timeline.
+func (t *Timeline) Watch(ctx context.Context) {
+	t.Refresh(ctx)
+	go t.updater(ctx)
+}
+
+func (t *Timeline) updater(ctx context.Context) {
+	defer log.Debug().Msgf("Timeline canceled -- %q", t.namespace)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(t.refreshRate):
+			t.Refresh(ctx)
+		}
+	}
+}
+
+// Refresh updates the model now.
+func (t *Timeline) Refresh(ctx context.Context) {
+	if !atomic.CompareAndSwapInt32(&t.inUpdate, 0, 1) {
+		log.Debug().Msgf("Dropping update...")
+		return
+	}
+	defer atomic.StoreInt32(&t.inUpdate, 0)
+
+	ll, err := t.reconcile(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Timeline reconcile failed")
+		t.fireTimelineFailed(err)
+		return
+	}
+	t.fireTimelineChanged(ll)
+}
+
+func (t *Timeline) reconcile(ctx context.Context) ([]tchart.Lane, error) {
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return nil, fmt.Errorf("expected Factory in context but got %T", ctx.Value(internal.KeyFactory))
+	}
+	ns := client.CleanseNamespace(t.namespace)
+
+	evts, err := fetchEvents(f, ns)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := fetchPods(f, ns)
+	if err != nil {
+		return nil, err
+	}
+	rss, err := fetchReplicaSets(f, ns)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Timeline unable to list replicasets")
+	}
+
+	return BuildTimeline(evts, pods, rss, time.Now().Add(-t.Window())), nil
+}
+
+// AddListener adds a listener.
+func (t *Timeline) AddListener(l TimelineListener) {
+	t.listeners = append(t.listeners, l)
+}
+
+// RemoveListener delete a listener.
+func (t *Timeline) RemoveListener(l TimelineListener) {
+	victim := -1
+	for i, lis := range t.listeners {
+		if lis == l {
+			victim = i
+			break
+		}
+	}
+
+	if victim >= 0 {
+		t.listeners = append(t.listeners[:victim], t.listeners[victim+1:]...)
+	}
+}
+
+func (t *Timeline) fireTimelineChanged(ll []tchart.Lane) {
+	for _, l := range t.listeners {
+		l.TimelineChanged(ll)
+	}
+}
+
+func (t *Timeline) fireTimelineFailed(err error) {
+	for _, l := range t.listeners {
+		l.TimelineFailed(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// BuildTimeline groups events, container restarts and rollout revisions per
+// involved object. Lanes are nested under their owner lanes ie Pod -> ReplicaSet ->
+// Deployment so an object activity shows up along with its dependents. Only marks
+// seen after since are retained.
+func BuildTimeline(evts []v1.Event, pods []v1.Pod, rss []appsv1.ReplicaSet, since time.Time) []tchart.Lane {
+	lanes := make(map[string]*tchart.Lane)
+	lane := func(id string) *tchart.Lane {
+		l, ok := lanes[id]
+		if !ok {
+			kind, fqn, _ := strings.Cut(id, ":")
+			l = &tchart.Lane{ID: id, Label: kind + "/" + fqn}
+			lanes[id] = l
+		}
+		return l
+	}
+	add := func(kind, ns, n string, m tchart.Mark) {
+		if m.End.Before(since) {
+			return
+		}
+		l := lane(laneID(kind, ns, n))
+		l.Marks = collapseMark(l.Marks, m)
+	}
+
+	owners := make(map[string]string, len(pods)+len(rss))
+	for _, p := range pods {
+		if ref := metav1.GetControllerOf(&p); ref != nil {
+			owners[laneID("Pod", p.Namespace, p.Name)] = laneID(ref.Kind, p.Namespace, ref.Name)
+		}
+	}
+	for _, rs := range rss {
+		if ref := metav1.GetControllerOf(&rs); ref != nil {
+			owners[laneID("ReplicaSet", rs.Namespace, rs.Name)] = laneID(ref.Kind, rs.Namespace, ref.Name)
+		}
+	}
+
+	for _, e := range evts {
+		o := e.InvolvedObject
+		add(o.Kind, o.Namespace, o.Name, eventMark(e))
+	}
+	for _, p := range pods {
+		for _, m := range restartMarks(p) {
+			add("Pod", p.Namespace, p.Name, m)
+		}
+	}
+	for _, rs := range rss {
+		if n, m, ok := rolloutMark(rs); ok {
+			add("Deployment", rs.Namespace, n, m)
+		}
+	}
+
+	return nestLanes(lanes, owners, lane)
+}
+
+// nestLanes orders lanes per owner, most recently active groups first, each owner
+// being followed by its dependents. Missing owner lanes are added to anchor groups.
+func nestLanes(lanes map[string]*tchart.Lane, owners map[string]string, lane func(string) *tchart.Lane) []tchart.Lane {
+	children := make(map[string][]string)
+	ids := make([]string, 0, len(lanes))
+	for id := range lanes {
+		ids = append(ids, id)
+	}
+	for _, id := range ids {
+		for depth := 0; depth < maxOwnerDepth; depth++ {
+			owner, ok := owners[id]
+			if !ok {
+				break
+			}
+			_, seen := lanes[owner]
+			lane(owner)
+			if !slices.Contains(children[owner], id) {
+				children[owner] = append(children[owner], id)
+			}
+			if seen {
+				break
+			}
+			id = owner
+		}
+	}
+
+	// last tracks the most recent activity of a lane and its dependents.
+	last := make(map[string]time.Time, len(lanes))
+	var lastOf func(string, int) time.Time
+	lastOf = func(id string, depth int) time.Time {
+		if t, ok := last[id]; ok {
+			return t
+		}
+		t := lanes[id].Last()
+		if depth < maxOwnerDepth {
+			for _, c := range children[id] {
+				if ct := lastOf(c, depth+1); ct.After(t) {
+					t = ct
+				}
+			}
+		}
+		last[id] = t
+		return t
+	}
+	byActivity := func(ids []string) {
+		sort.Slice(ids, func(i, j int) bool {
+			ti, tj := lastOf(ids[i], 0), lastOf(ids[j], 0)
+			if ti.Equal(tj) {
+				return ids[i] < ids[j]
+			}
+			return ti.After(tj)
+		})
+	}
+
+	roots := make([]string, 0, len(lanes))
+	for id := range lanes {
+		if owner, ok := owners[id]; !ok || lanes[owner] == nil {
+			roots = append(roots, id)
+		}
+	}
+	byActivity(roots)
+
+	ll := make([]tchart.Lane, 0, len(lanes))
+	var walk func(id, group string, depth int)
+	walk = func(id, group string, depth int) {
+		l := *lanes[id]
+		l.Group, l.Depth = group, depth
+		ll = append(ll, l)
+		if depth >= maxOwnerDepth {
+			return
+		}
+		cc := children[id]
+		byActivity(cc)
+		for _, c := range cc {
+			walk(c, group, depth+1)
+		}
+	}
+	for _, r := range roots {
+		walk(r, r, 0)
+	}
+
+	return ll
+}
+
+func laneID(kind, ns, n string) string {
+	return kind + ":" + client.FQN(ns, n)
+}
+
+func eventMark(e v1.Event) tchart.Mark {
+	m := tchart.Mark{
+		Start: e.FirstTimestamp.Time,
+		End:   e.LastTimestamp.Time,
+		Kind:  tchart.MarkNormal,
+		Count: e.Count,
+		Label: e.Reason + ": " + e.Message,
+	}
+	if m.Start.IsZero() {
+		m.Start = e.EventTime.Time
+	}
+	if e.Series != nil {
+		m.Count = e.Series.Count
+		m.End = e.Series.LastObservedTime.Time
+	}
+	if m.End.IsZero() {
+		m.End = m.Start
+	}
+	if m.Count == 0 {
+		m.Count = 1
+	}
+	if e.Type == v1.EventTypeWarning {
+		m.Kind = tchart.MarkWarning
+	}
+
+	return m
+}
+
+func restartMarks(p v1.Pod) []tchart.Mark {
+	cos := make([]v1.ContainerStatus, 0, len(p.Status.InitContainerStatuses)+len(p.Status.ContainerStatuses))
+	cos = append(cos, p.Status.InitContainerStatuses...)
+	cos = append(cos, p.Status.ContainerStatuses...)
+
+	mm := make([]tchart.Mark, 0, len(cos))
+	for _, co := range cos {
+		term := co.LastTerminationState.Terminated
+		if co.RestartCount == 0 || term == nil {
+			continue
+		}
+		mm = append(mm, tchart.Mark{
+			Start: term.FinishedAt.Time,
+			End:   term.FinishedAt.Time,
+			Kind:  tchart.MarkRestart,
+			Count: co.RestartCount,
+			Label: fmt.Sprintf("Restarted: container %s %s (exit code %d)", co.Name, term.Reason, term.ExitCode),
+		})
+	}
+
+	return mm
+}
+
+func rolloutMark(rs appsv1.ReplicaSet) (string, tchart.Mark, bool) {
+	rev, ok := rs.Annotations[revisionAnnotation]
+	if !ok {
+		return "", tchart.Mark{}, false
+	}
+	for _, ref := range rs.OwnerReferences {
+		if ref.Kind != "Deployment" {
+			continue
+		}
+		if _, err := strconv.Atoi(rev); err != nil {
+			return "", tchart.Mark{}, false
+		}
+		return ref.Name, tchart.Mark{
+			Start: rs.CreationTimestamp.Time,
+			End:   rs.CreationTimestamp.Time,
+			Kind:  tchart.MarkRollout,
+			Count: 1,
+			Label: fmt.Sprintf("Rollout: revision %s (%s)", rev, rs.Name),
+		}, true
+	}
+
+	return "", tchart.Mark{}, false
+}
+
+// collapseMark merges repeated occurrences of the same mark.
+func collapseMark(mm []tchart.Mark, m tchart.Mark) []tchart.Mark {
+	for i := range mm {
+		if mm[i].Kind != m.Kind || mm[i].Label != m.Label {
+			continue
+		}
+		if m.Start.Before(mm[i].Start) {
+			mm[i].Start = m.Start
+		}
+		if m.End.After(mm[i].End) {
+			mm[i].End = m.End
+		}
+		mm[i].Count += m.Count
+		return mm
+	}
+
+	return append(mm, m)
+}
+
+func listUnstructured(f dao.Factory, gvr, ns string, fn func(map[string]interface{}) error) error {
+	oo, err := f.List(gvr, ns, false, labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		if err := fn(u.Object); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func fetchEvents(f dao.Factory, ns string) ([]v1.Event, error) {
+	var ee []v1.Event
+	err := listUnstructured(f, "v1/events", ns, func(o map[string]interface{}) error {
+		var e v1.Event
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o, &e); err != nil {
+			return err
+		}
+		ee = append(ee, e)
+		return nil
+	})
+
+	return ee, err
+}
+
+func fetchPods(f dao.Factory, ns string) ([]v1.Pod, error) {
+	var pp []v1.Pod
+	err := listUnstructured(f, "v1/pods", ns, func(o map[string]interface{}) error {
+		var p v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o, &p); err != nil {
+			return err
+		}
+		pp = append(pp, p)
+		return nil
+	})
+
+	return pp, err
+}
+
+func fetchReplicaSets(f dao.Factory, ns string) ([]appsv1.ReplicaSet, error) {
+	var rr []appsv1.ReplicaSet
+	err := listUnstructured(f, "apps/v1/replicasets", ns, func(o map[string]interface{}) error {
+		var rs appsv1.ReplicaSet
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o, &rs); err != nil {
+			return err
+		}
+		rr = append(rr, rs)
+		return nil
+	})
+
+	return rr, err
+}
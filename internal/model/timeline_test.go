@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBuildTimeline(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	ago := func(d time.Duration) metav1.Time {
+		return metav1.NewTime(now.Add(-d))
+	}
+	pod := v1.ObjectReference{Kind: "Pod", Namespace: "ns1", Name: "p1"}
+	dp := v1.ObjectReference{Kind: "Deployment", Namespace: "ns1", Name: "dp1"}
+
+	evts := []v1.Event{
+		{
+			InvolvedObject: pod,
+			Type:           v1.EventTypeWarning,
+			Reason:         "BackOff",
+			Message:        "Back-off restarting failed container",
+			FirstTimestamp: ago(30 * time.Minute),
+			LastTimestamp:  ago(time.Minute),
+			Count:          10,
+		},
+		{
+			InvolvedObject: pod,
+			Type:           v1.EventTypeWarning,
+			Reason:         "BackOff",
+			Message:        "Back-off restarting failed container",
+			FirstTimestamp: ago(40 * time.Minute),
+			LastTimestamp:  ago(35 * time.Minute),
+			Count:          2,
+		},
+		{
+			InvolvedObject: dp,
+			Type:           v1.EventTypeNormal,
+			Reason:         "ScalingReplicaSet",
+			Message:        "Scaled up replica set dp1-abc to 1",
+			FirstTimestamp: ago(45 * time.Minute),
+			LastTimestamp:  ago(45 * time.Minute),
+			Count:          1,
+		},
+		{
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "ns1", Name: "old"},
+			Type:           v1.EventTypeNormal,
+			Reason:         "Pulled",
+			FirstTimestamp: ago(3 * time.Hour),
+			LastTimestamp:  ago(2 * time.Hour),
+		},
+	}
+	pods := []v1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1"},
+			Status: v1.PodStatus{
+				ContainerStatuses: []v1.ContainerStatus{
+					{
+						Name:         "c1",
+						RestartCount: 3,
+						LastTerminationState: v1.ContainerState{
+							Terminated: &v1.ContainerStateTerminated{
+								Reason:     "OOMKilled",
+								ExitCode:   137,
+								FinishedAt: ago(2 * time.Minute),
+							},
+						},
+					},
+					{Name: "c2"},
+				},
+			},
+		},
+	}
+	rss := []appsv1.ReplicaSet{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "ns1",
+				Name:              "dp1-abc",
+				CreationTimestamp: ago(45 * time.Minute),
+				Annotations:       map[string]string{"deployment.kubernetes.io/revision": "2"},
+				OwnerReferences:   []metav1.OwnerReference{{Kind: "Deployment", Name: "dp1"}},
+			},
+		},
+	}
+
+	ll := model.BuildTimeline(evts, pods, rss, now.Add(-time.Hour))
+
+	assert.Equal(t, 2, len(ll))
+	assert.Equal(t, "Pod:ns1/p1", ll[0].ID)
+	assert.Equal(t, "Pod/ns1/p1", ll[0].Label)
+	assert.Equal(t, []tchart.Mark{
+		{
+			Start: now.Add(-40 * time.Minute),
+			End:   now.Add(-time.Minute),
+			Kind:  tchart.MarkWarning,
+			Count: 12,
+			Label: "BackOff: Back-off restarting failed container",
+		},
+		{
+			Start: now.Add(-2 * time.Minute),
+			End:   now.Add(-2 * time.Minute),
+			Kind:  tchart.MarkRestart,
+			Count: 3,
+			Label: "Restarted: container c1 OOMKilled (exit code 137)",
+		},
+	}, ll[0].Marks)
+
+	assert.Equal(t, "Deployment:ns1/dp1", ll[1].ID)
+	assert.Equal(t, 2, len(ll[1].Marks))
+	assert.Equal(t, tchart.MarkNormal, ll[1].Marks[0].Kind)
+	assert.Equal(t, tchart.MarkRollout, ll[1].Marks[1].Kind)
+	assert.Equal(t, "Rollout: revision 2 (dp1-abc)", ll[1].Marks[1].Label)
+}
+
+func TestBuildTimelineOwners(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	ago := func(d time.Duration) metav1.Time {
+		return metav1.NewTime(now.Add(-d))
+	}
+	ctrl := true
+	owner := func(kind, n string) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{Kind: kind, Name: n, Controller: &ctrl}}
+	}
+	warn := func(kind, n string, d time.Duration) v1.Event {
+		return v1.Event{
+			InvolvedObject: v1.ObjectReference{Kind: kind, Namespace: "ns1", Name: n},
+			Type:           v1.EventTypeWarning,
+			Reason:         "Failed",
+			FirstTimestamp: ago(d),
+			LastTimestamp:  ago(d),
+		}
+	}
+
+	evts := []v1.Event{
+		warn("Pod", "dp1-abc-p1", 5*time.Minute),
+		warn("ReplicaSet", "dp1-abc", 10*time.Minute),
+		warn("Pod", "dp2-xyz-p1", 2*time.Minute),
+		warn("Pod", "bare", time.Minute),
+	}
+	pods := []v1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "dp1-abc-p1", OwnerReferences: owner("ReplicaSet", "dp1-abc")}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "dp2-xyz-p1", OwnerReferences: owner("ReplicaSet", "dp2-xyz")}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "bare"}},
+	}
+	rss := []appsv1.ReplicaSet{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "dp1-abc", CreationTimestamp: ago(20 * time.Minute), OwnerReferences: owner("Deployment", "dp1")}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "dp2-xyz", OwnerReferences: owner("Deployment", "dp2")}},
+	}
+
+	ll := model.BuildTimeline(evts, pods, rss, now.Add(-time.Hour))
+
+	type lane struct {
+		id, group string
+		depth     int
+	}
+	aa := make([]lane, 0, len(ll))
+	for _, l := range ll {
+		aa = append(aa, lane{id: l.ID, group: l.Group, depth: l.Depth})
+	}
+	assert.Equal(t, []lane{
+		{id: "Pod:ns1/bare", group: "Pod:ns1/bare"},
+		{id: "Deployment:ns1/dp2", group: "Deployment:ns1/dp2"},
+		{id: "ReplicaSet:ns1/dp2-xyz", group: "Deployment:ns1/dp2", depth: 1},
+		{id: "Pod:ns1/dp2-xyz-p1", group: "Deployment:ns1/dp2", depth: 2},
+		{id: "Deployment:ns1/dp1", group: "Deployment:ns1/dp1"},
+		{id: "ReplicaSet:ns1/dp1-abc", group: "Deployment:ns1/dp1", depth: 1},
+		{id: "Pod:ns1/dp1-abc-p1", group: "Deployment:ns1/dp1", depth: 2},
+	}, aa)
+	assert.Empty(t, ll[1].Marks)
+	assert.Equal(t, "└ ReplicaSet/ns1/dp2-xyz", ll[2].Title())
+	assert.Equal(t, "  └ Pod/ns1/dp2-xyz-p1", ll[3].Title())
+}
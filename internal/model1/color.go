@@ -3,7 +3,10 @@
 
 package model1
 
-import "github.com/derailed/tcell/v2"
+import (
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tcell/v2"
+)
 
 var (
 	// ModColor row modified color.
@@ -49,3 +52,43 @@ func DefaultColorer(ns string, h Header, re *RowEvent) tcell.Color {
 		return StdColor
 	}
 }
+
+// RuleColor returns the color of the first rule matching the given row.
+func RuleColor(rr []config.ColorRule, h Header, re *RowEvent) (tcell.Color, bool) {
+	for _, r := range rr {
+		idx, ok := h.IndexOf(r.Column, true)
+		if !ok || idx >= len(re.Row.Fields) {
+			continue
+		}
+		if r.Match(re.Row.Fields[idx]) {
+			return ruleColor(r.Color), true
+		}
+	}
+
+	return tcell.ColorDefault, false
+}
+
+// ruleColor resolves a rule color either from a skin status color name
+// ie errorColor or from a plain color name/hex value.
+func ruleColor(n string) tcell.Color {
+	switch n {
+	case "newColor":
+		return StdColor
+	case "modifyColor":
+		return ModColor
+	case "addColor":
+		return AddColor
+	case "pendingColor":
+		return PendingColor
+	case "errorColor":
+		return ErrColor
+	case "highlightColor":
+		return HighlightColor
+	case "killColor":
+		return KillColor
+	case "completedColor":
+		return CompletedColor
+	default:
+		return tcell.GetColor(n)
+	}
+}
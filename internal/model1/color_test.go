@@ -6,6 +6,7 @@ package model1_test
 import (
 	"testing"
 
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/tcell/v2"
 	"github.com/stretchr/testify/assert"
@@ -64,3 +65,52 @@ func TestDefaultColorer(t *testing.T) {
 		})
 	}
 }
+
+func TestRuleColor(t *testing.T) {
+	five := 5.0
+	rr := []config.ColorRule{
+		{Column: "RESTARTS", GT: &five, Color: "errorColor"},
+		{Column: "STATUS", Equals: "Pending", Color: "orange"},
+		{Column: "NOPE", Matches: ".*", Color: "red"},
+	}
+	h := model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "RESTARTS", Wide: true},
+	}
+
+	uu := map[string]struct {
+		ff model1.Fields
+		e  tcell.Color
+		ok bool
+	}{
+		"restarts": {
+			ff: model1.Fields{"p1", "Running", "10"},
+			e:  model1.ErrColor,
+			ok: true,
+		},
+		"first-wins": {
+			ff: model1.Fields{"p1", "Pending", "10"},
+			e:  model1.ErrColor,
+			ok: true,
+		},
+		"status": {
+			ff: model1.Fields{"p1", "Pending", "0"},
+			e:  tcell.GetColor("orange"),
+			ok: true,
+		},
+		"none": {
+			ff: model1.Fields{"p1", "Running", "0"},
+			e:  tcell.ColorDefault,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, ok := model1.RuleColor(rr, h, &model1.RowEvent{Row: model1.Row{Fields: u.ff}})
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, c)
+		})
+	}
+}
@@ -0,0 +1,281 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tview"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"github.com/google/cel-go/ext"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// celVars tracks top level resource fields exposed to CEL expressions.
+var celVars = []string{"apiVersion", "kind", "metadata", "spec", "status", "data"}
+
+// RawWrapper represents an object decorating a raw resource.
+type RawWrapper interface {
+	// RawObject returns the raw resource.
+	RawObject() *unstructured.Unstructured
+}
+
+type colEvaluator interface {
+	eval(o map[string]interface{}) (string, error)
+}
+
+// CustomColumnValue evaluates a custom column expression against a raw resource.
+func CustomColumnValue(spec config.ColumnSpec, o map[string]interface{}) (string, error) {
+	ev, err := colExprs.get(spec)
+	if err != nil {
+		return "", err
+	}
+	v, err := ev.eval(o)
+	if err != nil || !spec.Duration {
+		return v, err
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return duration.HumanDuration(time.Since(t)), nil
+	}
+
+	return v, nil
+}
+
+func customColumns(ctx context.Context, gvr client.GVR) []config.ColumnSpec {
+	cfg, ok := ctx.Value(internal.KeyViewConfig).(*config.CustomView)
+	if !ok || cfg == nil {
+		return nil
+	}
+	vs, ok := cfg.Views[gvr.String()]
+	if !ok {
+		return nil
+	}
+
+	return vs.CustomColumns()
+}
+
+func addCustomColumns(h Header, rows Rows, raws []map[string]interface{}, cc []config.ColumnSpec) Header {
+	header := h.Clone()
+	for _, spec := range cc {
+		if _, ok := h.IndexOf(spec.Name, true); ok {
+			log.Warn().Msgf("Custom column %q shadows an existing column", spec.Name)
+			continue
+		}
+		col := HeaderColumn{
+			Name:     spec.Name,
+			Number:   spec.Number,
+			Time:     spec.Duration,
+			Capacity: spec.Capacity,
+		}
+		if spec.Number || spec.Capacity {
+			col.Align = tview.AlignRight
+		}
+		header = append(header, col)
+		for i := range rows {
+			var v string
+			if raws[i] != nil {
+				var err error
+				if v, err = CustomColumnValue(spec, raws[i]); err != nil {
+					log.Debug().Err(err).Msgf("Custom column %q evaluation failed", spec.Name)
+				}
+			}
+			rows[i].Fields = append(rows[i].Fields, v)
+		}
+	}
+
+	return header
+}
+
+func rawObjects(oo []runtime.Object) []map[string]interface{} {
+	raws := make([]map[string]interface{}, 0, len(oo))
+	for _, o := range oo {
+		raws = append(raws, rawObject(o))
+	}
+
+	return raws
+}
+
+func rawTableObjects(table *metav1.Table) []map[string]interface{} {
+	raws := make([]map[string]interface{}, 0, len(table.Rows))
+	for _, row := range table.Rows {
+		var m map[string]interface{}
+		if err := json.Unmarshal(row.Object.Raw, &m); err != nil {
+			log.Debug().Err(err).Msg("Unable to decode table row object")
+		}
+		raws = append(raws, m)
+	}
+
+	return raws
+}
+
+func rawObject(o runtime.Object) map[string]interface{} {
+	switch t := o.(type) {
+	case *unstructured.Unstructured:
+		return t.Object
+	case RawWrapper:
+		if raw := t.RawObject(); raw != nil {
+			return raw.Object
+		}
+		return nil
+	}
+	if reflect.ValueOf(o).Kind() != reflect.Ptr {
+		return nil
+	}
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+	if err != nil {
+		return nil
+	}
+
+	return m
+}
+
+// ----------------------------------------------------------------------------
+// Evaluators...
+
+var colExprs = newColumnExprs()
+
+type colExpr struct {
+	ev  colEvaluator
+	err error
+}
+
+// columnExprs caches compiled column expressions.
+type columnExprs struct {
+	exprs map[string]colExpr
+	mx    sync.Mutex
+}
+
+func newColumnExprs() *columnExprs {
+	return &columnExprs{exprs: make(map[string]colExpr)}
+}
+
+func (c *columnExprs) get(spec config.ColumnSpec) (colEvaluator, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if e, ok := c.exprs[spec.Expr]; ok {
+		return e.ev, e.err
+	}
+	var e colExpr
+	if spec.IsJSONPath() {
+		e.ev, e.err = newJSONPathExpr(spec.Expr)
+	} else {
+		e.ev, e.err = newCELExpr(spec.Expr)
+	}
+	if e.err != nil {
+		log.Warn().Err(e.err).Msgf("Invalid custom column expression %q", spec.Expr)
+	}
+	c.exprs[spec.Expr] = e
+
+	return e.ev, e.err
+}
+
+type jsonPathExpr struct {
+	jp *jsonpath.JSONPath
+}
+
+func newJSONPathExpr(expr string) (*jsonPathExpr, error) {
+	if !strings.HasPrefix(expr, "{") {
+		expr = "{" + expr + "}"
+	}
+	jp := jsonpath.New("col").AllowMissingKeys(true)
+	if err := jp.Parse(expr); err != nil {
+		return nil, err
+	}
+
+	return &jsonPathExpr{jp: jp}, nil
+}
+
+func (j *jsonPathExpr) eval(o map[string]interface{}) (string, error) {
+	rr, err := j.jp.FindResults(o)
+	if err != nil {
+		return "", err
+	}
+	var ss []string
+	for _, r := range rr {
+		for _, v := range r {
+			ss = append(ss, fmt.Sprintf("%v", v.Interface()))
+		}
+	}
+
+	return strings.Join(ss, ","), nil
+}
+
+type celExpr struct {
+	prg cel.Program
+}
+
+func newCELExpr(expr string) (*celExpr, error) {
+	opts := []cel.EnvOption{ext.Strings(), cel.Variable("object", cel.DynType)}
+	for _, v := range celVars {
+		opts = append(opts, cel.Variable(v, cel.DynType))
+	}
+	env, err := cel.NewEnv(opts...)
+	if err != nil {
+		return nil, err
+	}
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	return &celExpr{prg: prg}, nil
+}
+
+func (c *celExpr) eval(o map[string]interface{}) (string, error) {
+	vars := map[string]interface{}{"object": o}
+	for _, v := range celVars {
+		if val, ok := o[v]; ok {
+			vars[v] = val
+		} else {
+			vars[v] = map[string]interface{}{}
+		}
+	}
+	out, _, err := c.prg.Eval(vars)
+	if err != nil {
+		return "", err
+	}
+
+	return celToStr(out), nil
+}
+
+func celToStr(v ref.Val) string {
+	switch t := v.(type) {
+	case traits.Lister:
+		var ss []string
+		for it := t.Iterator(); it.HasNext() == types.True; {
+			ss = append(ss, celToStr(it.Next()))
+		}
+		return strings.Join(ss, ",")
+	case traits.Mapper:
+		m, err := t.ConvertToNative(reflect.TypeOf(map[string]interface{}{}))
+		if err != nil {
+			return ""
+		}
+		return fmt.Sprintf("%v", m)
+	}
+
+	return fmt.Sprintf("%v", v.Value())
+}
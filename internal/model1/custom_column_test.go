@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCustomColumnValue(t *testing.T) {
+	o := makeRawPod("p1", "blee", time.Now().Add(-2*time.Hour))
+	uu := map[string]struct {
+		spec string
+		e    string
+	}{
+		"jsonpath": {
+			spec: "IMG:.spec.containers[0].image",
+			e:    "nginx:1.25",
+		},
+		"jsonpath-braces": {
+			spec: "IMG:{.spec.containers[*].image}",
+			e:    "nginx:1.25,busybox",
+		},
+		"jsonpath-missing": {
+			spec: "X:.spec.nope",
+		},
+		"cel-annotation": {
+			spec: "TEAM:metadata.annotations['team']",
+			e:    "blee",
+		},
+		"cel-list": {
+			spec: "IMGS:spec.containers.map(c, c.name)",
+			e:    "c1,c2",
+		},
+		"cel-number": {
+			spec: "COUNT:size(spec.containers)|N",
+			e:    "2",
+		},
+		"cel-ternary": {
+			spec: "QOS:has(status.qosClass) ? status.qosClass : 'n/a'",
+			e:    "n/a",
+		},
+		"duration": {
+			spec: "STARTED:.status.startTime|D",
+			e:    "120m",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v, err := CustomColumnValue(config.ParseColumnSpec(u.spec), o.Object)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, v)
+		})
+	}
+}
+
+func TestCustomColumnValueErr(t *testing.T) {
+	o := makeRawPod("p1", "blee", time.Now())
+
+	_, err := CustomColumnValue(config.ParseColumnSpec("X:spec.containers["), o.Object)
+	assert.Error(t, err)
+}
+
+func TestTableDataReconcileCustomColumns(t *testing.T) {
+	cv := config.NewCustomView()
+	cv.Views["v1/pods"] = config.ViewSetting{
+		Columns: []string{"NAME", "TEAM:metadata.annotations['team']", "COUNT:size(spec.containers)|N"},
+	}
+	ctx := context.WithValue(context.Background(), internal.KeyViewConfig, cv)
+
+	td := NewTableData(client.NewGVR("v1/pods"))
+	oo := []runtime.Object{
+		makeRawPod("p1", "blee", time.Now()),
+		makeRawPod("p2", "duh", time.Now()),
+	}
+	assert.NoError(t, td.Reconcile(ctx, rawRenderer{}, oo))
+
+	h := td.Header()
+	assert.Equal(t, []string{"NAME", "TEAM", "COUNT"}, h.ColumnNames(true))
+	assert.True(t, h[2].Number)
+	re, ok := td.FindRow("default/p2")
+	assert.True(t, ok)
+	assert.Equal(t, Fields{"p2", "duh", "2"}, re.Row.Fields)
+}
+
+// Helpers...
+
+type rawRenderer struct{}
+
+func (rawRenderer) IsGeneric() bool { return false }
+
+func (rawRenderer) Render(o interface{}, _ string, r *Row) error {
+	u := o.(*unstructured.Unstructured)
+	r.ID, r.Fields = client.FQN(u.GetNamespace(), u.GetName()), Fields{u.GetName()}
+
+	return nil
+}
+
+func (rawRenderer) Header(string) Header {
+	return Header{HeaderColumn{Name: "NAME"}}
+}
+
+func (rawRenderer) ColorerFunc() ColorerFunc { return DefaultColorer }
+
+func makeRawPod(n, team string, start time.Time) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"namespace":   "default",
+			"name":        n,
+			"annotations": map[string]interface{}{"team": team},
+		},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "c1", "image": "nginx:1.25"},
+				map[string]interface{}{"name": "c2", "image": "busybox"},
+			},
+		},
+		"status": map[string]interface{}{
+			"startTime": start.UTC().Format(time.RFC3339),
+		},
+	}}
+}
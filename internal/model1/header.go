@@ -21,6 +21,7 @@ type HeaderColumn struct {
 	MX        bool
 	Time      bool
 	Capacity  bool
+	Number    bool
 	VS        bool
 }
 
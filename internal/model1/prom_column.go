@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/prom"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+func promMetrics(ctx context.Context, gvr client.GVR) *prom.Metrics {
+	fn, ok := ctx.Value(internal.KeyPrometheus).(prom.MetricsFn)
+	if !ok || fn == nil {
+		return nil
+	}
+	mx := fn()
+	if mx == nil || !mx.HasQueries(gvr.String()) {
+		return nil
+	}
+
+	return mx
+}
+
+func addPromColumns(ctx context.Context, h Header, rows Rows, gvr client.GVR, ns string, mx *prom.Metrics) Header {
+	header := h.Clone()
+	for _, col := range mx.Columns(ctx, gvr.String(), ns) {
+		if _, ok := header.IndexOf(col.Name, true); ok {
+			log.Warn().Msgf("Prometheus column %q shadows an existing column", col.Name)
+			continue
+		}
+		header = append(header, HeaderColumn{
+			Name:   col.Name,
+			Align:  tview.AlignRight,
+			Number: true,
+		})
+		for i := range rows {
+			rows[i].Fields = append(rows[i].Fields, col.Value(prom.ResourceKey(rows[i].ID)))
+		}
+	}
+
+	return header
+}
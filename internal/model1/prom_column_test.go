@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/prom"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddPromColumns(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[
+			{"metric":{"node":"n1"},"value":[1700000000,"0.3"]}
+		]}}`))
+	}))
+	defer srv.Close()
+
+	mx := prom.NewMetrics(prom.NewClient(srv.URL, time.Second), []data.PromQuery{
+		{GVR: "v1/nodes", Name: "LOAD", Expr: `load`, Format: "%.1f"},
+		{GVR: "v1/nodes", Name: "NAME", Expr: `load`},
+	})
+	ctx := context.WithValue(context.Background(), internal.KeyPrometheus, prom.MetricsFn(func() *prom.Metrics { return mx }))
+	assert.Nil(t, promMetrics(ctx, client.NewGVR("v1/pods")))
+	assert.NotNil(t, promMetrics(ctx, client.NewGVR("v1/nodes")))
+
+	h := Header{{Name: "NAME"}}
+	rows := Rows{
+		{ID: "n1", Fields: Fields{"n1"}},
+		{ID: "n2", Fields: Fields{"n2"}},
+	}
+	h = addPromColumns(ctx, h, rows, client.NewGVR("v1/nodes"), client.ClusterScope, mx)
+
+	assert.Equal(t, Header{{Name: "NAME"}, {Name: "LOAD", Align: tview.AlignRight, Number: true}}, h)
+	assert.Equal(t, Fields{"n1", "0.3"}, rows[0].Fields)
+	assert.Equal(t, Fields{"n2", prom.MissingValue}, rows[1].Fields)
+}
@@ -108,7 +108,7 @@ func (t *TableData) Sort(sc SortColumn) {
 		t.GetNamespace(),
 		idx,
 		col.Time,
-		col.MX,
+		col.MX || col.Number,
 		col.Capacity,
 		sc.ASC,
 	)
@@ -243,7 +243,11 @@ func (t *TableData) Reset(ns string) {
 }
 
 func (t *TableData) Reconcile(ctx context.Context, r Renderer, oo []runtime.Object) error {
-	var rows Rows
+	var (
+		rows Rows
+		raws []map[string]interface{}
+	)
+	cc := customColumns(ctx, t.gvr)
 	if len(oo) > 0 {
 		if r.IsGeneric() {
 			table, ok := oo[0].(*metav1.Table)
@@ -254,16 +258,29 @@ func (t *TableData) Reconcile(ctx context.Context, r Renderer, oo []runtime.Obje
 			if err := GenericHydrate(t.namespace, table, rows, r); err != nil {
 				return err
 			}
+			if len(cc) > 0 {
+				raws = rawTableObjects(table)
+			}
 		} else {
 			rows = make(Rows, len(oo))
 			if err := Hydrate(t.namespace, oo, rows, r); err != nil {
 				return err
 			}
+			if len(cc) > 0 {
+				raws = rawObjects(oo)
+			}
 		}
 	}
 
+	h := r.Header(t.namespace)
+	if len(cc) > 0 {
+		h = addCustomColumns(h, rows, raws, cc)
+	}
+	if mx := promMetrics(ctx, t.gvr); mx != nil {
+		h = addPromColumns(ctx, h, rows, t.gvr, t.namespace, mx)
+	}
 	t.Update(rows)
-	t.SetHeader(t.namespace, r.Header(t.namespace))
+	t.SetHeader(t.namespace, h)
 	if t.HeaderCount() == 0 {
 		return fmt.Errorf("fail to list resource %s", t.gvr)
 	}
@@ -332,7 +349,7 @@ func (t *TableData) Customize(vs *config.ViewSetting, sc SortColumn, manual, wid
 		return t, sc
 	}
 
-	cols := vs.Columns
+	cols := vs.ColumnNames()
 	cdata := TableData{
 		gvr:       t.gvr,
 		namespace: t.namespace,
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package prom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	queryPath      = "/api/v1/query"
+	queryRangePath = "/api/v1/query_range"
+	vectorType     = "vector"
+	matrixType     = "matrix"
+	successStatus  = "success"
+)
+
+// Sample represents an instant vector sample.
+type Sample struct {
+	Labels map[string]string
+	Value  float64
+}
+
+// Series represents a range vector series.
+type Series struct {
+	Labels map[string]string
+	Times  []time.Time
+	Values []float64
+}
+
+type apiResponse struct {
+	Status    string  `json:"status"`
+	Error     string  `json:"error"`
+	ErrorType string  `json:"errorType"`
+	Data      apiData `json:"data"`
+}
+
+type apiData struct {
+	ResultType string      `json:"resultType"`
+	Result     []apiResult `json:"result"`
+}
+
+type apiResult struct {
+	Metric map[string]string `json:"metric"`
+	Value  []interface{}     `json:"value"`
+	Values [][]interface{}   `json:"values"`
+}
+
+// Client represents a Prometheus HTTP API client.
+type Client struct {
+	url  string
+	http *http.Client
+}
+
+// NewClient returns a new Prometheus client.
+func NewClient(u string, timeout time.Duration) *Client {
+	return &Client{
+		url:  strings.TrimSuffix(u, "/"),
+		http: &http.Client{Timeout: timeout},
+	}
+}
+
+// URL returns the Prometheus endpoint.
+func (c *Client) URL() string {
+	return c.url
+}
+
+// Query evaluates an instant query.
+func (c *Client) Query(ctx context.Context, expr string, t time.Time) ([]Sample, error) {
+	params := url.Values{}
+	params.Set("query", expr)
+	params.Set("time", formatTime(t))
+
+	var res apiResponse
+	if err := c.call(ctx, queryPath, params, &res); err != nil {
+		return nil, err
+	}
+	if res.Data.ResultType != vectorType {
+		return nil, fmt.Errorf("expecting a %s result but got %q", vectorType, res.Data.ResultType)
+	}
+	ss := make([]Sample, 0, len(res.Data.Result))
+	for _, r := range res.Data.Result {
+		_, v, err := parsePoint(r.Value)
+		if err != nil {
+			return nil, err
+		}
+		ss = append(ss, Sample{Labels: r.Metric, Value: v})
+	}
+
+	return ss, nil
+}
+
+// QueryRange evaluates a range query.
+func (c *Client) QueryRange(ctx context.Context, expr string, start, end time.Time, step time.Duration) ([]Series, error) {
+	params := url.Values{}
+	params.Set("query", expr)
+	params.Set("start", formatTime(start))
+	params.Set("end", formatTime(end))
+	params.Set("step", strconv.FormatFloat(step.Seconds(), 'f', -1, 64))
+
+	var res apiResponse
+	if err := c.call(ctx, queryRangePath, params, &res); err != nil {
+		return nil, err
+	}
+	if res.Data.ResultType != matrixType {
+		return nil, fmt.Errorf("expecting a %s result but got %q", matrixType, res.Data.ResultType)
+	}
+	ss := make([]Series, 0, len(res.Data.Result))
+	for _, r := range res.Data.Result {
+		s := Series{
+			Labels: r.Metric,
+			Times:  make([]time.Time, 0, len(r.Values)),
+			Values: make([]float64, 0, len(r.Values)),
+		}
+		for _, p := range r.Values {
+			t, v, err := parsePoint(p)
+			if err != nil {
+				return nil, err
+			}
+			s.Times, s.Values = append(s.Times, t), append(s.Values, v)
+		}
+		ss = append(ss, s)
+	}
+
+	return ss, nil
+}
+
+func (c *Client) call(ctx context.Context, path string, params url.Values, res *apiResponse) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+path+"?"+params.Encode(), http.NoBody)
+	if err != nil {
+		return err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(res); err != nil {
+		return fmt.Errorf("prometheus response decode failed (%s): %w", resp.Status, err)
+	}
+	if res.Status != successStatus {
+		return fmt.Errorf("prometheus query failed: %s: %s", res.ErrorType, res.Error)
+	}
+
+	return nil
+}
+
+func parsePoint(p []interface{}) (time.Time, float64, error) {
+	if len(p) != 2 {
+		return time.Time{}, 0, fmt.Errorf("invalid sample %v", p)
+	}
+	ts, ok := p[0].(float64)
+	if !ok {
+		return time.Time{}, 0, fmt.Errorf("invalid sample time %v", p[0])
+	}
+	s, ok := p[1].(string)
+	if !ok {
+		return time.Time{}, 0, fmt.Errorf("invalid sample value %v", p[1])
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	sec := int64(ts)
+
+	return time.Unix(sec, int64((ts-float64(sec))*1e9)), v, nil
+}
+
+func formatTime(t time.Time) string {
+	return strconv.FormatFloat(float64(t.UnixNano())/1e9, 'f', 3, 64)
+}
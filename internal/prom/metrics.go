@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package prom
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/util/cache"
+)
+
+const (
+	// MissingValue represents a resource without metrics.
+	MissingValue = "n/a"
+
+	// QueryTimeout represents the max time a query may take.
+	QueryTimeout = 5 * time.Second
+
+	mxCacheSize   = 100
+	mxCacheExpiry = 15 * time.Second
+	allNamespaces = ".*"
+)
+
+// Column represents a metrics column values keyed by resource id.
+type Column struct {
+	Name   string
+	Format string
+	Values map[string]float64
+}
+
+// Value returns a formatted resource value.
+func (c Column) Value(id string) string {
+	v, ok := c.Values[id]
+	if !ok || math.IsNaN(v) || math.IsInf(v, 0) {
+		return MissingValue
+	}
+
+	return fmt.Sprintf(c.Format, v)
+}
+
+// History represents a resource metric over time.
+type History struct {
+	Name   string
+	Format string
+	Series Series
+}
+
+// MetricsFn resolves metrics on demand as the endpoint may need to be port-forwarded.
+type MetricsFn func() *Metrics
+
+// Metrics serves resources metrics from configured PromQL templates.
+type Metrics struct {
+	client  *Client
+	queries []data.PromQuery
+	cache   *cache.LRUExpireCache
+}
+
+// NewMetrics returns a new metrics service.
+func NewMetrics(c *Client, qq []data.PromQuery) *Metrics {
+	return &Metrics{
+		client:  c,
+		queries: qq,
+		cache:   cache.NewLRUExpireCache(mxCacheSize),
+	}
+}
+
+// HasQueries checks if metrics are configured for a given resource.
+func (m *Metrics) HasQueries(gvr string) bool {
+	return len(m.queriesFor(gvr)) > 0
+}
+
+// Columns returns the metrics columns for a given resource in a namespace.
+// Failed queries yield columns without values.
+func (m *Metrics) Columns(ctx context.Context, gvr, ns string) []Column {
+	qq := m.queriesFor(gvr)
+	cc := make([]Column, 0, len(qq))
+	for _, q := range qq {
+		col := Column{
+			Name:   q.Name,
+			Format: q.ValueFormat(),
+			Values: make(map[string]float64),
+		}
+		ss, err := m.instant(ctx, q, ns)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Prometheus query %q failed", q.Name)
+		}
+		for _, s := range ss {
+			col.Values[SeriesKey(s.Labels, q.KeyLabels())] = s.Value
+		}
+		cc = append(cc, col)
+	}
+
+	return cc
+}
+
+// History returns the metrics history of a given resource.
+func (m *Metrics) History(ctx context.Context, gvr, fqn string, since, step time.Duration) ([]History, error) {
+	ns, _ := client.Namespaced(fqn)
+	end := time.Now()
+	hh := make([]History, 0, len(m.queries))
+	for _, q := range m.queriesFor(gvr) {
+		expr, err := Render(q.Expr, ns)
+		if err != nil {
+			return nil, err
+		}
+		ss, err := m.client.QueryRange(ctx, expr, end.Add(-since), end, step)
+		if err != nil {
+			return nil, fmt.Errorf("prometheus query %q failed: %w", q.Name, err)
+		}
+		h := History{Name: q.Name, Format: q.ValueFormat()}
+		for _, s := range ss {
+			if SeriesKey(s.Labels, q.KeyLabels()) == fqn {
+				h.Series = s
+				break
+			}
+		}
+		hh = append(hh, h)
+	}
+
+	return hh, nil
+}
+
+func (m *Metrics) queriesFor(gvr string) []data.PromQuery {
+	qq := make([]data.PromQuery, 0, len(m.queries))
+	for _, q := range m.queries {
+		if q.GVR == gvr {
+			qq = append(qq, q)
+		}
+	}
+
+	return qq
+}
+
+func (m *Metrics) instant(ctx context.Context, q data.PromQuery, ns string) ([]Sample, error) {
+	expr, err := Render(q.Expr, ns)
+	if err != nil {
+		return nil, err
+	}
+	if ss, ok := m.cache.Get(expr); ok {
+		if samples, ok := ss.([]Sample); ok {
+			return samples, nil
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeout)
+	defer cancel()
+	ss, err := m.client.Query(ctx, expr, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	m.cache.Add(expr, ss, mxCacheExpiry)
+
+	return ss, nil
+}
+
+// Render expands a PromQL template for a given namespace.
+// The namespace is rendered as a regular expression suitable for a =~ matcher.
+func Render(expr, ns string) (string, error) {
+	tpl, err := template.New("promql").Parse(expr)
+	if err != nil {
+		return "", err
+	}
+	var buff bytes.Buffer
+	if err := tpl.Execute(&buff, struct{ Namespace string }{Namespace: nsMatcher(ns)}); err != nil {
+		return "", err
+	}
+
+	return buff.String(), nil
+}
+
+func nsMatcher(ns string) string {
+	if client.IsClusterWide(ns) {
+		return allNamespaces
+	}
+	nn := []string{ns}
+	if client.IsMultiNamespace(ns) {
+		nn = client.Namespaces(ns)
+	}
+	for i := range nn {
+		nn[i] = regexp.QuoteMeta(nn[i])
+	}
+
+	return strings.Join(nn, "|")
+}
+
+// SeriesKey returns the resource id of a series given its key labels.
+func SeriesKey(ll map[string]string, keys []string) string {
+	vv := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if v := ll[k]; v != "" {
+			vv = append(vv, v)
+		}
+	}
+
+	return strings.Join(vv, "/")
+}
+
+// ResourceKey returns the series key of a resource given its path.
+func ResourceKey(path string) string {
+	if ns, n := client.Namespaced(path); ns == client.ClusterScope {
+		return n
+	}
+
+	return path
+}
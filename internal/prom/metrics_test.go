@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package prom_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/prom"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	uu := map[string]struct {
+		ns, e string
+	}{
+		"all": {
+			e: `rate(http_requests_total{namespace=~".*"}[5m])`,
+		},
+		"single": {
+			ns: "fred",
+			e:  `rate(http_requests_total{namespace=~"fred"}[5m])`,
+		},
+		"multi": {
+			ns: "fred,blee",
+			e:  `rate(http_requests_total{namespace=~"fred|blee"}[5m])`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			expr, err := prom.Render(`rate(http_requests_total{namespace=~"{{.Namespace}}"}[5m])`, u.ns)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, expr)
+		})
+	}
+}
+
+func TestSeriesKey(t *testing.T) {
+	ll := map[string]string{"namespace": "fred", "pod": "p1", "node": "n1"}
+
+	assert.Equal(t, "fred/p1", prom.SeriesKey(ll, []string{"namespace", "pod"}))
+	assert.Equal(t, "n1", prom.SeriesKey(ll, []string{"node"}))
+	assert.Equal(t, "", prom.SeriesKey(ll, []string{"instance"}))
+}
+
+func TestResourceKey(t *testing.T) {
+	assert.Equal(t, "fred/p1", prom.ResourceKey("fred/p1"))
+	assert.Equal(t, "n1", prom.ResourceKey("-/n1"))
+	assert.Equal(t, "n1", prom.ResourceKey("n1"))
+}
+
+func TestMetricsColumns(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "/api/v1/query", r.URL.Path)
+		assert.Equal(t, `rps{namespace=~"fred"}`, r.URL.Query().Get("query"))
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[
+			{"metric":{"namespace":"fred","pod":"p1"},"value":[1700000000.5,"1.5"]},
+			{"metric":{"namespace":"fred","pod":"p2"},"value":[1700000000.5,"NaN"]}
+		]}}`))
+	}))
+	defer srv.Close()
+
+	mx := prom.NewMetrics(prom.NewClient(srv.URL, time.Second), []data.PromQuery{
+		{GVR: "v1/pods", Name: "RPS", Expr: `rps{namespace=~"{{.Namespace}}"}`},
+		{GVR: "v1/nodes", Name: "LOAD", Expr: `load`},
+	})
+	assert.True(t, mx.HasQueries("v1/pods"))
+	assert.False(t, mx.HasQueries("v1/services"))
+
+	cc := mx.Columns(context.Background(), "v1/pods", "fred")
+	assert.Equal(t, 1, len(cc))
+	assert.Equal(t, "RPS", cc[0].Name)
+	assert.Equal(t, "1.50", cc[0].Value("fred/p1"))
+	assert.Equal(t, prom.MissingValue, cc[0].Value("fred/p2"))
+	assert.Equal(t, prom.MissingValue, cc[0].Value("fred/p3"))
+
+	mx.Columns(context.Background(), "v1/pods", "fred")
+	assert.Equal(t, 1, calls)
+}
+
+func TestMetricsColumnsFailed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"parse error"}`))
+	}))
+	defer srv.Close()
+
+	mx := prom.NewMetrics(prom.NewClient(srv.URL, time.Second), []data.PromQuery{
+		{GVR: "v1/pods", Name: "RPS", Expr: `rps`},
+	})
+	cc := mx.Columns(context.Background(), "v1/pods", "")
+	assert.Equal(t, 1, len(cc))
+	assert.Equal(t, prom.MissingValue, cc[0].Value("fred/p1"))
+}
+
+func TestMetricsHistory(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/query_range", r.URL.Path)
+		assert.Equal(t, "60", r.URL.Query().Get("step"))
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"matrix","result":[
+			{"metric":{"namespace":"fred","pod":"p1"},"values":[[1700000000,"1"],[1700000060,"2"]]},
+			{"metric":{"namespace":"fred","pod":"p2"},"values":[[1700000000,"3"]]}
+		]}}`))
+	}))
+	defer srv.Close()
+
+	mx := prom.NewMetrics(prom.NewClient(srv.URL, time.Second), []data.PromQuery{
+		{GVR: "v1/pods", Name: "RPS", Expr: `rps`},
+	})
+	hh, err := mx.History(context.Background(), "v1/pods", "fred/p1", time.Hour, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(hh))
+	assert.Equal(t, []float64{1, 2}, hh[0].Series.Values)
+	assert.Equal(t, time.Unix(1700000060, 0), hh[0].Series.Times[1])
+}
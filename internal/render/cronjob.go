@@ -7,13 +7,18 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
 )
 
 // CronJob renders a K8s CronJob to screen.
@@ -31,6 +36,10 @@ func (CronJob) Header(ns string) model1.Header {
 		model1.HeaderColumn{Name: "SUSPEND"},
 		model1.HeaderColumn{Name: "ACTIVE"},
 		model1.HeaderColumn{Name: "LAST_SCHEDULE", Time: true},
+		model1.HeaderColumn{Name: "LAST_SUCCESS", Time: true},
+		model1.HeaderColumn{Name: "LAST_FAILURE", Time: true},
+		model1.HeaderColumn{Name: "SUCCESS%", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "AVG_DURATION", Time: true},
 		model1.HeaderColumn{Name: "SELECTOR", Wide: true},
 		model1.HeaderColumn{Name: "CONTAINERS", Wide: true},
 		model1.HeaderColumn{Name: "IMAGES", Wide: true},
@@ -42,12 +51,12 @@ func (CronJob) Header(ns string) model1.Header {
 
 // Render renders a K8s resource to screen.
 func (c CronJob) Render(o interface{}, ns string, r *model1.Row) error {
-	raw, ok := o.(*unstructured.Unstructured)
+	cs, ok := o.(*CronJobWithStats)
 	if !ok {
-		return fmt.Errorf("expected CronJob, but got %T", o)
+		return fmt.Errorf("expected CronJobWithStats, but got %T", o)
 	}
 	var cj batchv1.CronJob
-	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &cj)
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(cs.Raw.Object, &cj)
 	if err != nil {
 		return err
 	}
@@ -66,6 +75,10 @@ func (c CronJob) Render(o interface{}, ns string, r *model1.Row) error {
 		boolPtrToStr(cj.Spec.Suspend),
 		strconv.Itoa(len(cj.Status.Active)),
 		lastScheduled,
+		toAgeOrNA(cs.Stats.LastSuccess),
+		toAgeOrNA(cs.Stats.LastFailure),
+		cs.Stats.successRate(),
+		cs.Stats.avgDuration(),
 		jobSelector(cj.Spec.JobTemplate.Spec),
 		podContainerNames(cj.Spec.JobTemplate.Spec.Template.Spec, true),
 		podImageNames(cj.Spec.JobTemplate.Spec.Template.Spec, true),
@@ -77,8 +90,73 @@ func (c CronJob) Render(o interface{}, ns string, r *model1.Row) error {
 	return nil
 }
 
+// CronJobStats tracks a cronjob's run statistics.
+type CronJobStats struct {
+	Runs        int
+	Succeeded   int
+	Failed      int
+	LastSuccess time.Time
+	LastFailure time.Time
+	AvgDuration time.Duration
+}
+
+// SuccessRate returns the percentage of successful completed runs.
+func (s CronJobStats) SuccessRate() int {
+	done := s.Succeeded + s.Failed
+	if done == 0 {
+		return 0
+	}
+
+	return s.Succeeded * 100 / done
+}
+
+func (s CronJobStats) successRate() string {
+	if s.Succeeded+s.Failed == 0 {
+		return NAValue
+	}
+
+	return strconv.Itoa(s.SuccessRate()) + "%"
+}
+
+func (s CronJobStats) avgDuration() string {
+	if s.Succeeded+s.Failed == 0 {
+		return NAValue
+	}
+
+	return duration.HumanDuration(s.AvgDuration)
+}
+
+// CronJobWithStats represents a cronjob and its owned jobs run stats.
+type CronJobWithStats struct {
+	Raw   *unstructured.Unstructured
+	Stats CronJobStats
+}
+
+// RawObject returns the raw resource.
+func (c *CronJobWithStats) RawObject() *unstructured.Unstructured {
+	return c.Raw
+}
+
+// GetObjectKind returns a schema object.
+func (c *CronJobWithStats) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c *CronJobWithStats) DeepCopyObject() runtime.Object {
+	return c
+}
+
 // Helpers
 
+func toAgeOrNA(t time.Time) string {
+	if t.IsZero() {
+		return NAValue
+	}
+
+	return ToAge(metav1.Time{Time: t})
+}
+
 func jobSelector(spec batchv1.JobSpec) string {
 	if spec.Selector == nil {
 		return MissingValue
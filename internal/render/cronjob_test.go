@@ -5,6 +5,7 @@ package render_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
@@ -12,10 +13,34 @@ import (
 )
 
 func TestCronJobRender(t *testing.T) {
-	c := render.CronJob{}
-	r := model1.NewRow(6)
+	uu := map[string]struct {
+		stats render.CronJobStats
+		e     model1.Fields
+	}{
+		"no-runs": {
+			e: model1.Fields{"n/a", "n/a", "n/a", "n/a"},
+		},
+		"runs": {
+			stats: render.CronJobStats{
+				Runs:        4,
+				Succeeded:   3,
+				Failed:      1,
+				LastSuccess: time.Now().Add(-time.Hour),
+				LastFailure: time.Now().Add(-2 * time.Hour),
+				AvgDuration: 90 * time.Second,
+			},
+			e: model1.Fields{"60m", "120m", "75%", "90s"},
+		},
+	}
 
-	assert.NoError(t, c.Render(load(t, "cj"), "", &r))
-	assert.Equal(t, "default/hello", r.ID)
-	assert.Equal(t, model1.Fields{"default", "hello", "0", "*/1 * * * *", "false", "0"}, r.Fields[:6])
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, r := render.CronJob{}, model1.NewRow(17)
+			assert.NoError(t, c.Render(&render.CronJobWithStats{Raw: load(t, "cj"), Stats: u.stats}, "", &r))
+			assert.Equal(t, "default/hello", r.ID)
+			assert.Equal(t, model1.Fields{"default", "hello", "0", "*/1 * * * *", "false", "0"}, r.Fields[:6])
+			assert.Equal(t, u.e, r.Fields[7:11])
+		})
+	}
 }
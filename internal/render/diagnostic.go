@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ThrottleThreshold represents a rate limiter wait worth flagging.
+const ThrottleThreshold = 1 * time.Second
+
+// Diagnostic renders api client diagnostics to screen.
+type Diagnostic struct {
+	Base
+}
+
+// Header returns a header row.
+func (Diagnostic) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "GVR"},
+		model1.HeaderColumn{Name: "SYNCED", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "ITEMS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "CACHE(KB)", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "REQUESTS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "ERRORS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "LISTS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "RELISTS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "LIST-P50", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "LIST-P99", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "WATCHES", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "W-RESTARTS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "W-ERRORS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "THROTTLE-P99", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "THROTTLE-MAX", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Diagnostic) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(DiagnosticRes)
+	if !ok {
+		return fmt.Errorf("expected DiagnosticRes, but got %T", o)
+	}
+
+	s := res.Stats
+	r.ID = res.GVR
+	r.Fields = model1.Fields{
+		res.GVR,
+		fmt.Sprintf("%d/%d", res.Synced, res.Informers),
+		strconv.Itoa(res.Items),
+		strconv.FormatInt(res.CacheBytes/1024, 10),
+		strconv.Itoa(s.Requests),
+		strconv.Itoa(s.Errors),
+		strconv.Itoa(s.Lists),
+		strconv.Itoa(s.Relists),
+		toLatency(s.ListLatency.Quantile(0.5)),
+		toLatency(s.ListLatency.Quantile(0.99)),
+		strconv.Itoa(s.ActiveWatches),
+		strconv.Itoa(s.WatchRestarts),
+		strconv.Itoa(s.WatchErrors),
+		toLatency(s.ThrottleWait.Quantile(0.99)),
+		toLatency(s.ThrottleWait.Max),
+		res.diagnose(),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func toLatency(d time.Duration) string {
+	if d == 0 {
+		return ZeroValue
+	}
+	if d < time.Millisecond {
+		return d.Round(time.Microsecond).String()
+	}
+
+	return d.Round(time.Millisecond).String()
+}
+
+// DiagnosticRes represents a resource api client diagnostics.
+type DiagnosticRes struct {
+	GVR               string
+	Informers, Synced int
+	Items             int
+	CacheBytes        int64
+	Stats             client.RequestStats
+}
+
+func (r DiagnosticRes) diagnose() string {
+	switch {
+	case r.Synced < r.Informers:
+		return "informer not synced"
+	case r.Stats.WatchErrors > 0:
+		return "watch errors"
+	case r.Stats.Errors > 0:
+		return "request errors"
+	case r.Stats.ThrottleWait.Max >= ThrottleThreshold:
+		return "client side throttling"
+	}
+
+	return ""
+}
+
+// GetObjectKind returns a schema object.
+func (DiagnosticRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r DiagnosticRes) DeepCopyObject() runtime.Object {
+	return r
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiagnosticRender(t *testing.T) {
+	stats := func(errs int, throttle time.Duration) client.RequestStats {
+		s := client.RequestStats{
+			GVR:           "v1/pods",
+			Requests:      10,
+			Errors:        errs,
+			Lists:         3,
+			Relists:       1,
+			Watches:       2,
+			ActiveWatches: 1,
+			WatchRestarts: 1,
+			ListLatency:   client.NewHistogram(),
+			WatchLatency:  client.NewHistogram(),
+			ThrottleWait:  client.NewHistogram(),
+		}
+		s.ListLatency.Observe(80 * time.Millisecond)
+		if throttle > 0 {
+			s.ThrottleWait.Observe(throttle)
+		}
+		return s
+	}
+
+	uu := map[string]struct {
+		res render.DiagnosticRes
+		e   model1.Fields
+	}{
+		"healthy": {
+			res: render.DiagnosticRes{
+				GVR:        "v1/pods",
+				Informers:  2,
+				Synced:     2,
+				Items:      20,
+				CacheBytes: 4096,
+				Stats:      stats(0, 0),
+			},
+			e: model1.Fields{"v1/pods", "2/2", "20", "4", "10", "0", "3", "1", "80ms", "80ms", "1", "1", "0", "0", "0", ""},
+		},
+		"not-synced": {
+			res: render.DiagnosticRes{
+				GVR:       "v1/pods",
+				Informers: 2,
+				Synced:    1,
+				Stats:     stats(0, 0),
+			},
+			e: model1.Fields{"v1/pods", "1/2", "0", "0", "10", "0", "3", "1", "80ms", "80ms", "1", "1", "0", "0", "0", "informer not synced"},
+		},
+		"throttled": {
+			res: render.DiagnosticRes{
+				GVR:   "v1/pods",
+				Stats: stats(0, 1500*time.Millisecond),
+			},
+			e: model1.Fields{"v1/pods", "0/0", "0", "0", "10", "0", "3", "1", "80ms", "80ms", "1", "1", "0", "1.5s", "1.5s", "client side throttling"},
+		},
+	}
+
+	var r render.Diagnostic
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var row model1.Row
+			assert.NoError(t, r.Render(u.res, "", &row))
+			assert.Equal(t, "v1/pods", row.ID)
+			assert.Equal(t, u.e, row.Fields)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/derailed/tview"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// HPA renders a K8s HorizontalPodAutoscaler to screen.
+type HPA struct {
+	Base
+}
+
+// Header returns a header row.
+func (HPA) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "REFERENCE"},
+		model1.HeaderColumn{Name: "TARGETS"},
+		model1.HeaderColumn{Name: "MINPODS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "MAXPODS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "REPLICAS", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "DESIRED", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "TREND"},
+		model1.HeaderColumn{Name: "LABELS", Wide: true},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (h HPA) Render(o interface{}, ns string, r *model1.Row) error {
+	hh, ok := o.(*HPAWithHistory)
+	if !ok {
+		return fmt.Errorf("expected HPAWithHistory, but got %T", o)
+	}
+	hpa, err := ToHPA(hh.Raw)
+	if err != nil {
+		return err
+	}
+
+	var min int32 = 1
+	if hpa.Spec.MinReplicas != nil {
+		min = *hpa.Spec.MinReplicas
+	}
+	r.ID = client.MetaFQN(hpa.ObjectMeta)
+	r.Fields = model1.Fields{
+		hpa.Namespace,
+		hpa.Name,
+		hpa.Spec.ScaleTargetRef.Kind + "/" + hpa.Spec.ScaleTargetRef.Name,
+		strings.Join(HPATargets(hpa), ", "),
+		strconv.Itoa(int(min)),
+		strconv.Itoa(int(hpa.Spec.MaxReplicas)),
+		strconv.Itoa(int(hpa.Status.CurrentReplicas)),
+		strconv.Itoa(int(hpa.Status.DesiredReplicas)),
+		tchart.SparkText(hh.Replicas),
+		mapToStr(hpa.Labels),
+		AsStatus(h.diagnose(hpa)),
+		ToAge(hpa.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func (HPA) diagnose(hpa *autoscalingv2.HorizontalPodAutoscaler) error {
+	for _, c := range hpa.Status.Conditions {
+		if c.Status != v1.ConditionFalse {
+			continue
+		}
+		if c.Type == autoscalingv2.AbleToScale || c.Type == autoscalingv2.ScalingActive {
+			return fmt.Errorf("%s: %s", c.Type, c.Reason)
+		}
+	}
+
+	return nil
+}
+
+// ToHPA converts a raw resource to an HPA.
+func ToHPA(raw *unstructured.Unstructured) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	var hpa autoscalingv2.HorizontalPodAutoscaler
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &hpa)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hpa, nil
+}
+
+// HPATargets returns each metric current vs target values.
+func HPATargets(hpa *autoscalingv2.HorizontalPodAutoscaler) []string {
+	ss := make([]string, 0, len(hpa.Spec.Metrics))
+	for i, spec := range hpa.Spec.Metrics {
+		var current *autoscalingv2.MetricStatus
+		if i < len(hpa.Status.CurrentMetrics) {
+			current = &hpa.Status.CurrentMetrics[i]
+		}
+		ss = append(ss, hpaTarget(spec, current))
+	}
+
+	return ss
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func hpaTarget(spec autoscalingv2.MetricSpec, st *autoscalingv2.MetricStatus) string {
+	var (
+		name   string
+		target autoscalingv2.MetricTarget
+		cur    *autoscalingv2.MetricValueStatus
+	)
+	switch spec.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		name, target = string(spec.Resource.Name), spec.Resource.Target
+		if st != nil && st.Resource != nil {
+			cur = &st.Resource.Current
+		}
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		name, target = spec.ContainerResource.Container+"/"+string(spec.ContainerResource.Name), spec.ContainerResource.Target
+		if st != nil && st.ContainerResource != nil {
+			cur = &st.ContainerResource.Current
+		}
+	case autoscalingv2.PodsMetricSourceType:
+		name, target = spec.Pods.Metric.Name, spec.Pods.Target
+		if st != nil && st.Pods != nil {
+			cur = &st.Pods.Current
+		}
+	case autoscalingv2.ObjectMetricSourceType:
+		name, target = spec.Object.Metric.Name, spec.Object.Target
+		if st != nil && st.Object != nil {
+			cur = &st.Object.Current
+		}
+	case autoscalingv2.ExternalMetricSourceType:
+		name, target = spec.External.Metric.Name, spec.External.Target
+		if st != nil && st.External != nil {
+			cur = &st.External.Current
+		}
+	default:
+		return UnknownValue
+	}
+
+	return name + ": " + metricValue(target.Type, cur) + "/" + metricTarget(target)
+}
+
+func metricTarget(t autoscalingv2.MetricTarget) string {
+	switch t.Type {
+	case autoscalingv2.UtilizationMetricType:
+		if t.AverageUtilization != nil {
+			return strconv.Itoa(int(*t.AverageUtilization)) + "%"
+		}
+	case autoscalingv2.AverageValueMetricType:
+		return quantityToStr(t.AverageValue)
+	case autoscalingv2.ValueMetricType:
+		return quantityToStr(t.Value)
+	}
+
+	return UnknownValue
+}
+
+func metricValue(kind autoscalingv2.MetricTargetType, cur *autoscalingv2.MetricValueStatus) string {
+	if cur == nil {
+		return UnknownValue
+	}
+	switch kind {
+	case autoscalingv2.UtilizationMetricType:
+		if cur.AverageUtilization != nil {
+			return strconv.Itoa(int(*cur.AverageUtilization)) + "%"
+		}
+	case autoscalingv2.AverageValueMetricType:
+		return quantityToStr(cur.AverageValue)
+	case autoscalingv2.ValueMetricType:
+		return quantityToStr(cur.Value)
+	}
+
+	return UnknownValue
+}
+
+func quantityToStr(q *resource.Quantity) string {
+	if q == nil {
+		return UnknownValue
+	}
+
+	return q.String()
+}
+
+// HPAWithHistory represents an HPA and its replica counts sampled during the session.
+type HPAWithHistory struct {
+	Raw      *unstructured.Unstructured
+	Replicas []int64
+}
+
+// RawObject returns the raw resource.
+func (h *HPAWithHistory) RawObject() *unstructured.Unstructured {
+	return h.Raw
+}
+
+// GetObjectKind returns a schema object.
+func (h *HPAWithHistory) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (h *HPAWithHistory) DeepCopyObject() runtime.Object {
+	return h
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHPARender(t *testing.T) {
+	c := render.HPA{}
+	r := model1.NewRow(12)
+
+	assert.NoError(t, c.Render(&render.HPAWithHistory{Raw: load(t, "hpa2"), Replicas: []int64{2, 3, 4}}, "", &r))
+	assert.Equal(t, "default/nginx", r.ID)
+	assert.Equal(t, model1.Fields{
+		"default",
+		"nginx",
+		"Deployment/nginx",
+		"cpu: 95%/80%, packets-per-second: <unknown>/1k",
+		"2",
+		"10",
+		"3",
+		"4",
+		"▄▆█",
+		"",
+		"ScalingActive: FailedGetPodsMetric",
+	}, r.Fields[:11])
+}
+
+func TestHPARenderBadType(t *testing.T) {
+	var r model1.Row
+
+	assert.Error(t, render.HPA{}.Render(load(t, "hpa2"), "", &r))
+}
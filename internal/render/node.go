@@ -148,6 +148,11 @@ type NodeWithMetrics struct {
 	PodCount int
 }
 
+// RawObject returns the raw resource.
+func (n *NodeWithMetrics) RawObject() *unstructured.Unstructured {
+	return n.Raw
+}
+
 // GetObjectKind returns a schema object.
 func (n *NodeWithMetrics) GetObjectKind() schema.ObjectKind {
 	return nil
@@ -213,6 +213,11 @@ type PodWithMetrics struct {
 	MX  *mv1beta1.PodMetrics
 }
 
+// RawObject returns the raw resource.
+func (p *PodWithMetrics) RawObject() *unstructured.Unstructured {
+	return p.Raw
+}
+
 // GetObjectKind returns a schema object.
 func (p *PodWithMetrics) GetObjectKind() schema.ObjectKind {
 	return nil
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Query renders saved queries to screen.
+type Query struct {
+	Base
+}
+
+// Header returns a header row.
+func (Query) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "COMMAND"},
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "FILTER"},
+		model1.HeaderColumn{Name: "LABELS"},
+		model1.HeaderColumn{Name: "SORT"},
+		model1.HeaderColumn{Name: "SHORTCUT"},
+		model1.HeaderColumn{Name: "DESCRIPTION", Wide: true},
+	}
+}
+
+// Render renders a saved query to screen.
+func (Query) Render(o interface{}, _ string, r *model1.Row) error {
+	q, ok := o.(QueryRes)
+	if !ok {
+		return fmt.Errorf("expected QueryRes, but got %T", o)
+	}
+
+	r.ID = q.Name
+	r.Fields = model1.Fields{
+		q.Name,
+		q.Command,
+		q.Namespace,
+		q.Filter,
+		q.Labels,
+		q.SortColumn,
+		q.ShortCut,
+		q.Description,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// QueryRes represents a saved query resource.
+type QueryRes struct {
+	config.Query
+
+	Name string
+}
+
+// GetObjectKind returns a schema object.
+func (QueryRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a query copy.
+func (q QueryRes) DeepCopyObject() runtime.Object {
+	return q
+}
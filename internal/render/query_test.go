@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	cfg "github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryRender(t *testing.T) {
+	o := render.QueryRes{
+		Name: "crashers",
+		Query: cfg.Query{
+			Command:    "pods",
+			Namespace:  "prod",
+			Filter:     "CrashLoop",
+			SortColumn: "RESTARTS:desc",
+			ShortCut:   "Shift-1",
+		},
+	}
+
+	var q render.Query
+	r := model1.NewRow(8)
+	assert.NoError(t, q.Render(o, "", &r))
+	assert.Equal(t, "crashers", r.ID)
+	assert.Equal(t, model1.Fields{"crashers", "pods", "prod", "CrashLoop", "", "RESTARTS:desc", "Shift-1", ""}, r.Fields)
+	assert.Equal(t, len(q.Header("")), len(r.Fields))
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// RightSizing renders container resources recommendations to screen.
+type RightSizing struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (RightSizing) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		idx, ok := h.IndexOf("REC-CPU/R", true)
+		if !ok {
+			return c
+		}
+		if re.Row.Fields[idx] == NAValue {
+			return model1.PendingColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (RightSizing) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "WORKLOAD"},
+		model1.HeaderColumn{Name: "CONTAINER"},
+		model1.HeaderColumn{Name: "SAMPLES", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "CPU/R", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "CPU/L", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "CPU-P95", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "REC-CPU/R", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "MEM/R", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "MEM/L", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "MEM-P95", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "REC-MEM/R", Align: tview.AlignRight, MX: true},
+		model1.HeaderColumn{Name: "REC-MEM/L", Align: tview.AlignRight, MX: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (RightSizing) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(RightSizingRes)
+	if !ok {
+		return fmt.Errorf("expected RightSizingRes, but got %T", o)
+	}
+
+	r.ID = strings.Join([]string{res.Namespace, res.Kind, res.Workload, res.Container}, "/")
+	r.Fields = model1.Fields{
+		res.Namespace,
+		res.Kind + "/" + res.Workload,
+		res.Container,
+		strconv.Itoa(res.Samples),
+		toMc(res.Requests.Cpu().MilliValue()),
+		toMc(res.Limits.Cpu().MilliValue()),
+		toMc(res.CPUP95),
+		recToMc(res.CPURequest),
+		toMi(res.Requests.Memory().Value()),
+		toMi(res.Limits.Memory().Value()),
+		toMi(res.MEMP95),
+		recToMi(res.MEMRequest),
+		recToMi(res.MEMLimit),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func recToMc(q *resource.Quantity) string {
+	if q == nil {
+		return NAValue
+	}
+
+	return toMc(q.MilliValue())
+}
+
+func recToMi(q *resource.Quantity) string {
+	if q == nil {
+		return NAValue
+	}
+
+	return toMi(q.Value())
+}
+
+// RightSizingRes represents a container usage and its resources recommendations.
+type RightSizingRes struct {
+	Namespace, Kind, Workload, Container string
+	Samples                              int
+	Requests, Limits                     v1.ResourceList
+	CPUP95, MEMP95                       int64
+	CPURequest, MEMRequest, MEMLimit     *resource.Quantity
+}
+
+// GetObjectKind returns a schema object.
+func (RightSizingRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r RightSizingRes) DeepCopyObject() runtime.Object {
+	return r
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestRightSizingRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.RightSizingRes
+		e   model1.Fields
+	}{
+		"recommended": {
+			res: render.RightSizingRes{
+				Namespace:  "default",
+				Kind:       "Deployment",
+				Workload:   "fred",
+				Container:  "c1",
+				Samples:    10,
+				Requests:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m"), v1.ResourceMemory: resource.MustParse("512Mi")},
+				Limits:     v1.ResourceList{v1.ResourceMemory: resource.MustParse("1Gi")},
+				CPUP95:     100,
+				MEMP95:     100 * 1024 * 1024,
+				CPURequest: resource.NewMilliQuantity(110, resource.DecimalSI),
+				MEMRequest: resource.NewQuantity(110*1024*1024, resource.BinarySI),
+				MEMLimit:   resource.NewQuantity(125*1024*1024, resource.BinarySI),
+			},
+			e: model1.Fields{"default", "Deployment/fred", "c1", "10", "500", "0", "100", "110", "512", "1024", "100", "110", "125"},
+		},
+		"pending": {
+			res: render.RightSizingRes{
+				Namespace: "default",
+				Kind:      "Pod",
+				Workload:  "fred",
+				Container: "c1",
+				Samples:   1,
+				CPUP95:    10,
+				MEMP95:    1024 * 1024,
+			},
+			e: model1.Fields{"default", "Pod/fred", "c1", "1", "0", "0", "10", "n/a", "0", "0", "1", "n/a", "n/a"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			assert.NoError(t, render.RightSizing{}.Render(u.res, "", &r))
+			assert.Equal(t, "default/"+u.res.Kind+"/fred/c1", r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -21,7 +21,7 @@ type Role struct {
 // Header returns a header row.
 func (Role) Header(ns string) model1.Header {
 	var h model1.Header
-	if client.IsAllNamespaces(ns) {
+	if client.IsAllNamespaces(ns) || client.IsMultiNamespace(ns) {
 		h = append(h, model1.HeaderColumn{Name: "NAMESPACE"})
 	}
 
@@ -47,7 +47,7 @@ func (r Role) Render(o interface{}, ns string, row *model1.Row) error {
 
 	row.ID = client.MetaFQN(ro.ObjectMeta)
 	row.Fields = make(model1.Fields, 0, len(r.Header(ns)))
-	if client.IsAllNamespaces(ns) {
+	if client.IsAllNamespaces(ns) || client.IsMultiNamespace(ns) {
 		row.Fields = append(row.Fields, ro.Namespace)
 	}
 	row.Fields = append(row.Fields,
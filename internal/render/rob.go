@@ -22,7 +22,7 @@ type RoleBinding struct {
 // Header returns a header rbw.
 func (RoleBinding) Header(ns string) model1.Header {
 	var h model1.Header
-	if client.IsAllNamespaces(ns) {
+	if client.IsAllNamespaces(ns) || client.IsMultiNamespace(ns) {
 		h = append(h, model1.HeaderColumn{Name: "NAMESPACE"})
 	}
 
@@ -53,7 +53,7 @@ func (r RoleBinding) Render(o interface{}, ns string, row *model1.Row) error {
 
 	row.ID = client.MetaFQN(rb.ObjectMeta)
 	row.Fields = make(model1.Fields, 0, len(r.Header(ns)))
-	if client.IsAllNamespaces(ns) {
+	if client.IsAllNamespaces(ns) || client.IsMultiNamespace(ns) {
 		row.Fields = append(row.Fields, rb.Namespace)
 	}
 	row.Fields = append(row.Fields,
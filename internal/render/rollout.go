@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// RevisionAnnotation tracks a deployment revision.
+	RevisionAnnotation = "deployment.kubernetes.io/revision"
+
+	// ChangeCauseAnnotation tracks a revision change cause.
+	ChangeCauseAnnotation = "kubernetes.io/change-cause"
+
+	// RolloutComplete tracks a completed rollout.
+	RolloutComplete = "Complete"
+
+	// RolloutProgressing tracks an in flight rollout.
+	RolloutProgressing = "Progressing"
+
+	// RolloutPaused tracks a paused rollout.
+	RolloutPaused = "Paused"
+
+	// RolloutScalingDown tracks a previous revision being scaled down.
+	RolloutScalingDown = "ScalingDown"
+
+	// RolloutHistoric tracks an inactive revision.
+	RolloutHistoric = "Historic"
+)
+
+// Rollout renders a deployment rollout revision to screen.
+type Rollout struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Rollout) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		idx, ok := h.IndexOf("STATUS", true)
+		if !ok {
+			return c
+		}
+		status, _, _ := strings.Cut(re.Row.Fields[idx], " ")
+		switch status {
+		case RolloutProgressing, RolloutScalingDown:
+			return model1.PendingColor
+		case RolloutPaused:
+			return model1.HighlightColor
+		case RolloutHistoric:
+			return model1.CompletedColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Rollout) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "REVISION", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "REPLICASET"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "READY", Align: tview.AlignRight},
+		model1.HeaderColumn{Name: "IMAGES"},
+		model1.HeaderColumn{Name: "CHANGE-CAUSE"},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+		model1.HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Rollout) Render(o interface{}, ns string, r *model1.Row) error {
+	res, ok := o.(RolloutRes)
+	if !ok {
+		return fmt.Errorf("expected RolloutRes, but got %T", o)
+	}
+
+	rs := res.ReplicaSet
+	r.ID = client.FQN(res.Deployment.Namespace, res.Deployment.Name) + ":" + strconv.FormatInt(res.Revision, 10)
+	r.Fields = model1.Fields{
+		strconv.FormatInt(res.Revision, 10),
+		rs.Name,
+		RolloutStatus(res.Deployment, rs, res.Current),
+		strconv.Itoa(int(rs.Status.ReadyReplicas)) + "/" + strconv.Itoa(int(rs.Status.Replicas)),
+		strings.Join(ExtractImages(&rs.Spec.Template.Spec), ","),
+		rs.Annotations[ChangeCauseAnnotation],
+		"",
+		ToAge(rs.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// RolloutStatus returns a revision rollout status. The current revision
+// status tracks the deployment progress toward its desired replicas.
+func RolloutStatus(dp *appsv1.Deployment, rs *appsv1.ReplicaSet, current bool) string {
+	if !current {
+		if rs.Status.Replicas > 0 {
+			return RolloutScalingDown
+		}
+		return RolloutHistoric
+	}
+	if dp.Spec.Paused {
+		return RolloutPaused
+	}
+
+	var desired int32 = 1
+	if dp.Spec.Replicas != nil {
+		desired = *dp.Spec.Replicas
+	}
+	st := dp.Status
+	if dp.Generation <= st.ObservedGeneration &&
+		st.UpdatedReplicas == desired &&
+		st.Replicas == st.UpdatedReplicas &&
+		st.AvailableReplicas == st.UpdatedReplicas {
+		return RolloutComplete
+	}
+
+	return fmt.Sprintf("%s %s", RolloutProgressing, AsPerc(PrintPerc(client.ToPercentage(int64(st.UpdatedReplicas), int64(desired)))))
+}
+
+// Revision returns a replicaset deployment revision.
+func Revision(rs *appsv1.ReplicaSet) (int64, error) {
+	return strconv.ParseInt(rs.Annotations[RevisionAnnotation], 10, 64)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// RolloutRes represents a deployment rollout revision.
+type RolloutRes struct {
+	Deployment *appsv1.Deployment
+	ReplicaSet *appsv1.ReplicaSet
+	Revision   int64
+	Current    bool
+}
+
+// GetObjectKind returns a schema object.
+func (RolloutRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r RolloutRes) DeepCopyObject() runtime.Object {
+	return r
+}
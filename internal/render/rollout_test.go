@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRolloutRender(t *testing.T) {
+	dp, rs := makeRollout(3, appsv1.DeploymentStatus{
+		ObservedGeneration: 1,
+		Replicas:           3,
+		UpdatedReplicas:    3,
+		AvailableReplicas:  3,
+	})
+	var r model1.Row
+
+	assert.NoError(t, render.Rollout{}.Render(render.RolloutRes{Deployment: dp, ReplicaSet: rs, Revision: 2, Current: true}, "", &r))
+	assert.Equal(t, "default/fred:2", r.ID)
+	assert.Equal(t, model1.Fields{"2", "fred-abc", "Complete", "0/0", "nginx:1.25", "bump"}, r.Fields[:6])
+}
+
+func TestRolloutStatus(t *testing.T) {
+	uu := map[string]struct {
+		current bool
+		paused  bool
+		rsReps  int32
+		st      appsv1.DeploymentStatus
+		e       string
+	}{
+		"historic": {
+			e: render.RolloutHistoric,
+		},
+		"scaling-down": {
+			rsReps: 1,
+			e:      render.RolloutScalingDown,
+		},
+		"paused": {
+			current: true,
+			paused:  true,
+			e:       render.RolloutPaused,
+		},
+		"progressing": {
+			current: true,
+			st:      appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 4, UpdatedReplicas: 1, AvailableReplicas: 3},
+			e:       "Progressing (33%)",
+		},
+		"stale": {
+			current: true,
+			st:      appsv1.DeploymentStatus{Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+			e:       "Progressing (100%)",
+		},
+		"complete": {
+			current: true,
+			st:      appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+			e:       render.RolloutComplete,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dp, rs := makeRollout(3, u.st)
+			dp.Spec.Paused = u.paused
+			rs.Status.Replicas = u.rsReps
+			assert.Equal(t, u.e, render.RolloutStatus(dp, rs, u.current))
+		})
+	}
+}
+
+// Helpers...
+
+func makeRollout(replicas int32, st appsv1.DeploymentStatus) (*appsv1.Deployment, *appsv1.ReplicaSet) {
+	dp := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "fred", Namespace: "default", Generation: 1},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status:     st,
+	}
+	rs := appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fred-abc",
+			Namespace: "default",
+			Annotations: map[string]string{
+				render.RevisionAnnotation:    "2",
+				render.ChangeCauseAnnotation: "bump",
+			},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1", Image: "nginx:1.25"}}},
+			},
+		},
+	}
+
+	return &dp, &rs
+}
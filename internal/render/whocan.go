@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// WhoCan renders the subjects granted access to a resource.
+type WhoCan struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (WhoCan) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		if idx, ok := h.IndexOf("RESOURCE-NAMES", true); ok && idx < len(re.Row.Fields) && re.Row.Fields[idx] != "" {
+			return tcell.ColorOrange
+		}
+		return tcell.ColorMediumSpringGreen
+	}
+}
+
+// Header returns a header row.
+func (WhoCan) Header(ns string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "KIND"},
+		model1.HeaderColumn{Name: "SCOPE"},
+		model1.HeaderColumn{Name: "BINDING"},
+		model1.HeaderColumn{Name: "ROLE"},
+		model1.HeaderColumn{Name: "RESOURCE-NAMES"},
+		model1.HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (WhoCan) Render(o interface{}, ns string, r *model1.Row) error {
+	w, ok := o.(WhoCanRes)
+	if !ok {
+		return fmt.Errorf("expecting WhoCanRes but got %T", o)
+	}
+
+	r.ID = w.ID()
+	r.Fields = model1.Fields{
+		w.SubjectFQN(),
+		w.Kind,
+		w.Scope,
+		w.Binding,
+		w.Role,
+		strings.Join(w.ResourceNames, ","),
+		"",
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// WhoCanRes represents a subject granted access via a binding.
+type WhoCanRes struct {
+	Kind, Namespace, Name string
+	Scope                 string
+	Binding, Role         string
+	ResourceNames         []string
+}
+
+// NewWhoCanRes returns a new subject access.
+func NewWhoCanRes(s rbacv1.Subject, scope, binding, role string, names []string) WhoCanRes {
+	return WhoCanRes{
+		Kind:          s.Kind,
+		Namespace:     s.Namespace,
+		Name:          s.Name,
+		Scope:         scope,
+		Binding:       binding,
+		Role:          role,
+		ResourceNames: names,
+	}
+}
+
+// SubjectFQN returns the subject fully qualified name.
+func (w WhoCanRes) SubjectFQN() string {
+	if w.Kind != rbacv1.ServiceAccountKind {
+		return w.Name
+	}
+
+	return client.FQN(w.Namespace, w.Name)
+}
+
+// ID returns a unique id for the subject binding.
+func (w WhoCanRes) ID() string {
+	return w.Kind + ":" + w.SubjectFQN() + "@" + w.Binding
+}
+
+// GetObjectKind returns a schema object.
+func (WhoCanRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (w WhoCanRes) DeepCopyObject() runtime.Object {
+	return w
+}
+
+// WhoCans represents a collection of subject accesses.
+type WhoCans []WhoCanRes
+
+// Upsert adds a new subject access.
+func (ww WhoCans) Upsert(w WhoCanRes) WhoCans {
+	for i := range ww {
+		if ww[i].ID() == w.ID() {
+			ww[i] = w
+			return ww
+		}
+	}
+
+	return append(ww, w)
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func TestWhoCanRender(t *testing.T) {
+	var w render.WhoCan
+
+	var r model1.Row
+	o := render.NewWhoCanRes(
+		rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Namespace: "ns1", Name: "fred"},
+		"ns1",
+		"RB:ns1/blee",
+		"CR:edit",
+		[]string{"s1", "s2"},
+	)
+
+	assert.Nil(t, w.Render(o, "fred", &r))
+	assert.Equal(t, "ServiceAccount:ns1/fred@RB:ns1/blee", r.ID)
+	assert.Equal(t, model1.Fields{"ns1/fred", "ServiceAccount", "ns1", "RB:ns1/blee", "CR:edit", "s1,s2", ""}, r.Fields)
+}
+
+func TestWhoCansUpsert(t *testing.T) {
+	u := rbacv1.Subject{Kind: rbacv1.UserKind, Name: "fred"}
+	ww := render.WhoCans{}
+	ww = ww.Upsert(render.NewWhoCanRes(u, "*", "CRB:b1", "CR:admin", nil))
+	ww = ww.Upsert(render.NewWhoCanRes(u, "*", "CRB:b1", "CR:admin", nil))
+	ww = ww.Upsert(render.NewWhoCanRes(u, "*", "CRB:b2", "CR:admin", nil))
+
+	assert.Equal(t, 2, len(ww))
+}
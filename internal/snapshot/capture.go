@@ -0,0 +1,345 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"os"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	eventsGVR       = "v1/events"
+	clusterDir      = "cluster"
+	namespacesDir   = "namespaces"
+	capturePageSize = 500
+)
+
+// skippedGroups tracks api groups not worth capturing.
+var skippedGroups = []string{
+	// Duplicates core events.
+	"events.k8s.io",
+	// Point in time usage metrics.
+	"metrics.k8s.io",
+}
+
+// Spec represents a snapshot capture specification.
+type Spec struct {
+	// Namespace to capture. All namespaces also captures cluster scoped resources.
+	Namespace string
+
+	// GVRs to capture along with events. Blank captures all listable resources.
+	GVRs []string
+
+	// LogLines tracks how many log lines to capture for failing pods containers.
+	LogLines int
+
+	// IncludeSecrets captures secrets data as is rather than redacted.
+	IncludeSecrets bool
+}
+
+// Summary represents a snapshot capture outcome.
+type Summary struct {
+	Resources, Objects, Logs int
+	Skipped                  []string
+}
+
+// Capture captures cluster resources into a snapshot archive. Resources that can't
+// be listed are skipped.
+func Capture(ctx context.Context, conn client.Connection, spec Spec, p string) (Summary, error) {
+	var sum Summary
+	rr, err := capturedResources(conn, spec)
+	if err != nil {
+		return sum, err
+	}
+	dial, err := conn.DynDial()
+	if err != nil {
+		return sum, err
+	}
+
+	f, err := os.OpenFile(p, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return sum, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing snapshot %q", p)
+		}
+	}()
+	gz := gzip.NewWriter(f)
+	w := archiveWriter{tw: tar.NewWriter(gz), root: snapshotName(p), now: time.Now()}
+
+	ns := spec.Namespace
+	if client.IsAllNamespaces(ns) {
+		ns = client.BlankNamespace
+	}
+	var pods []*unstructured.Unstructured
+	for _, r := range rr {
+		oo, err := listAll(ctx, dial, conn.Config().CallTimeout(), r, ns)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Snapshot skipping resource %q", r)
+			sum.Skipped = append(sum.Skipped, client.FromGVAndR(r.GroupVersion().String(), r.Resource).String())
+			continue
+		}
+		if len(oo) == 0 {
+			continue
+		}
+		for _, o := range oo {
+			sanitize(o, spec.IncludeSecrets)
+		}
+		if err := w.writeResource(r, oo); err != nil {
+			return sum, err
+		}
+		sum.Resources++
+		sum.Objects += len(oo)
+		if r.Group == "" && r.Resource == "pods" {
+			pods = oo
+		}
+	}
+
+	n, err := captureLogs(ctx, conn, &w, pods, int64(spec.LogLines))
+	if err != nil {
+		return sum, err
+	}
+	sum.Logs = n
+
+	if err := w.tw.Close(); err != nil {
+		return sum, err
+	}
+
+	return sum, gz.Close()
+}
+
+// capturedResources returns all listable resources matching the capture spec.
+func capturedResources(conn client.Connection, spec Spec) ([]schema.GroupVersionResource, error) {
+	dial, err := conn.CachedDiscovery()
+	if err != nil {
+		return nil, err
+	}
+	ll, err := dial.ServerPreferredResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+
+	var want []string
+	if len(spec.GVRs) > 0 {
+		want = append(slices.Clone(spec.GVRs), eventsGVR)
+	}
+	namespaced := client.IsNamespaced(spec.Namespace)
+	var rr []schema.GroupVersionResource
+	for _, l := range ll {
+		gv, err := schema.ParseGroupVersion(l.GroupVersion)
+		if err != nil || slices.Contains(skippedGroups, gv.Group) {
+			continue
+		}
+		for _, r := range l.APIResources {
+			if strings.Contains(r.Name, "/") || !slices.Contains(r.Verbs, "list") {
+				continue
+			}
+			if namespaced && !r.Namespaced {
+				continue
+			}
+			if want != nil && !slices.Contains(want, client.FromGVAndR(l.GroupVersion, r.Name).String()) {
+				continue
+			}
+			rr = append(rr, gv.WithResource(r.Name))
+		}
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].String() < rr[j].String()
+	})
+
+	return rr, nil
+}
+
+func listAll(ctx context.Context, dial dynamic.Interface, timeout time.Duration, gvr schema.GroupVersionResource, ns string) ([]*unstructured.Unstructured, error) {
+	var res dynamic.ResourceInterface = dial.Resource(gvr)
+	if ns != client.BlankNamespace {
+		res = dial.Resource(gvr).Namespace(ns)
+	}
+
+	opts := metav1.ListOptions{Limit: capturePageSize}
+	var oo []*unstructured.Unstructured
+	for {
+		lctx, cancel := context.WithTimeout(ctx, timeout)
+		ll, err := res.List(lctx, opts)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		for i := range ll.Items {
+			oo = append(oo, &ll.Items[i])
+		}
+		if opts.Continue = ll.GetContinue(); opts.Continue == "" {
+			return oo, nil
+		}
+	}
+}
+
+// sanitize strips managed fields and redacts secrets.
+func sanitize(o *unstructured.Unstructured, includeSecrets bool) {
+	o.SetManagedFields(nil)
+	if !includeSecrets {
+		redact(o)
+	}
+}
+
+// captureLogs captures failing pods containers most recent logs.
+func captureLogs(ctx context.Context, conn client.Connection, w *archiveWriter, pods []*unstructured.Unstructured, lines int64) (int, error) {
+	if len(pods) == 0 {
+		return 0, nil
+	}
+	dial, err := conn.DialLogs()
+	if err != nil {
+		return 0, err
+	}
+
+	var n int
+	for _, o := range pods {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.Object, &po); err != nil {
+			log.Warn().Err(err).Msgf("Snapshot pod conversion failed %q", o.GetName())
+			continue
+		}
+		if !isFailing(&po) {
+			continue
+		}
+		for _, cs := range append(po.Status.InitContainerStatuses, po.Status.ContainerStatuses...) {
+			bb := containerLogs(ctx, conn, dial.CoreV1().Pods(po.Namespace), po.Name, cs, lines)
+			if len(bb) == 0 {
+				continue
+			}
+			if err := w.write(path.Join(LogsDir, po.Namespace, po.Name, cs.Name+LogExt), bb); err != nil {
+				return n, err
+			}
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+type logsGetter interface {
+	GetLogs(name string, opts *v1.PodLogOptions) *rest.Request
+}
+
+// containerLogs returns a container current logs or its previous ones if it restarted.
+func containerLogs(ctx context.Context, conn client.Connection, pods logsGetter, pod string, cs v1.ContainerStatus, lines int64) []byte {
+	for _, previous := range []bool{false, true} {
+		if previous && cs.RestartCount == 0 {
+			break
+		}
+		lctx, cancel := context.WithTimeout(ctx, conn.Config().CallTimeout())
+		bb, err := pods.GetLogs(pod, &v1.PodLogOptions{
+			Container: cs.Name,
+			TailLines: &lines,
+			Previous:  previous,
+		}).DoRaw(lctx)
+		cancel()
+		if err == nil && len(bb) > 0 {
+			return bb
+		}
+		if err != nil {
+			log.Debug().Err(err).Msgf("Snapshot logs skipped for %s/%s", pod, cs.Name)
+		}
+	}
+
+	return nil
+}
+
+// isFailing checks if a pod is unhealthy ie not all containers are ready or restarted.
+func isFailing(po *v1.Pod) bool {
+	switch po.Status.Phase {
+	case v1.PodSucceeded:
+		return false
+	case v1.PodFailed, v1.PodUnknown:
+		return true
+	}
+	if len(po.Status.ContainerStatuses) == 0 {
+		return true
+	}
+	for _, cs := range append(po.Status.InitContainerStatuses, po.Status.ContainerStatuses...) {
+		if cs.RestartCount > 0 || cs.State.Waiting != nil {
+			return true
+		}
+	}
+	for _, cs := range po.Status.ContainerStatuses {
+		if !cs.Ready {
+			return true
+		}
+	}
+
+	return false
+}
+
+type archiveWriter struct {
+	tw   *tar.Writer
+	root string
+	now  time.Time
+}
+
+// writeResource writes resource objects as lists per namespace ie namespaces/ns/deployments.apps.yaml.
+func (a *archiveWriter) writeResource(gvr schema.GroupVersionResource, oo []*unstructured.Unstructured) error {
+	nss := make(map[string][]interface{})
+	for _, o := range oo {
+		nss[o.GetNamespace()] = append(nss[o.GetNamespace()], o.Object)
+	}
+
+	name := gvr.Resource
+	if gvr.Group != "" {
+		name += "." + gvr.Group
+	}
+	for ns, ii := range nss {
+		dir := path.Join(namespacesDir, ns)
+		if ns == "" {
+			dir = clusterDir
+		}
+		raw, err := yaml.Marshal(map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       listKind,
+			"items":      ii,
+		})
+		if err != nil {
+			return err
+		}
+		if err := a.write(path.Join(dir, name+".yaml"), raw); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *archiveWriter) write(p string, bb []byte) error {
+	if err := a.tw.WriteHeader(&tar.Header{
+		Name:     path.Join(a.root, p),
+		Mode:     0600,
+		Size:     int64(len(bb)),
+		ModTime:  a.now,
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return err
+	}
+	_, err := a.tw.Write(bb)
+
+	return err
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestCapture(t *testing.T) {
+	conn := newConn(t, "testdata/dump")
+
+	uu := map[string]struct {
+		spec          snapshot.Spec
+		pods          []string
+		deps, widgets int
+		crds          int
+	}{
+		"all": {
+			spec:    snapshot.Spec{Namespace: client.NamespaceAll},
+			pods:    []string{"default/nginx-1", "ns1/redis-1"},
+			deps:    1,
+			widgets: 1,
+			crds:    1,
+		},
+		"namespaced": {
+			spec:    snapshot.Spec{Namespace: "ns1"},
+			pods:    []string{"ns1/redis-1"},
+			deps:    1,
+			widgets: 1,
+		},
+		"gvrs": {
+			spec: snapshot.Spec{Namespace: client.NamespaceAll, GVRs: []string{"v1/pods"}},
+			pods: []string{"default/nginx-1", "ns1/redis-1"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := filepath.Join(t.TempDir(), "capture.tar.gz")
+			sum, err := snapshot.Capture(context.Background(), conn, u.spec, p)
+			require.NoError(t, err)
+			assert.Empty(t, sum.Skipped)
+
+			snap, err := snapshot.Load(p)
+			require.NoError(t, err)
+			assert.Equal(t, "capture", snap.Name())
+
+			r, ok := snap.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"})
+			require.True(t, ok)
+			pp := make([]string, 0, len(r.Objects))
+			for _, o := range r.List(client.NamespaceAll) {
+				pp = append(pp, client.FQN(o.GetNamespace(), o.GetName()))
+			}
+			assert.Equal(t, u.pods, pp)
+			assert.Equal(t, u.deps, count(snap, schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}))
+			assert.Equal(t, u.widgets, count(snap, schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}))
+			assert.Equal(t, u.crds, count(snap, schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}))
+		})
+	}
+}
+
+func TestCaptureLogs(t *testing.T) {
+	conn := newConn(t, "testdata/failing")
+
+	p := filepath.Join(t.TempDir(), "capture.tar.gz")
+	sum, err := snapshot.Capture(context.Background(), conn, snapshot.Spec{Namespace: "default", GVRs: []string{"v1/pods"}, LogLines: 10}, p)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sum.Logs)
+
+	snap, err := snapshot.Load(p)
+	require.NoError(t, err)
+	bb, ok := snap.Logs("default", "api-1", "api")
+	assert.True(t, ok)
+	assert.Equal(t, "api starting\npanic: boom\n", string(bb))
+	_, ok = snap.Logs("default", "nginx-1", "nginx")
+	assert.False(t, ok)
+}
+
+func TestCaptureRedactsSecrets(t *testing.T) {
+	conn := newConn(t, "testdata/secrets.yaml")
+
+	uu := map[string]struct {
+		include bool
+		data    string
+		ann     bool
+	}{
+		"redacted": {
+			data: "UkVEQUNURUQ=",
+		},
+		"included": {
+			include: true,
+			data:    "ZnJlZA==",
+			ann:     true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := filepath.Join(t.TempDir(), "capture.tar.gz")
+			_, err := snapshot.Capture(context.Background(), conn, snapshot.Spec{Namespace: "default", GVRs: []string{"v1/secrets"}, IncludeSecrets: u.include}, p)
+			require.NoError(t, err)
+
+			snap, err := snapshot.Load(p)
+			require.NoError(t, err)
+			r, ok := snap.Resource(schema.GroupVersionResource{Version: "v1", Resource: "secrets"})
+			require.True(t, ok)
+			o, ok := r.Objects["default/creds"]
+			require.True(t, ok)
+			assert.Equal(t, u.data, o.Object["data"].(map[string]interface{})["user"])
+			_, ok = o.GetAnnotations()["kubectl.kubernetes.io/last-applied-configuration"]
+			assert.Equal(t, u.ann, ok)
+			assert.Empty(t, o.GetManagedFields())
+		})
+	}
+}
+
+// Helpers...
+
+func count(s *snapshot.Snapshot, gvr schema.GroupVersionResource) int {
+	r, ok := s.Resource(gvr)
+	if !ok {
+		return 0
+	}
+
+	return len(r.Objects)
+}
@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/metrics/pkg/client/clientset/versioned"
+)
+
+const (
+	// ContextPrefix tracks snapshots context names prefix.
+	ContextPrefix = "snapshot-"
+
+	// snapshotHost is never dialed as requests are served by the snapshot transport.
+	snapshotHost = "http://snapshot.k9s.local"
+)
+
+var nsGVR = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+
+// Connection represents a read only connection to a snapshot. Api requests are
+// answered in process and the kubeconfig only lives in memory.
+type Connection struct {
+	snap      *Snapshot
+	name      string
+	config    *client.Config
+	transport *Transport
+	dial      kubernetes.Interface
+	dynDial   dynamic.Interface
+	cached    discovery.CachedDiscoveryInterface
+	mx        sync.Mutex
+}
+
+var _ client.Connection = (*Connection)(nil)
+
+// NewConnection returns a new snapshot connection.
+func NewConnection(s *Snapshot) *Connection {
+	n := ContextPrefix + s.Name()
+	cfg := api.NewConfig()
+	cfg.Clusters[n] = &api.Cluster{Server: snapshotHost}
+	cfg.AuthInfos[n] = &api.AuthInfo{}
+	cfg.Contexts[n] = &api.Context{Cluster: n, AuthInfo: n}
+	cfg.CurrentContext = n
+
+	// Pins the context so that kubeconfig based tools never target a live cluster.
+	flags := genericclioptions.NewConfigFlags(false)
+	timeout := ""
+	flags.Context, flags.ClusterName, flags.Timeout = &n, &n, &timeout
+
+	return &Connection{
+		snap:      s,
+		name:      n,
+		config:    client.NewConfigFromAPI(cfg, flags),
+		transport: NewTransport(s),
+	}
+}
+
+// CanI only grants read access to snapshot resources.
+func (c *Connection) CanI(_, _, _ string, verbs []string) (bool, error) {
+	for _, v := range verbs {
+		if !slices.Contains(readVerbs, v) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// Config returns the snapshot config.
+func (c *Connection) Config() *client.Config {
+	return c.config
+}
+
+// ConnectionOK checks the connection status.
+func (*Connection) ConnectionOK() bool {
+	return true
+}
+
+// Dial returns a snapshot client.
+func (c *Connection) Dial() (kubernetes.Interface, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.dial != nil {
+		return c.dial, nil
+	}
+	cfg, err := c.RestConfig()
+	if err != nil {
+		return nil, err
+	}
+	if c.dial, err = kubernetes.NewForConfig(cfg); err != nil {
+		return nil, err
+	}
+
+	return c.dial, nil
+}
+
+// DialLogs returns a snapshot client for logs.
+func (c *Connection) DialLogs() (kubernetes.Interface, error) {
+	return c.Dial()
+}
+
+// SwitchContext only accepts the snapshot context.
+func (c *Connection) SwitchContext(ctx string) error {
+	if ctx != c.name {
+		return fmt.Errorf("snapshot %q does not serve context %q", c.snap.Name(), ctx)
+	}
+
+	return nil
+}
+
+// CachedDiscovery returns an in memory discovery client.
+func (c *Connection) CachedDiscovery() (discovery.CachedDiscoveryInterface, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.cached != nil {
+		return c.cached, nil
+	}
+	cfg, err := c.RestConfig()
+	if err != nil {
+		return nil, err
+	}
+	dial, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	c.cached = memory.NewMemCacheClient(dial)
+
+	return c.cached, nil
+}
+
+// RestConfig returns a rest config served by the snapshot transport.
+func (c *Connection) RestConfig() (*restclient.Config, error) {
+	cfg, err := c.config.RESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	cfg.Transport = c.transport
+	// Requests never leave the process so there is no need to throttle them.
+	cfg.QPS = -1
+
+	return cfg, nil
+}
+
+// MXDial is not supported as snapshots carry no metrics.
+func (*Connection) MXDial() (*versioned.Clientset, error) {
+	return nil, errors.New("metrics are not available in snapshots")
+}
+
+// DynDial returns a snapshot dynamic client.
+func (c *Connection) DynDial() (dynamic.Interface, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.dynDial != nil {
+		return c.dynDial, nil
+	}
+	cfg, err := c.RestConfig()
+	if err != nil {
+		return nil, err
+	}
+	if c.dynDial, err = dynamic.NewForConfig(cfg); err != nil {
+		return nil, err
+	}
+
+	return c.dynDial, nil
+}
+
+// HasMetrics checks if metrics are available.
+func (*Connection) HasMetrics() bool {
+	return false
+}
+
+// ValidNamespaceNames returns the snapshot namespaces.
+func (c *Connection) ValidNamespaceNames() (client.NamespaceNames, error) {
+	r, ok := c.snap.Resource(nsGVR)
+	if !ok {
+		return client.NamespaceNames{}, nil
+	}
+	nn := make(client.NamespaceNames, len(r.Objects))
+	for _, o := range r.Objects {
+		nn[o.GetName()] = struct{}{}
+	}
+
+	return nn, nil
+}
+
+// IsValidNamespace checks if a namespace is part of the snapshot.
+func (c *Connection) IsValidNamespace(ns string) bool {
+	if client.IsClusterWide(ns) || ns == client.NotNamespaced {
+		return true
+	}
+	nn, _ := c.ValidNamespaceNames()
+	_, ok := nn[ns]
+
+	return ok
+}
+
+// ServerVersion returns the snapshot version.
+func (*Connection) ServerVersion() (*version.Info, error) {
+	return serverVersion(), nil
+}
+
+// CheckConnectivity checks the connection status.
+func (*Connection) CheckConnectivity() bool {
+	return true
+}
+
+// ActiveContext returns the snapshot context name.
+func (c *Connection) ActiveContext() string {
+	return c.name
+}
+
+// ActiveNamespace returns the current namespace.
+func (c *Connection) ActiveNamespace() string {
+	if ns, err := c.config.CurrentNamespaceName(); err == nil {
+		return ns
+	}
+
+	return client.BlankNamespace
+}
+
+// IsActiveNamespace checks if given ns is active.
+func (c *Connection) IsActiveNamespace(ns string) bool {
+	if c.ActiveNamespace() == client.BlankNamespace {
+		return true
+	}
+
+	return c.ActiveNamespace() == ns
+}
+
+func serverVersion() *version.Info {
+	return &version.Info{Major: "1", GitVersion: "snapshot", Platform: "offline"}
+}
@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestConnectionList(t *testing.T) {
+	dial, err := newConn(t, "testdata/dump").DynDial()
+	require.NoError(t, err)
+
+	uu := map[string]struct {
+		gvr   schema.GroupVersionResource
+		ns    string
+		opts  metav1.ListOptions
+		names []string
+	}{
+		"all": {
+			gvr:   schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+			names: []string{"nginx-1", "redis-1"},
+		},
+		"namespaced": {
+			gvr:   schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+			ns:    "ns1",
+			names: []string{"redis-1"},
+		},
+		"labels": {
+			gvr:   schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+			opts:  metav1.ListOptions{LabelSelector: "app=nginx"},
+			names: []string{"nginx-1"},
+		},
+		"fields": {
+			gvr:   schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+			opts:  metav1.ListOptions{FieldSelector: "spec.nodeName=n1"},
+			names: []string{"nginx-1"},
+		},
+		"custom": {
+			gvr:   schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"},
+			ns:    "ns1",
+			names: []string{"w1"},
+		},
+		"empty": {
+			gvr:   schema.GroupVersionResource{Version: "v1", Resource: "nodes"},
+			names: []string{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l, err := dial.Resource(u.gvr).Namespace(u.ns).List(context.Background(), u.opts)
+			require.NoError(t, err)
+			nn := make([]string, 0, len(l.Items))
+			for _, o := range l.Items {
+				nn = append(nn, o.GetName())
+			}
+			assert.Equal(t, u.names, nn)
+		})
+	}
+}
+
+func TestConnectionGet(t *testing.T) {
+	dial, err := newConn(t, "testdata/dump").DynDial()
+	require.NoError(t, err)
+	deps := dial.Resource(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"})
+
+	o, err := deps.Namespace("ns1").Get(context.Background(), "redis", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "Deployment", o.GetKind())
+
+	_, err = deps.Namespace("ns1").Get(context.Background(), "bozo", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+	_, err = dial.Resource(schema.GroupVersionResource{Group: "bozo", Version: "v1", Resource: "bozos"}).
+		Get(context.Background(), "bozo", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestConnectionWatch(t *testing.T) {
+	dial, err := newConn(t, "testdata/dump").DynDial()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w, err := dial.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).Watch(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	cancel()
+
+	select {
+	case _, ok := <-w.ResultChan():
+		assert.False(t, ok)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "watch did not end")
+	}
+}
+
+func TestConnectionReadOnly(t *testing.T) {
+	conn := newConn(t, "testdata/dump")
+
+	uu := map[string]struct {
+		verbs []string
+		e     bool
+	}{
+		"read":   {verbs: client.ReadAllAccess, e: true},
+		"delete": {verbs: []string{client.DeleteVerb}},
+		"patch":  {verbs: []string{client.GetVerb, client.PatchVerb}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ok, err := conn.CanI("default", "v1/pods", "", u.verbs)
+			require.NoError(t, err)
+			assert.Equal(t, u.e, ok)
+		})
+	}
+
+	dial, err := conn.DynDial()
+	require.NoError(t, err)
+	err = dial.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).
+		Namespace("default").
+		Delete(context.Background(), "nginx-1", metav1.DeleteOptions{})
+	assert.True(t, apierrors.IsMethodNotSupported(err))
+}
+
+func TestConnectionConfig(t *testing.T) {
+	conn := newConn(t, "testdata/dump")
+
+	ct, err := conn.Config().CurrentContextName()
+	require.NoError(t, err)
+	assert.Equal(t, "snapshot-dump", ct)
+	assert.Equal(t, "snapshot-dump", conn.ActiveContext())
+	assert.NoError(t, conn.SwitchContext("snapshot-dump"))
+	assert.Error(t, conn.SwitchContext("bozo"))
+
+	_, err = conn.Config().ConfigAccess()
+	assert.Error(t, err)
+	assert.Error(t, conn.Config().RenameContext("snapshot-dump", "bozo"))
+
+	assert.True(t, conn.IsValidNamespace("ns1"))
+	assert.False(t, conn.IsValidNamespace("bozo"))
+	_, err = conn.MXDial()
+	assert.Error(t, err)
+}
+
+func TestConnectionDiscovery(t *testing.T) {
+	dial, err := newConn(t, "testdata/dump").CachedDiscovery()
+	require.NoError(t, err)
+
+	gg, err := dial.ServerGroups()
+	require.NoError(t, err)
+	var names []string
+	for _, g := range gg.Groups {
+		names = append(names, g.Name)
+	}
+	assert.Contains(t, names, "apps")
+	assert.Contains(t, names, "example.com")
+
+	rr, err := dial.ServerResourcesForGroupVersion("example.com/v1")
+	require.NoError(t, err)
+	require.Len(t, rr.APIResources, 1)
+	assert.Equal(t, "widgets", rr.APIResources[0].Name)
+	assert.Equal(t, []string{"wd"}, rr.APIResources[0].ShortNames)
+	assert.True(t, rr.APIResources[0].Namespaced)
+}
+
+func TestConnectionLogs(t *testing.T) {
+	dial, err := newConn(t, "testdata/dump").DialLogs()
+	require.NoError(t, err)
+
+	bb, err := dial.CoreV1().Pods("default").GetLogs("nginx-1", &v1.PodLogOptions{Container: "nginx"}).DoRaw(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "hello from nginx\n", string(bb))
+
+	_, err = dial.CoreV1().Pods("ns1").GetLogs("redis-1", &v1.PodLogOptions{}).DoRaw(context.Background())
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestTransportTable(t *testing.T) {
+	s, err := snapshot.Load("testdata/dump")
+	require.NoError(t, err)
+	c := http.Client{Transport: snapshot.NewTransport(s)}
+
+	req, err := http.NewRequest(http.MethodGet, "http://snapshot/apis/example.com/v1/widgets", http.NoBody)
+	require.NoError(t, err)
+	req.Header.Set("Accept", "application/json;as=Table;v=v1;g=meta.k8s.io")
+	resp, err := c.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bb, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	var tt metav1.Table
+	require.NoError(t, json.Unmarshal(bb, &tt))
+	require.Len(t, tt.Rows, 1)
+	assert.Equal(t, "w1", tt.Rows[0].Cells[0])
+	assert.Contains(t, string(tt.Rows[0].Object.Raw), `"namespace":"ns1"`)
+}
+
+func TestSnapshotRedact(t *testing.T) {
+	s, err := snapshot.Load("testdata/secrets.yaml")
+	require.NoError(t, err)
+	s.Redact()
+
+	r, ok := s.Resource(schema.GroupVersionResource{Version: "v1", Resource: "secrets"})
+	require.True(t, ok)
+	o, ok := r.Objects["default/creds"]
+	require.True(t, ok)
+	assert.Equal(t, "UkVEQUNURUQ=", o.Object["data"].(map[string]interface{})["user"])
+	assert.NotContains(t, o.GetAnnotations(), "kubectl.kubernetes.io/last-applied-configuration")
+}
+
+// Helpers...
+
+func newConn(t *testing.T, path string) *snapshot.Connection {
+	s, err := snapshot.Load(path)
+	require.NoError(t, err)
+
+	return snapshot.NewConnection(s)
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot
+
+import (
+	"encoding/base64"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// Redacted tracks redacted secrets values.
+	Redacted = "REDACTED"
+
+	lastAppliedAnn = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// redact redacts secrets values along with their last applied configuration.
+func redact(o *unstructured.Unstructured) {
+	if o.GetAPIVersion() != "v1" || o.GetKind() != "Secret" {
+		return
+	}
+
+	if dd, ok := o.Object["data"].(map[string]interface{}); ok {
+		for k := range dd {
+			dd[k] = base64.StdEncoding.EncodeToString([]byte(Redacted))
+		}
+	}
+	if dd, ok := o.Object["stringData"].(map[string]interface{}); ok {
+		for k := range dd {
+			dd[k] = Redacted
+		}
+	}
+	if aa := o.GetAnnotations(); aa != nil {
+		if _, ok := aa[lastAppliedAnn]; ok {
+			delete(aa, lastAppliedAnn)
+			o.SetAnnotations(aa)
+		}
+	}
+}
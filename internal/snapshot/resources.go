@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type builtin struct {
+	version    string
+	resource   string
+	namespaced bool
+	shortNames []string
+}
+
+// builtins tracks well known resources names and scopes as served by the api server.
+var builtins = map[schema.GroupKind]builtin{
+	{Kind: "Pod"}:                   {"v1", "pods", true, []string{"po"}},
+	{Kind: "Service"}:               {"v1", "services", true, []string{"svc"}},
+	{Kind: "ConfigMap"}:             {"v1", "configmaps", true, []string{"cm"}},
+	{Kind: "Secret"}:                {"v1", "secrets", true, nil},
+	{Kind: "ServiceAccount"}:        {"v1", "serviceaccounts", true, []string{"sa"}},
+	{Kind: "Endpoints"}:             {"v1", "endpoints", true, []string{"ep"}},
+	{Kind: "Event"}:                 {"v1", "events", true, []string{"ev"}},
+	{Kind: "PersistentVolumeClaim"}: {"v1", "persistentvolumeclaims", true, []string{"pvc"}},
+	{Kind: "PersistentVolume"}:      {"v1", "persistentvolumes", false, []string{"pv"}},
+	{Kind: "ReplicationController"}: {"v1", "replicationcontrollers", true, []string{"rc"}},
+	{Kind: "ResourceQuota"}:         {"v1", "resourcequotas", true, []string{"quota"}},
+	{Kind: "LimitRange"}:            {"v1", "limitranges", true, []string{"limits"}},
+	{Kind: "Node"}:                  {"v1", "nodes", false, []string{"no"}},
+	{Kind: "Namespace"}:             {"v1", "namespaces", false, []string{"ns"}},
+
+	{Group: "apps", Kind: "Deployment"}:  {"v1", "deployments", true, []string{"deploy"}},
+	{Group: "apps", Kind: "DaemonSet"}:   {"v1", "daemonsets", true, []string{"ds"}},
+	{Group: "apps", Kind: "StatefulSet"}: {"v1", "statefulsets", true, []string{"sts"}},
+	{Group: "apps", Kind: "ReplicaSet"}:  {"v1", "replicasets", true, []string{"rs"}},
+
+	{Group: "batch", Kind: "Job"}:     {"v1", "jobs", true, nil},
+	{Group: "batch", Kind: "CronJob"}: {"v1", "cronjobs", true, []string{"cj"}},
+
+	{Group: "autoscaling", Kind: "HorizontalPodAutoscaler"}: {"v2", "horizontalpodautoscalers", true, []string{"hpa"}},
+	{Group: "policy", Kind: "PodDisruptionBudget"}:          {"v1", "poddisruptionbudgets", true, []string{"pdb"}},
+
+	{Group: "networking.k8s.io", Kind: "Ingress"}:       {"v1", "ingresses", true, []string{"ing"}},
+	{Group: "networking.k8s.io", Kind: "IngressClass"}:  {"v1", "ingressclasses", false, nil},
+	{Group: "networking.k8s.io", Kind: "NetworkPolicy"}: {"v1", "networkpolicies", true, []string{"netpol"}},
+
+	{Group: "rbac.authorization.k8s.io", Kind: "Role"}:               {"v1", "roles", true, nil},
+	{Group: "rbac.authorization.k8s.io", Kind: "RoleBinding"}:        {"v1", "rolebindings", true, nil},
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}:        {"v1", "clusterroles", false, nil},
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"}: {"v1", "clusterrolebindings", false, nil},
+
+	{Group: "storage.k8s.io", Kind: "StorageClass"}:                   {"v1", "storageclasses", false, []string{"sc"}},
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}: {"v1", "customresourcedefinitions", false, []string{"crd", "crds"}},
+}
+
+// builtinResources returns all well known resources.
+func builtinResources() map[schema.GroupVersionKind]metav1.APIResource {
+	rr := make(map[schema.GroupVersionKind]metav1.APIResource, len(builtins))
+	for gk, b := range builtins {
+		gvk := gk.WithVersion(b.version)
+		rr[gvk] = builtinResource(gvk)
+	}
+
+	return rr
+}
+
+// builtinResource returns a resource meta for a given kind. Unknown kinds resources
+// names are guessed and their scopes are inferred from their objects.
+func builtinResource(gvk schema.GroupVersionKind) metav1.APIResource {
+	b, ok := builtins[gvk.GroupKind()]
+	if !ok {
+		plural, _ := meta.UnsafeGuessKindToResource(gvk)
+		b.resource = plural.Resource
+	}
+
+	return metav1.APIResource{
+		Name:         b.resource,
+		SingularName: strings.ToLower(gvk.Kind),
+		Namespaced:   b.namespaced,
+		ShortNames:   b.shortNames,
+	}
+}
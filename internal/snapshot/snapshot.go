@@ -0,0 +1,393 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const (
+	// LogsDir tracks pods logs location within a snapshot ie logs/<ns>/<pod>/<container>.log.
+	LogsDir = "logs"
+
+	// LogExt tracks container logs files extension.
+	LogExt = ".log"
+
+	crdKind  = "CustomResourceDefinition"
+	listKind = "List"
+)
+
+// Resource represents a snapshot resource and its objects.
+type Resource struct {
+	metav1.APIResource
+
+	GVR     schema.GroupVersionResource
+	Objects map[string]*unstructured.Unstructured
+}
+
+// Snapshot represents an offline dump of cluster resources.
+type Snapshot struct {
+	name      string
+	resources map[schema.GroupVersionResource]*Resource
+	logs      map[string][]byte
+	objects   []*unstructured.Unstructured
+}
+
+// Load loads a snapshot from a directory, a manifest or a tar archive.
+func Load(p string) (*Snapshot, error) {
+	fi, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+
+	s := newSnapshot(snapshotName(p))
+	switch {
+	case fi.IsDir():
+		err = s.loadDir(p)
+	case isArchive(p):
+		err = s.loadArchive(p)
+	default:
+		err = s.loadFile(p)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(s.objects) == 0 {
+		return nil, fmt.Errorf("no resources found in snapshot %q", p)
+	}
+	s.index()
+
+	return s, nil
+}
+
+func newSnapshot(name string) *Snapshot {
+	return &Snapshot{
+		name:      name,
+		resources: make(map[schema.GroupVersionResource]*Resource),
+		logs:      make(map[string][]byte),
+	}
+}
+
+// Name returns the snapshot name.
+func (s *Snapshot) Name() string {
+	return s.name
+}
+
+// Resources returns all snapshot resources sorted by gvr.
+func (s *Snapshot) Resources() []*Resource {
+	rr := make([]*Resource, 0, len(s.resources))
+	for _, r := range s.resources {
+		rr = append(rr, r)
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].GVR.String() < rr[j].GVR.String()
+	})
+
+	return rr
+}
+
+// Resource returns a snapshot resource.
+func (s *Snapshot) Resource(gvr schema.GroupVersionResource) (*Resource, bool) {
+	r, ok := s.resources[gvr]
+
+	return r, ok
+}
+
+// Logs returns a pod container logs if captured.
+func (s *Snapshot) Logs(ns, pod, co string) ([]byte, bool) {
+	if co == "" {
+		for k, bb := range s.logs {
+			if strings.HasPrefix(k, path.Join(ns, pod)+"/") {
+				return bb, true
+			}
+		}
+		return nil, false
+	}
+	bb, ok := s.logs[path.Join(ns, pod, co)]
+
+	return bb, ok
+}
+
+// Redact redacts the snapshot secrets values.
+func (s *Snapshot) Redact() {
+	for _, r := range s.resources {
+		for _, o := range r.Objects {
+			redact(o)
+		}
+	}
+}
+
+// List returns a resource objects in a given namespace sorted by fqn.
+func (r *Resource) List(ns string) []*unstructured.Unstructured {
+	kk := make([]string, 0, len(r.Objects))
+	for k, o := range r.Objects {
+		if client.IsAllNamespaces(ns) || o.GetNamespace() == ns {
+			kk = append(kk, k)
+		}
+	}
+	sort.Strings(kk)
+	oo := make([]*unstructured.Unstructured, 0, len(kk))
+	for _, k := range kk {
+		oo = append(oo, r.Objects[k])
+	}
+
+	return oo
+}
+
+func (s *Snapshot) loadDir(dir string) error {
+	return filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		if !isManifest(p) && !isLog(rel) {
+			return nil
+		}
+		bb, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		s.add(filepath.ToSlash(rel), bb)
+
+		return nil
+	})
+}
+
+func (s *Snapshot) loadFile(p string) error {
+	bb, err := os.ReadFile(p)
+	if err != nil {
+		return err
+	}
+	s.add(filepath.Base(p), bb)
+
+	return nil
+}
+
+func (s *Snapshot) loadArchive(p string) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing snapshot %q", p)
+		}
+	}()
+
+	var r io.Reader = f
+	if !strings.HasSuffix(p, ".tar") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if h.Typeflag != tar.TypeReg || (!isManifest(h.Name) && !isLog(h.Name)) {
+			continue
+		}
+		bb, err := io.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		s.add(h.Name, bb)
+	}
+}
+
+// add adds a snapshot file content. Files that are not k8s manifests are skipped.
+func (s *Snapshot) add(p string, bb []byte) {
+	if isLog(p) {
+		tt := strings.Split(path.Clean(p), "/")
+		tt = tt[len(tt)-3:]
+		s.logs[path.Join(tt[0], tt[1], strings.TrimSuffix(tt[2], LogExt))] = bb
+		return
+	}
+	oo, err := decode(bb)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Skipping snapshot file %q", p)
+	}
+	s.objects = append(s.objects, oo...)
+}
+
+// index maps snapshot objects to their resources.
+func (s *Snapshot) index() {
+	crds := make(map[schema.GroupKind]metav1.APIResource)
+	for _, o := range s.objects {
+		if o.GetKind() == crdKind {
+			gk, res := crdResource(o)
+			crds[gk] = res
+		}
+	}
+
+	nss := make(map[string]struct{})
+	for _, o := range s.objects {
+		gvk := o.GroupVersionKind()
+		res, ok := crds[gvk.GroupKind()]
+		if !ok {
+			res = builtinResource(gvk)
+		}
+		r := s.resourceFor(gvk, res)
+		if ns := o.GetNamespace(); ns != "" {
+			r.Namespaced, nss[ns] = true, struct{}{}
+		}
+		r.Objects[client.FQN(o.GetNamespace(), o.GetName())] = o
+	}
+	s.objects = nil
+
+	// Well known resources are always served even when not part of the dump.
+	for gvk, res := range builtinResources() {
+		s.resourceFor(gvk, res)
+	}
+
+	// Namespaces are not always part of a dump.
+	r := s.resourceFor(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, metav1.APIResource{})
+	for ns := range nss {
+		if _, ok := r.Objects[ns]; ok {
+			continue
+		}
+		var o unstructured.Unstructured
+		o.SetAPIVersion("v1")
+		o.SetKind("Namespace")
+		o.SetName(ns)
+		_ = unstructured.SetNestedField(o.Object, "Active", "status", "phase")
+		r.Objects[ns] = &o
+	}
+}
+
+func (s *Snapshot) resourceFor(gvk schema.GroupVersionKind, res metav1.APIResource) *Resource {
+	if res.Name == "" {
+		res = builtinResource(gvk)
+	}
+	gvr := gvk.GroupVersion().WithResource(res.Name)
+	if r, ok := s.resources[gvr]; ok {
+		return r
+	}
+	res.Group, res.Version, res.Kind = gvk.Group, gvk.Version, gvk.Kind
+	r := Resource{
+		APIResource: res,
+		GVR:         gvr,
+		Objects:     make(map[string]*unstructured.Unstructured),
+	}
+	s.resources[gvr] = &r
+
+	return &r
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func decode(bb []byte) ([]*unstructured.Unstructured, error) {
+	var oo []*unstructured.Unstructured
+	d := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(bb), 4096)
+	for {
+		var raw runtime.RawExtension
+		if err := d.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return oo, nil
+			}
+			return oo, err
+		}
+		if len(bytes.TrimSpace(raw.Raw)) == 0 || bytes.Equal(raw.Raw, []byte("null")) {
+			continue
+		}
+		o, err := runtime.Decode(unstructured.UnstructuredJSONScheme, raw.Raw)
+		if err != nil {
+			// Not a k8s manifest.
+			continue
+		}
+		switch u := o.(type) {
+		case *unstructured.Unstructured:
+			oo = append(oo, u)
+		case *unstructured.UnstructuredList:
+			kind := strings.TrimSuffix(u.GetKind(), listKind)
+			for i := range u.Items {
+				item := &u.Items[i]
+				if item.GetKind() == "" && kind != "" {
+					item.SetAPIVersion(u.GetAPIVersion())
+					item.SetKind(kind)
+				}
+				if item.GetKind() == "" {
+					continue
+				}
+				oo = append(oo, item)
+			}
+		}
+	}
+}
+
+func crdResource(o *unstructured.Unstructured) (schema.GroupKind, metav1.APIResource) {
+	group, _, _ := unstructured.NestedString(o.Object, "spec", "group")
+	kind, _, _ := unstructured.NestedString(o.Object, "spec", "names", "kind")
+	plural, _, _ := unstructured.NestedString(o.Object, "spec", "names", "plural")
+	singular, _, _ := unstructured.NestedString(o.Object, "spec", "names", "singular")
+	shorts, _, _ := unstructured.NestedStringSlice(o.Object, "spec", "names", "shortNames")
+	scope, _, _ := unstructured.NestedString(o.Object, "spec", "scope")
+
+	return schema.GroupKind{Group: group, Kind: kind}, metav1.APIResource{
+		Name:         plural,
+		SingularName: singular,
+		Namespaced:   scope == "Namespaced",
+		ShortNames:   shorts,
+	}
+}
+
+func snapshotName(p string) string {
+	n := filepath.Base(filepath.Clean(p))
+	for _, ext := range []string{".tar.gz", ".tgz", ".tar", filepath.Ext(n)} {
+		n = strings.TrimSuffix(n, ext)
+	}
+
+	return n
+}
+
+func isArchive(p string) bool {
+	return strings.HasSuffix(p, ".tar.gz") || strings.HasSuffix(p, ".tgz") || strings.HasSuffix(p, ".tar")
+}
+
+func isManifest(p string) bool {
+	switch filepath.Ext(p) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+func isLog(p string) bool {
+	tt := strings.Split(path.Clean(filepath.ToSlash(p)), "/")
+
+	return path.Ext(p) == LogExt && len(tt) >= 4 && tt[len(tt)-4] == LogsDir
+}
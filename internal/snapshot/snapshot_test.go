@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestLoadDir(t *testing.T) {
+	s, err := snapshot.Load("testdata/dump")
+	require.NoError(t, err)
+	assert.Equal(t, "dump", s.Name())
+
+	uu := map[string]struct {
+		gvr        schema.GroupVersionResource
+		ns         string
+		count      int
+		namespaced bool
+	}{
+		"pods": {
+			gvr:        schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+			count:      2,
+			namespaced: true,
+		},
+		"pods-ns": {
+			gvr:        schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+			ns:         "ns1",
+			count:      1,
+			namespaced: true,
+		},
+		"deployments": {
+			gvr:        schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+			count:      1,
+			namespaced: true,
+		},
+		"custom": {
+			gvr:        schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"},
+			count:      1,
+			namespaced: true,
+		},
+		"namespaces": {
+			gvr:   schema.GroupVersionResource{Version: "v1", Resource: "namespaces"},
+			count: 2,
+		},
+		"builtin": {
+			gvr: schema.GroupVersionResource{Version: "v1", Resource: "nodes"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r, ok := s.Resource(u.gvr)
+			require.True(t, ok)
+			assert.Equal(t, u.namespaced, r.Namespaced)
+			assert.Len(t, r.List(u.ns), u.count)
+		})
+	}
+}
+
+func TestLoadArchive(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "fred.tar.gz")
+	f, err := os.Create(p)
+	require.NoError(t, err)
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	ff := map[string]string{
+		"fred/blee/pods.yaml":             "apiVersion: v1\nkind: Pod\nmetadata:\n  name: p1\n  namespace: blee\n",
+		"fred/logs/blee/p1/c1.log":        "line1\nline2\n",
+		"fred/README.md":                  "# Not a manifest",
+		"fred/blee/configmaps/cm1.yaml":   "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm1\n  namespace: blee\n",
+		"fred/blee/configmaps/bozo.yaml":  "a: b\n",
+		"fred/cluster/namespaces/ns.json": `{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"blee"}}`,
+	}
+	for n, c := range ff {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: n, Mode: 0600, Size: int64(len(c)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(c))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	require.NoError(t, f.Close())
+
+	s, err := snapshot.Load(p)
+	require.NoError(t, err)
+	assert.Equal(t, "fred", s.Name())
+
+	r, ok := s.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"})
+	require.True(t, ok)
+	assert.Len(t, r.List("blee"), 1)
+	r, ok = s.Resource(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"})
+	require.True(t, ok)
+	assert.Len(t, r.List(""), 1)
+	r, ok = s.Resource(schema.GroupVersionResource{Version: "v1", Resource: "namespaces"})
+	require.True(t, ok)
+	assert.Len(t, r.List(""), 1)
+
+	bb, ok := s.Logs("blee", "p1", "c1")
+	assert.True(t, ok)
+	assert.Equal(t, "line1\nline2\n", string(bb))
+	bb, ok = s.Logs("blee", "p1", "")
+	assert.True(t, ok)
+	assert.Equal(t, "line1\nline2\n", string(bb))
+	_, ok = s.Logs("blee", "p1", "c2")
+	assert.False(t, ok)
+}
+
+func TestLoadEmpty(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "fred.yaml"), []byte("a: b\n"), 0600))
+
+	_, err := snapshot.Load(dir)
+	assert.Error(t, err)
+}
@@ -0,0 +1,418 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	logResource  = "log"
+	tableAccept  = "as=Table"
+	jsonContent  = "application/json"
+	textContent  = "text/plain"
+	watchTimeout = 30 * time.Minute
+)
+
+var readVerbs = []string{client.GetVerb, client.ListVerb, client.WatchVerb}
+
+// Transport answers api requests straight from a snapshot so that all k9s
+// views can browse it offline. Requests never leave the process and writes
+// are rejected.
+type Transport struct {
+	snap *Snapshot
+}
+
+// NewTransport returns a new snapshot transport.
+func NewTransport(s *Snapshot) *Transport {
+	return &Transport{snap: s}
+}
+
+// response tracks a snapshot api response.
+type response struct {
+	code        int
+	contentType string
+	body        io.ReadCloser
+}
+
+// RoundTrip serves a snapshot api request.
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Body != nil {
+		if err := r.Body.Close(); err != nil {
+			log.Warn().Err(err).Msgf("Snapshot request body close failed")
+		}
+	}
+	resp := t.serve(r)
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", resp.code, http.StatusText(resp.code)),
+		StatusCode:    resp.code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{resp.contentType}},
+		Body:          resp.body,
+		ContentLength: -1,
+		Request:       r,
+	}, nil
+}
+
+func (t *Transport) serve(r *http.Request) response {
+	tt := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.URL.Path == "/version":
+		return jsonResponse(http.StatusOK, serverVersion())
+	case r.URL.Path == "/api":
+		return jsonResponse(http.StatusOK, &metav1.APIVersions{
+			TypeMeta: metav1.TypeMeta{Kind: "APIVersions"},
+			Versions: []string{"v1"},
+		})
+	case r.URL.Path == "/apis":
+		return jsonResponse(http.StatusOK, t.groups())
+	case len(tt) == 2 && tt[0] == "api":
+		return jsonResponse(http.StatusOK, t.resourceList(schema.GroupVersion{Version: tt[1]}))
+	case len(tt) == 3 && tt[0] == "apis":
+		return jsonResponse(http.StatusOK, t.resourceList(schema.GroupVersion{Group: tt[1], Version: tt[2]}))
+	case len(tt) > 2 && tt[0] == "api":
+		return t.serveResource(r, schema.GroupVersion{Version: tt[1]}, tt[2:])
+	case len(tt) > 3 && tt[0] == "apis":
+		return t.serveResource(r, schema.GroupVersion{Group: tt[1], Version: tt[2]}, tt[3:])
+	default:
+		return statusResponse(apierrors.NewNotFound(schema.GroupResource{}, r.URL.Path))
+	}
+}
+
+func (t *Transport) groups() *metav1.APIGroupList {
+	gg := make(map[string][]string)
+	for _, r := range t.snap.Resources() {
+		if r.GVR.Group == "" || slices.Contains(gg[r.GVR.Group], r.GVR.Version) {
+			continue
+		}
+		gg[r.GVR.Group] = append(gg[r.GVR.Group], r.GVR.Version)
+	}
+
+	l := metav1.APIGroupList{TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"}}
+	for g, vv := range gg {
+		group := metav1.APIGroup{Name: g}
+		for _, v := range vv {
+			group.Versions = append(group.Versions, metav1.GroupVersionForDiscovery{
+				GroupVersion: g + "/" + v,
+				Version:      v,
+			})
+		}
+		group.PreferredVersion = group.Versions[0]
+		l.Groups = append(l.Groups, group)
+	}
+	sort.Slice(l.Groups, func(i, j int) bool {
+		return l.Groups[i].Name < l.Groups[j].Name
+	})
+
+	return &l
+}
+
+func (t *Transport) resourceList(gv schema.GroupVersion) *metav1.APIResourceList {
+	l := metav1.APIResourceList{
+		TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+		GroupVersion: gv.String(),
+	}
+	for _, r := range t.snap.Resources() {
+		if r.GVR.GroupVersion() != gv {
+			continue
+		}
+		res := r.APIResource
+		res.Group, res.Version, res.Verbs = "", "", readVerbs
+		l.APIResources = append(l.APIResources, res)
+		if r.GVR.Resource == "pods" {
+			l.APIResources = append(l.APIResources, metav1.APIResource{
+				Name:       "pods/" + logResource,
+				Namespaced: true,
+				Kind:       "Pod",
+				Verbs:      []string{"get"},
+			})
+		}
+	}
+
+	return &l
+}
+
+// serveResource serves resources requests ie [namespaces/ns/]resource[/name[/subresource]].
+func (t *Transport) serveResource(r *http.Request, gv schema.GroupVersion, tt []string) response {
+	var ns string
+	if len(tt) > 2 && tt[0] == "namespaces" {
+		ns, tt = tt[1], tt[2:]
+	}
+	gvr := gv.WithResource(tt[0])
+	res, ok := t.snap.Resource(gvr)
+	if !ok {
+		return statusResponse(apierrors.NewNotFound(gvr.GroupResource(), ""))
+	}
+	if r.Method != http.MethodGet {
+		return statusResponse(apierrors.NewMethodNotSupported(gvr.GroupResource(), strings.ToLower(r.Method)))
+	}
+
+	switch len(tt) {
+	case 1:
+		if v := r.URL.Query().Get("watch"); v == "true" || v == "1" {
+			return serveWatch(r)
+		}
+		oo, err := filter(res.List(ns), r)
+		if err != nil {
+			return statusResponse(apierrors.NewBadRequest(err.Error()))
+		}
+		if isTable(r) {
+			return jsonResponse(http.StatusOK, toTable(oo))
+		}
+		return jsonResponse(http.StatusOK, toList(res, oo))
+	case 2:
+		o, ok := res.Objects[client.FQN(ns, tt[1])]
+		if !ok {
+			return statusResponse(apierrors.NewNotFound(gvr.GroupResource(), tt[1]))
+		}
+		if isTable(r) {
+			return jsonResponse(http.StatusOK, toTable([]*unstructured.Unstructured{o}))
+		}
+		return jsonResponse(http.StatusOK, o)
+	case 3:
+		if gvr.Resource == "pods" && tt[2] == logResource {
+			return t.serveLogs(r, ns, tt[1])
+		}
+		return statusResponse(apierrors.NewNotFound(gvr.GroupResource(), tt[1]+"/"+tt[2]))
+	default:
+		return statusResponse(apierrors.NewNotFound(gvr.GroupResource(), strings.Join(tt, "/")))
+	}
+}
+
+// serveWatch holds watches open until they time out since snapshots never change.
+func serveWatch(r *http.Request) response {
+	timeout := watchTimeout
+	if t, err := strconv.Atoi(r.URL.Query().Get("timeoutSeconds")); err == nil && t > 0 {
+		timeout = time.Duration(t) * time.Second
+	}
+
+	return response{
+		code:        http.StatusOK,
+		contentType: jsonContent,
+		body:        newIdleBody(r.Context(), nil, timeout),
+	}
+}
+
+func (t *Transport) serveLogs(r *http.Request, ns, pod string) response {
+	bb, ok := t.snap.Logs(ns, pod, r.URL.Query().Get("container"))
+	if !ok {
+		return statusResponse(apierrors.NewNotFound(schema.GroupResource{Resource: "pods/log"}, pod))
+	}
+	resp := response{
+		code:        http.StatusOK,
+		contentType: textContent,
+		body:        io.NopCloser(bytes.NewReader(bb)),
+	}
+	if r.URL.Query().Get("follow") == "true" {
+		resp.body = newIdleBody(r.Context(), bb, 0)
+	}
+
+	return resp
+}
+
+// idleBody streams some content and then blocks until the request is canceled,
+// the body is closed or the optional timeout expires.
+type idleBody struct {
+	data    *bytes.Reader
+	ctx     context.Context
+	timeout <-chan time.Time
+	timer   *time.Timer
+	done    chan struct{}
+	once    sync.Once
+}
+
+func newIdleBody(ctx context.Context, bb []byte, timeout time.Duration) *idleBody {
+	b := idleBody{
+		data: bytes.NewReader(bb),
+		ctx:  ctx,
+		done: make(chan struct{}),
+	}
+	if timeout > 0 {
+		b.timer = time.NewTimer(timeout)
+		b.timeout = b.timer.C
+	}
+
+	return &b
+}
+
+// Read reads the body content if any or waits for the stream to end.
+func (b *idleBody) Read(p []byte) (int, error) {
+	if b.data.Len() > 0 {
+		return b.data.Read(p)
+	}
+	select {
+	case <-b.ctx.Done():
+	case <-b.timeout:
+	case <-b.done:
+	}
+
+	return 0, io.EOF
+}
+
+// Close ends the stream.
+func (b *idleBody) Close() error {
+	b.once.Do(func() {
+		if b.timer != nil {
+			b.timer.Stop()
+		}
+		close(b.done)
+	})
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func isTable(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), tableAccept)
+}
+
+// filter filters objects using the request label and field selectors.
+func filter(oo []*unstructured.Unstructured, r *http.Request) ([]*unstructured.Unstructured, error) {
+	lsel, err := labels.Parse(r.URL.Query().Get("labelSelector"))
+	if err != nil {
+		return nil, err
+	}
+	fsel, err := fields.ParseSelector(r.URL.Query().Get("fieldSelector"))
+	if err != nil {
+		return nil, err
+	}
+	if lsel.Empty() && fsel.Empty() {
+		return oo, nil
+	}
+
+	ff := make([]*unstructured.Unstructured, 0, len(oo))
+	for _, o := range oo {
+		if lsel.Matches(labels.Set(o.GetLabels())) && fsel.Matches(objectFields{o}) {
+			ff = append(ff, o)
+		}
+	}
+
+	return ff, nil
+}
+
+// objectFields exposes an object fields to field selectors ie involvedObject.name.
+type objectFields struct {
+	*unstructured.Unstructured
+}
+
+// Has checks if a field is set.
+func (o objectFields) Has(f string) bool {
+	_, ok, _ := unstructured.NestedFieldNoCopy(o.Object, strings.Split(f, ".")...)
+
+	return ok
+}
+
+// Get returns a field value.
+func (o objectFields) Get(f string) string {
+	v, ok, _ := unstructured.NestedFieldNoCopy(o.Object, strings.Split(f, ".")...)
+	if !ok || v == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%v", v)
+}
+
+func toList(res *Resource, oo []*unstructured.Unstructured) *unstructured.UnstructuredList {
+	l := unstructured.UnstructuredList{Object: make(map[string]interface{})}
+	l.SetAPIVersion(res.GVR.GroupVersion().String())
+	l.SetKind(res.Kind + listKind)
+	l.SetResourceVersion("1")
+	l.Items = make([]unstructured.Unstructured, 0, len(oo))
+	for _, o := range oo {
+		l.Items = append(l.Items, *o)
+	}
+
+	return &l
+}
+
+// toTable renders objects as a server side table.
+func toTable(oo []*unstructured.Unstructured) *metav1.Table {
+	t := metav1.Table{
+		TypeMeta: metav1.TypeMeta{Kind: "Table", APIVersion: metav1.SchemeGroupVersion.String()},
+		ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name"},
+			{Name: "Age", Type: "date"},
+		},
+		Rows: make([]metav1.TableRow, 0, len(oo)),
+	}
+	for _, o := range oo {
+		raw, err := json.Marshal(map[string]interface{}{
+			"apiVersion": metav1.SchemeGroupVersion.String(),
+			"kind":       "PartialObjectMetadata",
+			"metadata":   o.Object["metadata"],
+		})
+		if err != nil {
+			log.Warn().Err(err).Msgf("Snapshot table row %q failed", o.GetName())
+			continue
+		}
+		t.Rows = append(t.Rows, metav1.TableRow{
+			Cells:  []interface{}{o.GetName(), toAge(o.GetCreationTimestamp())},
+			Object: runtime.RawExtension{Raw: raw},
+		})
+	}
+
+	return &t
+}
+
+func toAge(t metav1.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+
+	return duration.HumanDuration(time.Since(t.Time))
+}
+
+func jsonResponse(code int, o interface{}) response {
+	bb, err := json.Marshal(o)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Snapshot response encoding failed")
+		return statusResponse(apierrors.NewInternalError(err))
+	}
+
+	return response{
+		code:        code,
+		contentType: jsonContent,
+		body:        io.NopCloser(bytes.NewReader(bb)),
+	}
+}
+
+func statusResponse(err *apierrors.StatusError) response {
+	st := err.Status()
+	st.TypeMeta = metav1.TypeMeta{Kind: "Status", APIVersion: "v1"}
+	bb, _ := json.Marshal(&st)
+
+	return response{
+		code:        int(st.Code),
+		contentType: jsonContent,
+		body:        io.NopCloser(bytes.NewReader(bb)),
+	}
+}
@@ -45,11 +45,40 @@ func (m Metric) Sum() int64 {
 	return m.S1 + m.S2
 }
 
+// SparkText renders a series as a single line of spark glyphs.
+func SparkText(vv []int64) string {
+	var max int64
+	for _, v := range vv {
+		if v > max {
+			max = v
+		}
+	}
+
+	rr := make([]rune, 0, len(vv))
+	for _, v := range vv {
+		idx := 0
+		if max > 0 && v > 0 {
+			idx = int((v*int64(len(sparks)) - 1) / max)
+		}
+		rr = append(rr, sparks[idx])
+	}
+
+	return string(rr)
+}
+
+// Threshold represents a horizontal reference line.
+type Threshold struct {
+	Value int64
+	Color tcell.Color
+}
+
 // SparkLine represents a sparkline component.
 type SparkLine struct {
 	*Component
 
 	data        []Metric
+	marks       []bool
+	thresholds  []Threshold
 	multiSeries bool
 }
 
@@ -66,11 +95,27 @@ func (s *SparkLine) SetMultiSeries(b bool) {
 	s.multiSeries = b
 }
 
+// SetThresholds sets reference lines to draw across the graph.
+func (s *SparkLine) SetThresholds(tt ...Threshold) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.thresholds = tt
+}
+
 // Add adds a metric.
 func (s *SparkLine) Add(m Metric) {
 	s.mx.Lock()
 	defer s.mx.Unlock()
-	s.data = append(s.data, m)
+	s.data, s.marks = append(s.data, m), append(s.marks, false)
+}
+
+// Mark flags the last metric with a marker.
+func (s *SparkLine) Mark() {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	if len(s.marks) > 0 {
+		s.marks[len(s.marks)-1] = true
+	}
 }
 
 // Draw draws the graph.
@@ -93,20 +138,31 @@ func (s *SparkLine) Draw(screen tcell.Screen) {
 	s.cutSet(rect.Dx())
 	max := s.computeMax()
 
+	cols := 2
+	if !s.multiSeries {
+		cols = 1
+	}
 	cX, idx := rect.Min.X+1, 0
-	if len(s.data)*2 < rect.Dx() {
-		cX = rect.Max.X - len(s.data)*2
+	if len(s.data)*cols < rect.Dx() {
+		cX = rect.Max.X - len(s.data)*cols
 	} else {
-		idx = len(s.data) - rect.Dx()/2
+		idx = len(s.data) - rect.Dx()/cols
 	}
 
 	scale := float64(len(sparks)*(rect.Dy()-pad)) / float64(max)
+	s.drawThresholds(rect, screen, pad, scale)
 	c1, c2 := s.colorForSeries()
-	for _, d := range s.data[idx:] {
+	for i, d := range s.data[idx:] {
+		if s.marks[idx+i] {
+			screen.SetContent(cX, rect.Min.Y, '▼', nil, tcell.StyleDefault.Foreground(faultColor).Background(s.bgColor))
+		}
 		b := toBlocks(d, scale)
 		cY := rect.Max.Y - pad
 		s.drawBlock(rect, screen, cX, cY, b.s1, c1)
 		cX++
+		if !s.multiSeries {
+			continue
+		}
 		s.drawBlock(rect, screen, cX, cY, b.s2, c2)
 		cX++
 	}
@@ -136,13 +192,29 @@ func (s *SparkLine) drawBlock(r image.Rectangle, screen tcell.Screen, x, y int,
 	}
 }
 
+func (s *SparkLine) drawThresholds(r image.Rectangle, screen tcell.Screen, pad int, scale float64) {
+	for _, t := range s.thresholds {
+		if t.Value <= 0 {
+			continue
+		}
+		y := r.Max.Y - pad - int(math.Round(float64(t.Value)*scale))/len(sparks)
+		if y < r.Min.Y {
+			continue
+		}
+		style := tcell.StyleDefault.Foreground(t.Color).Background(s.bgColor)
+		for x := r.Min.X; x < r.Max.X; x++ {
+			screen.SetContent(x, y, '┄', nil, style)
+		}
+	}
+}
+
 func (s *SparkLine) cutSet(width int) {
 	if width <= 0 || len(s.data) == 0 {
 		return
 	}
 
 	if len(s.data) >= width*2 {
-		s.data = s.data[len(s.data)-width:]
+		s.data, s.marks = s.data[len(s.data)-width:], s.marks[len(s.marks)-width:]
 	}
 }
 
@@ -154,6 +226,11 @@ func (s *SparkLine) computeMax() int64 {
 			max = m
 		}
 	}
+	for _, t := range s.thresholds {
+		if max < t.Value {
+			max = t.Value
+		}
+	}
 
 	return max
 }
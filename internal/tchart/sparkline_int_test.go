@@ -96,6 +96,7 @@ func TestToBlocks(t *testing.T) {
 func TestComputeMax(t *testing.T) {
 	uu := map[string]struct {
 		mm []Metric
+		tt []Threshold
 		e  int64
 	}{
 		"empty": {
@@ -119,11 +120,17 @@ func TestComputeMax(t *testing.T) {
 			},
 			e: 1040,
 		},
+		"threshold": {
+			mm: []Metric{{S1: 100, S2: 10}},
+			tt: []Threshold{{Value: 50}, {Value: 200}},
+			e:  200,
+		},
 	}
 
 	for k := range uu {
 		u := uu[k]
 		s := NewSparkLine("s")
+		s.SetThresholds(u.tt...)
 		for _, m := range u.mm {
 			s.Add(m)
 		}
@@ -132,3 +139,17 @@ func TestComputeMax(t *testing.T) {
 		})
 	}
 }
+
+func TestMark(t *testing.T) {
+	s := NewSparkLine("s")
+	s.Mark()
+	for i := 0; i < 10; i++ {
+		s.Add(Metric{S1: int64(i)})
+		if i%3 == 0 {
+			s.Mark()
+		}
+	}
+	s.cutSet(5)
+
+	assert.Equal(t, []bool{false, true, false, false, true}, s.marks)
+}
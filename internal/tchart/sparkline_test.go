@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package tchart_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparkText(t *testing.T) {
+	uu := map[string]struct {
+		vv []int64
+		e  string
+	}{
+		"empty": {},
+		"zeros": {
+			vv: []int64{0, 0},
+			e:  "▁▁",
+		},
+		"scaled": {
+			vv: []int64{1, 4, 8, 2},
+			e:  "▁▄█▂",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, tchart.SparkText(u.vv))
+		})
+	}
+}
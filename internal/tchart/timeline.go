@@ -0,0 +1,337 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package tchart
+
+import (
+	"image"
+	"strings"
+	"time"
+
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	restartColor, rolloutColor = tcell.ColorOrange, tcell.ColorAqua
+	spanRune                   = '─'
+	minTickSpacing             = 12
+	axisTimeFmt                = "15:04"
+)
+
+// MarkKind represents a type of timeline mark.
+type MarkKind int
+
+const (
+	// MarkNormal represents a normal event.
+	MarkNormal MarkKind = iota
+
+	// MarkWarning represents a warning event.
+	MarkWarning
+
+	// MarkRestart represents a container restart.
+	MarkRestart
+
+	// MarkRollout represents a new rollout revision.
+	MarkRollout
+)
+
+var markRunes = map[MarkKind]rune{
+	MarkNormal:  '●',
+	MarkWarning: '▲',
+	MarkRestart: '↻',
+	MarkRollout: '◆',
+}
+
+// Mark represents an occurrence on a timeline lane. Repeated occurrences
+// span from Start to End.
+type Mark struct {
+	Start, End time.Time
+	Kind       MarkKind
+	Count      int32
+	Label      string
+}
+
+// Lane represents a collection of marks for a given object. Lanes may be
+// nested under their owner lane, Group tracking the top level owner lane ID.
+type Lane struct {
+	ID, Label, Group string
+	Depth            int
+	Marks            []Mark
+}
+
+// Title returns the lane label indented per its nesting depth.
+func (l Lane) Title() string {
+	if l.Depth == 0 {
+		return l.Label
+	}
+
+	return strings.Repeat("  ", l.Depth-1) + "└ " + l.Label
+}
+
+// Last returns the time of the most recent mark.
+func (l Lane) Last() time.Time {
+	var t time.Time
+	for _, m := range l.Marks {
+		if m.End.After(t) {
+			t = m.End
+		}
+	}
+
+	return t
+}
+
+// Timeline represents a horizontal time axis component with one lane per object.
+type Timeline struct {
+	*Component
+
+	lanes     []Lane
+	window    time.Duration
+	selected  int
+	offset    int
+	nowFn     func() time.Time
+	changedFn func(Lane)
+}
+
+// NewTimeline returns a new timeline.
+func NewTimeline(id string) *Timeline {
+	return &Timeline{
+		Component: NewComponent(id),
+		window:    time.Hour,
+		nowFn:     time.Now,
+	}
+}
+
+// SetWindow sets the time window to display.
+func (t *Timeline) SetWindow(d time.Duration) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	t.window = d
+}
+
+// Window returns the current time window.
+func (t *Timeline) Window() time.Duration {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+	return t.window
+}
+
+// SetChangedFunc sets a callback fn when the selected lane changes.
+func (t *Timeline) SetChangedFunc(f func(Lane)) {
+	t.changedFn = f
+}
+
+// SetLanes sets the timeline lanes.
+func (t *Timeline) SetLanes(ll []Lane) {
+	t.mx.Lock()
+	var id string
+	if t.selected < len(t.lanes) {
+		id = t.lanes[t.selected].ID
+	}
+	t.lanes, t.selected = ll, 0
+	for i, l := range ll {
+		if l.ID == id {
+			t.selected = i
+			break
+		}
+	}
+	t.mx.Unlock()
+
+	t.fireChanged()
+}
+
+// SelectedLane returns the currently selected lane if any.
+func (t *Timeline) SelectedLane() (Lane, bool) {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	if t.selected >= len(t.lanes) {
+		return Lane{}, false
+	}
+
+	return t.lanes[t.selected], true
+}
+
+// InputHandler returns the handler for this primitive.
+func (t *Timeline) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return t.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		// nolint:exhaustive
+		switch event.Key() {
+		case tcell.KeyUp:
+			t.selectLane(-1)
+		case tcell.KeyDown:
+			t.selectLane(1)
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'k':
+				t.selectLane(-1)
+			case 'j':
+				t.selectLane(1)
+			}
+		case tcell.KeyBacktab, tcell.KeyTab:
+			if t.blur != nil {
+				t.blur(event.Key())
+			}
+			setFocus(t)
+		}
+	})
+}
+
+func (t *Timeline) selectLane(delta int) {
+	t.mx.Lock()
+	if len(t.lanes) == 0 {
+		t.mx.Unlock()
+		return
+	}
+	t.selected += delta
+	if t.selected < 0 {
+		t.selected = 0
+	}
+	if t.selected >= len(t.lanes) {
+		t.selected = len(t.lanes) - 1
+	}
+	t.mx.Unlock()
+
+	t.fireChanged()
+}
+
+func (t *Timeline) fireChanged() {
+	if t.changedFn == nil {
+		return
+	}
+	if l, ok := t.SelectedLane(); ok {
+		t.changedFn(l)
+	}
+}
+
+// Draw draws the timeline.
+func (t *Timeline) Draw(screen tcell.Screen) {
+	t.Component.Draw(screen)
+
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	rect := t.asRect()
+	if rect.Dx() <= 0 || rect.Dy() <= 1 {
+		return
+	}
+
+	labelW := t.labelWidth(rect.Dx())
+	plot := image.Rectangle{
+		Min: image.Point{X: rect.Min.X + labelW + 1, Y: rect.Min.Y},
+		Max: image.Point{X: rect.Max.X, Y: rect.Max.Y - 1},
+	}
+	end := t.nowFn()
+	start := end.Add(-t.window)
+	t.drawAxis(screen, plot, start)
+
+	rows := plot.Dy()
+	if t.selected < t.offset {
+		t.offset = t.selected
+	}
+	if rows > 0 && t.selected >= t.offset+rows {
+		t.offset = t.selected - rows + 1
+	}
+
+	style := tcell.StyleDefault.Background(t.bgColor)
+	for i := 0; i < rows && t.offset+i < len(t.lanes); i++ {
+		idx, y := t.offset+i, plot.Min.Y+i
+		lane := t.lanes[idx]
+		lstyle := style.Foreground(tview.Styles.PrimaryTextColor)
+		if idx == t.selected {
+			lstyle = lstyle.Reverse(true)
+		}
+		drawText(screen, rect.Min.X, y, labelW, lane.Title(), lstyle)
+		for _, m := range lane.Marks {
+			t.drawMark(screen, plot, y, start, end, m)
+		}
+	}
+}
+
+func (t *Timeline) labelWidth(width int) int {
+	w := 0
+	for _, l := range t.lanes {
+		if n := len([]rune(l.Title())); n > w {
+			w = n
+		}
+	}
+	if max := width / 3; w > max {
+		w = max
+	}
+
+	return w
+}
+
+func (t *Timeline) drawAxis(screen tcell.Screen, plot image.Rectangle, start time.Time) {
+	style := t.dimmed
+	y := plot.Max.Y
+	for x := plot.Min.X; x < plot.Max.X; x++ {
+		screen.SetContent(x, y, '─', nil, style)
+	}
+	ticks := plot.Dx() / minTickSpacing
+	if ticks == 0 {
+		return
+	}
+	step := t.window / time.Duration(ticks)
+	for i := 0; i < ticks; i++ {
+		at := start.Add(step * time.Duration(i))
+		x := plot.Min.X + i*minTickSpacing
+		screen.SetContent(x, y, '┴', nil, style)
+		drawText(screen, x+1, y, minTickSpacing-1, at.Format(axisTimeFmt), style)
+	}
+}
+
+func (t *Timeline) drawMark(screen tcell.Screen, plot image.Rectangle, y int, start, end time.Time, m Mark) {
+	if m.End.Before(start) || m.Start.After(end) {
+		return
+	}
+	style := tcell.StyleDefault.Background(t.bgColor).Foreground(t.colorFor(m.Kind))
+	x0, x1 := t.toX(plot, start, m.Start), t.toX(plot, start, m.End)
+	for x := x0; x < x1; x++ {
+		screen.SetContent(x, y, spanRune, nil, style)
+	}
+	screen.SetContent(x1, y, markRunes[m.Kind], nil, style)
+}
+
+func (t *Timeline) toX(plot image.Rectangle, start, at time.Time) int {
+	if at.Before(start) {
+		at = start
+	}
+	x := plot.Min.X + int(float64(at.Sub(start))/float64(t.window)*float64(plot.Dx()-1))
+	if x >= plot.Max.X {
+		x = plot.Max.X - 1
+	}
+
+	return x
+}
+
+func (t *Timeline) colorFor(k MarkKind) tcell.Color {
+	c1, c2 := okColor, faultColor
+	if len(t.seriesColors) == 2 {
+		c1, c2 = t.seriesColors[0], t.seriesColors[1]
+	}
+	switch k {
+	case MarkWarning:
+		return c2
+	case MarkRestart:
+		return restartColor
+	case MarkRollout:
+		return rolloutColor
+	default:
+		return c1
+	}
+}
+
+// MarkRune returns the glyph used to render a given mark kind.
+func MarkRune(k MarkKind) rune {
+	return markRunes[k]
+}
+
+func drawText(screen tcell.Screen, x, y, width int, s string, style tcell.Style) {
+	for i, r := range []rune(s) {
+		if i >= width {
+			return
+		}
+		screen.SetContent(x+i, y, r, nil, style)
+	}
+}
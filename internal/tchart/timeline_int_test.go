@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package tchart
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimelineToX(t *testing.T) {
+	now := time.Now()
+	plot := image.Rectangle{Min: image.Point{X: 10}, Max: image.Point{X: 110, Y: 10}}
+
+	uu := map[string]struct {
+		at time.Time
+		e  int
+	}{
+		"start": {
+			at: now.Add(-time.Hour),
+			e:  10,
+		},
+		"before": {
+			at: now.Add(-2 * time.Hour),
+			e:  10,
+		},
+		"middle": {
+			at: now.Add(-30 * time.Minute),
+			e:  59,
+		},
+		"end": {
+			at: now,
+			e:  109,
+		},
+		"after": {
+			at: now.Add(time.Hour),
+			e:  109,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tl := NewTimeline("t")
+			assert.Equal(t, u.e, tl.toX(plot, now.Add(-time.Hour), u.at))
+		})
+	}
+}
+
+func TestTimelineSelection(t *testing.T) {
+	tl := NewTimeline("t")
+	var changed []string
+	tl.SetChangedFunc(func(l Lane) {
+		changed = append(changed, l.ID)
+	})
+
+	tl.SetLanes([]Lane{{ID: "a"}, {ID: "b"}, {ID: "c"}})
+	tl.selectLane(1)
+	tl.selectLane(5)
+	l, ok := tl.SelectedLane()
+	assert.True(t, ok)
+	assert.Equal(t, "c", l.ID)
+
+	tl.SetLanes([]Lane{{ID: "c"}, {ID: "a"}})
+	l, ok = tl.SelectedLane()
+	assert.True(t, ok)
+	assert.Equal(t, "c", l.ID)
+	assert.Equal(t, []string{"a", "b", "c", "c"}, changed)
+
+	tl.SetLanes(nil)
+	_, ok = tl.SelectedLane()
+	assert.False(t, ok)
+}
+
+func TestLaneLast(t *testing.T) {
+	now := time.Now()
+	l := Lane{
+		Marks: []Mark{
+			{Start: now.Add(-time.Hour), End: now.Add(-time.Minute)},
+			{Start: now.Add(-time.Hour), End: now.Add(-time.Hour)},
+		},
+	}
+
+	assert.Equal(t, now.Add(-time.Minute), l.Last())
+}
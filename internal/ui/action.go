@@ -26,13 +26,17 @@ type (
 		Plugin    bool
 		HotKey    bool
 		Dangerous bool
+		Remapped  bool
 	}
 
 	// KeyAction represents a keyboard action.
 	KeyAction struct {
+		id          string
 		Description string
 		Action      ActionHandler
 		Opts        ActionOpts
+		Chord       *KeyActions
+		Default     tcell.Key
 	}
 
 	// KeyMap tracks key to action mappings.
@@ -69,6 +73,32 @@ func NewKeyActionWithOpts(d string, a ActionHandler, opts ActionOpts) KeyAction
 	}
 }
 
+// NewChordKeyAction returns a new keyboard action prefixing a chord of actions.
+func NewChordKeyAction(a ActionHandler, aa *KeyActions) KeyAction {
+	return KeyAction{
+		Action: a,
+		Opts:   ActionOpts{Visible: true},
+		Chord:  aa,
+	}
+}
+
+// WithID returns the action with a stable identifier. Only identified actions can be remapped.
+func (a KeyAction) WithID(id string) KeyAction {
+	a.id = id
+
+	return a
+}
+
+// ID returns the action identifier.
+func (a KeyAction) ID() string {
+	return a.id
+}
+
+// IsChord checks if the action prefixes a chord.
+func (a KeyAction) IsChord() bool {
+	return a.Chord != nil
+}
+
 // NewKeyActions returns a new instance.
 func NewKeyActions() *KeyActions {
 	return &KeyActions{
@@ -163,6 +193,10 @@ func (a *KeyActions) ClearDanger() {
 	defer a.mx.Unlock()
 
 	for k, v := range a.actions {
+		if v.Chord != nil {
+			v.Chord.ClearDanger()
+			continue
+		}
 		if v.Opts.Dangerous {
 			delete(a.actions, k)
 		}
@@ -205,6 +239,13 @@ func (a *KeyActions) Hints() model.MenuHints {
 	hh := make(model.MenuHints, 0, len(kk))
 	for _, k := range kk {
 		if name, ok := tcell.KeyNames[tcell.Key(int16(k))]; ok {
+			if c := a.actions[tcell.Key(k)].Chord; c != nil {
+				for _, h := range c.Hints() {
+					h.Mnemonic = name + " " + h.Mnemonic
+					hh = append(hh, h)
+				}
+				continue
+			}
 			hh = append(hh,
 				model.MenuHint{
 					Mnemonic:    name,
@@ -23,3 +23,28 @@ func TestKeyActionsHints(t *testing.T) {
 	assert.Equal(t, 3, len(hh))
 	assert.Equal(t, model.MenuHint{Mnemonic: "b", Description: "blee", Visible: true}, hh[0])
 }
+
+func TestKeyActionsChordHints(t *testing.T) {
+	kk := ui.NewKeyActionsFromMap(ui.KeyMap{
+		ui.KeyF: ui.NewKeyAction("fred", nil, true),
+		ui.KeyG: ui.NewChordKeyAction(nil, ui.NewKeyActionsFromMap(ui.KeyMap{
+			ui.KeyB: ui.NewKeyAction("blee", nil, true),
+			ui.KeyZ: ui.NewKeyAction("zorg", nil, false),
+		})),
+	})
+
+	hh := kk.Hints()
+
+	assert.Equal(t, 3, len(hh))
+	assert.Equal(t, model.MenuHint{Mnemonic: "g b", Description: "blee", Visible: true}, hh[1])
+	assert.Equal(t, model.MenuHint{Mnemonic: "g z", Description: "zorg", Visible: false}, hh[2])
+}
+
+func TestKeyActionID(t *testing.T) {
+	a := ui.NewKeyAction("Sort Name", nil, false)
+	assert.Equal(t, "", a.ID())
+
+	a = a.WithID("sortName")
+	a.Description = "Sort By Name"
+	assert.Equal(t, "sortName", a.ID())
+}
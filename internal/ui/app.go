@@ -26,6 +26,7 @@ type App struct {
 	views   map[string]tview.Primitive
 	cmdBuff *model.FishBuff
 	running bool
+	beep    bool
 	mx      sync.RWMutex
 }
 
@@ -58,6 +59,24 @@ func (a *App) Init() {
 	a.Styles.AddListener(a)
 
 	a.SetRoot(a.Main, true).EnableMouse(a.Config.K9s.UI.EnableMouse)
+	a.SetAfterDrawFunc(a.afterDraw)
+}
+
+// Beep rings the terminal bell once the screen is redrawn.
+func (a *App) Beep() {
+	a.QueueUpdateDraw(func() {
+		a.beep = true
+	})
+}
+
+func (a *App) afterDraw(sc tcell.Screen) {
+	if !a.beep {
+		return
+	}
+	a.beep = false
+	if err := sc.Beep(); err != nil {
+		log.Warn().Err(err).Msg("Terminal bell failed")
+	}
 }
 
 // QueueUpdate queues up a ui action.
@@ -140,11 +159,11 @@ func (a *App) Conn() client.Connection {
 
 func (a *App) bindKeys() {
 	a.actions = NewKeyActionsFromMap(KeyMap{
-		KeyColon:       NewKeyAction("Cmd", a.activateCmd, false),
-		tcell.KeyCtrlR: NewKeyAction("Redraw", a.redrawCmd, false),
-		tcell.KeyCtrlP: NewKeyAction("Persist", a.saveCmd, false),
-		tcell.KeyCtrlU: NewSharedKeyAction("Clear Filter", a.clearCmd, false),
-		tcell.KeyCtrlQ: NewSharedKeyAction("Clear Filter", a.clearCmd, false),
+		KeyColon:       NewKeyAction("Cmd", a.activateCmd, false).WithID("cmd"),
+		tcell.KeyCtrlR: NewKeyAction("Redraw", a.redrawCmd, false).WithID("redraw"),
+		tcell.KeyCtrlP: NewKeyAction("Persist", a.saveCmd, false).WithID("persist"),
+		tcell.KeyCtrlU: NewSharedKeyAction("Clear Filter", a.clearCmd, false).WithID("clearFilter"),
+		tcell.KeyCtrlQ: NewSharedKeyAction("Clear Filter", a.clearCmd, false).WithID("clearFilter"),
 	})
 }
 
@@ -291,3 +310,11 @@ func AsKey(evt *tcell.EventKey) tcell.Key {
 	}
 	return key
 }
+
+// AsEventKey converts a keyboard key to an event key.
+func AsEventKey(k tcell.Key) *tcell.EventKey {
+	if k >= ' ' && k <= '~' {
+		return tcell.NewEventKey(tcell.KeyRune, rune(k), tcell.ModNone)
+	}
+	return tcell.NewEventKey(k, 0, tcell.ModNone)
+}
@@ -23,6 +23,7 @@ type synchronizer interface {
 	Flash() *model.Flash
 	Logo() *Logo
 	UpdateClusterInfo()
+	KeyMapChanged()
 	QueueUpdateDraw(func())
 	QueueUpdate(func())
 }
@@ -32,6 +33,7 @@ type Configurator struct {
 	Config     *config.Config
 	Styles     *config.Styles
 	CustomView *config.CustomView
+	KeyMap     config.KeyMap
 	BenchFile  string
 	skinFile   string
 }
@@ -139,6 +141,17 @@ func (c *Configurator) ConfigWatcher(ctx context.Context, s synchronizer) error
 			case evt := <-w.Events:
 				if evt.Has(fsnotify.Create) || evt.Has(fsnotify.Write) {
 					log.Debug().Msgf("ConfigWatcher file changed: %s", evt.Name)
+					if c.isKeyMapFile(evt.Name) {
+						s.QueueUpdateDraw(func() {
+							if err := c.RefreshKeyMap(); err != nil {
+								log.Error().Err(err).Msgf("Key bindings reload failed")
+								s.Flash().Warn("Key bindings reload failed. Check k9s logs!")
+								s.Logo().Warn("Key bindings reload failed!")
+							}
+							s.KeyMapChanged()
+						})
+						continue
+					}
 					if evt.Name == config.AppConfigFile {
 						if err := c.Config.Load(evt.Name, false); err != nil {
 							log.Error().Err(err).Msgf("k9s config reload failed")
@@ -173,17 +186,57 @@ func (c *Configurator) ConfigWatcher(ctx context.Context, s synchronizer) error
 	if err := w.Add(config.AppConfigFile); err != nil {
 		return err
 	}
+	if err := watchIfExists(w, config.AppKeyMapFile); err != nil {
+		return err
+	}
 
 	cl, ct, ok := c.activeConfig()
 	if !ok {
 		return nil
 	}
+	if err := watchIfExists(w, config.AppContextKeyMapFile(cl, ct)); err != nil {
+		return err
+	}
 	ctConfigFile := filepath.Join(config.AppContextConfig(cl, ct))
 	log.Debug().Msgf("ConfigWatcher watching: %q", ctConfigFile)
 
 	return w.Add(ctConfigFile)
 }
 
+// RefreshKeyMap loads the global and active context key bindings.
+func (c *Configurator) RefreshKeyMap() error {
+	c.KeyMap = config.NewKeyMap()
+	if c.Config == nil {
+		return nil
+	}
+	km := config.NewKeyMap()
+	if err := km.Load(c.Config.ContextKeyMapPath()); err != nil {
+		return err
+	}
+	c.KeyMap = km
+
+	return nil
+}
+
+func (c *Configurator) isKeyMapFile(path string) bool {
+	if path == config.AppKeyMapFile {
+		return true
+	}
+	cl, ct, ok := c.activeConfig()
+
+	return ok && path == config.AppContextKeyMapFile(cl, ct)
+}
+
+// watchIfExists watches an optional config file.
+func watchIfExists(w *fsnotify.Watcher, path string) error {
+	if _, err := os.Stat(path); err != nil {
+		return nil
+	}
+	log.Debug().Msgf("ConfigWatcher watching: %q", path)
+
+	return w.Add(path)
+}
+
 func (c *Configurator) activeSkin() (string, bool) {
 	var skin string
 	if c.Config == nil || c.Config.K9s == nil {
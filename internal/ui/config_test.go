@@ -61,6 +61,25 @@ func TestBenchConfig(t *testing.T) {
 	assert.Equal(t, "/tmp/test-config/clusters/cl-1/ct-1/benchmarks.yaml", bc)
 }
 
+func TestRefreshKeyMap(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(config.K9sEnvConfigDir, dir)
+	assert.NoError(t, config.InitLocs())
+
+	var cfg ui.Configurator
+	cfg.Config = mock.NewMockConfig()
+	assert.NoError(t, cfg.RefreshKeyMap())
+	assert.Empty(t, cfg.KeyMap.Bindings)
+
+	assert.NoError(t, os.WriteFile(config.AppKeyMapFile, []byte("keyMap:\n  pod.shell: Shift-S\n"), data.DefaultFileMod))
+	assert.NoError(t, cfg.RefreshKeyMap())
+	assert.Equal(t, map[string]string{"pod.shell": "Shift-S"}, cfg.KeyMap.Bindings)
+
+	assert.NoError(t, os.WriteFile(config.AppKeyMapFile, []byte("keyMap:\n  pod.shell: s\n  pod.logs: s\n"), data.DefaultFileMod))
+	assert.Error(t, cfg.RefreshKeyMap())
+	assert.Empty(t, cfg.KeyMap.Bindings)
+}
+
 // Helpers...
 
 type synchronizer struct{}
@@ -74,5 +93,6 @@ func (s synchronizer) Flash() *model.Flash {
 }
 func (s synchronizer) Logo() *ui.Logo         { return nil }
 func (s synchronizer) UpdateClusterInfo()     {}
+func (s synchronizer) KeyMapChanged()         {}
 func (s synchronizer) QueueUpdateDraw(func()) {}
 func (s synchronizer) QueueUpdate(func())     {}
@@ -55,6 +55,15 @@ func (c *Crumbs) StackPopped(_, _ model.Component) {
 	c.refresh(c.stack.Flatten())
 }
 
+// Reset resets the breadcrumbs to the given components.
+func (c *Crumbs) Reset(cc []model.Component) {
+	c.stack = model.NewStack()
+	for _, comp := range cc {
+		c.stack.Push(comp)
+	}
+	c.refresh(c.stack.Flatten())
+}
+
 // StackTop indicates the top of the stack.
 func (c *Crumbs) StackTop(top model.Component) {}
 
@@ -0,0 +1,356 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	editorIndent = "  "
+	issueMarker  = '!'
+)
+
+// CompleteFunc returns completion candidates for a given line and prefix.
+type CompleteFunc func(lines []string, row int, prefix string) []string
+
+// Editor represents a minimal yaml text editor.
+type Editor struct {
+	*tview.Box
+
+	lines          []string
+	row, col       int
+	rowOff, colOff int
+	issues         map[int]string
+	styles         config.Yaml
+	fgColor        tcell.Color
+	changedFn      func()
+	completeFn     CompleteFunc
+	suggestFn      func([]string)
+}
+
+// NewEditor returns a new editor.
+func NewEditor() *Editor {
+	return &Editor{
+		Box:     tview.NewBox(),
+		lines:   []string{""},
+		fgColor: tcell.ColorWhite,
+	}
+}
+
+// SetStyles sets the yaml syntax colors.
+func (e *Editor) SetStyles(fg tcell.Color, s config.Yaml) {
+	e.fgColor, e.styles = fg, s
+}
+
+// SetChangedFunc sets a callback fn when the text changes.
+func (e *Editor) SetChangedFunc(f func()) {
+	e.changedFn = f
+}
+
+// SetCompleteFunc sets a field completion provider.
+func (e *Editor) SetCompleteFunc(f CompleteFunc) {
+	e.completeFn = f
+}
+
+// SetSuggestFunc sets a callback fn when several completions are available.
+func (e *Editor) SetSuggestFunc(f func([]string)) {
+	e.suggestFn = f
+}
+
+// SetIssues marks lines with issues. Lines are 1 based.
+func (e *Editor) SetIssues(ii map[int]string) {
+	e.issues = ii
+}
+
+// IssueAt returns the issue at the cursor line if any.
+func (e *Editor) IssueAt() (string, bool) {
+	s, ok := e.issues[e.row+1]
+
+	return s, ok
+}
+
+// SetText sets the editor content.
+func (e *Editor) SetText(s string) {
+	e.lines = strings.Split(strings.ReplaceAll(s, "\t", editorIndent), "\n")
+	e.row, e.col, e.rowOff, e.colOff = 0, 0, 0, 0
+}
+
+// GetText returns the editor content.
+func (e *Editor) GetText() string {
+	return strings.Join(e.lines, "\n")
+}
+
+// Lines returns the editor lines.
+func (e *Editor) Lines() []string {
+	return e.lines
+}
+
+// Cursor returns the current cursor position.
+func (e *Editor) Cursor() (int, int) {
+	return e.row, e.col
+}
+
+// InputHandler returns the handler for this primitive.
+func (e *Editor) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return e.WrapInputHandler(func(evt *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		changed := true
+		// nolint:exhaustive
+		switch evt.Key() {
+		case tcell.KeyRune:
+			e.insert(string(evt.Rune()))
+		case tcell.KeyTab:
+			e.insert(editorIndent)
+		case tcell.KeyEnter:
+			e.newLine()
+		case tcell.KeyBackspace, tcell.KeyBackspace2:
+			e.backspace()
+		case tcell.KeyDelete:
+			e.delete()
+		case tcell.KeyCtrlSpace:
+			e.complete()
+		default:
+			changed = false
+			e.move(evt.Key())
+		}
+		if changed && e.changedFn != nil {
+			e.changedFn()
+		}
+	})
+}
+
+func (e *Editor) line() []rune {
+	return []rune(e.lines[e.row])
+}
+
+func (e *Editor) insert(s string) {
+	l := e.line()
+	e.lines[e.row] = string(l[:e.col]) + s + string(l[e.col:])
+	e.col += len([]rune(s))
+}
+
+func (e *Editor) newLine() {
+	l := e.line()
+	head, tail := string(l[:e.col]), string(l[e.col:])
+	indent := strings.Repeat(" ", len(head)-len(strings.TrimLeft(head, " ")))
+	if t := strings.TrimSpace(head); strings.HasSuffix(t, ":") {
+		indent += editorIndent
+	} else if strings.HasPrefix(t, "- ") {
+		indent += editorIndent
+	}
+	e.lines[e.row] = head
+	e.lines = append(e.lines[:e.row+1], append([]string{indent + strings.TrimLeft(tail, " ")}, e.lines[e.row+1:]...)...)
+	e.row, e.col = e.row+1, len(indent)
+}
+
+func (e *Editor) backspace() {
+	if e.col > 0 {
+		l := e.line()
+		e.lines[e.row] = string(l[:e.col-1]) + string(l[e.col:])
+		e.col--
+		return
+	}
+	if e.row == 0 {
+		return
+	}
+	prev := []rune(e.lines[e.row-1])
+	e.lines[e.row-1] += e.lines[e.row]
+	e.lines = append(e.lines[:e.row], e.lines[e.row+1:]...)
+	e.row, e.col = e.row-1, len(prev)
+}
+
+func (e *Editor) delete() {
+	l := e.line()
+	if e.col < len(l) {
+		e.lines[e.row] = string(l[:e.col]) + string(l[e.col+1:])
+		return
+	}
+	if e.row+1 < len(e.lines) {
+		e.lines[e.row] += e.lines[e.row+1]
+		e.lines = append(e.lines[:e.row+1], e.lines[e.row+2:]...)
+	}
+}
+
+func (e *Editor) complete() {
+	if e.completeFn == nil {
+		return
+	}
+	l := e.line()
+	start := e.col
+	for start > 0 && isFieldRune(l[start-1]) {
+		start--
+	}
+	prefix := string(l[start:e.col])
+	ss := e.completeFn(e.lines, e.row, prefix)
+	switch len(ss) {
+	case 0:
+		return
+	case 1:
+		e.insert(strings.TrimPrefix(ss[0], prefix) + ": ")
+	default:
+		e.insert(strings.TrimPrefix(commonPrefix(ss), prefix))
+		if e.suggestFn != nil {
+			e.suggestFn(ss)
+		}
+	}
+}
+
+func (e *Editor) move(k tcell.Key) {
+	_, _, _, height := e.GetInnerRect()
+	// nolint:exhaustive
+	switch k {
+	case tcell.KeyUp:
+		e.row--
+	case tcell.KeyDown:
+		e.row++
+	case tcell.KeyLeft:
+		if e.col > 0 {
+			e.col--
+		} else if e.row > 0 {
+			e.row--
+			e.col = len([]rune(e.lines[e.row]))
+		}
+	case tcell.KeyRight:
+		if e.col < len(e.line()) {
+			e.col++
+		} else if e.row+1 < len(e.lines) {
+			e.row, e.col = e.row+1, 0
+		}
+	case tcell.KeyHome, tcell.KeyCtrlA:
+		e.col = 0
+	case tcell.KeyEnd, tcell.KeyCtrlE:
+		e.col = len(e.line())
+	case tcell.KeyPgUp:
+		e.row -= height
+	case tcell.KeyPgDn:
+		e.row += height
+	}
+	e.row = clamp(e.row, 0, len(e.lines)-1)
+	e.col = clamp(e.col, 0, len(e.line()))
+}
+
+// Draw draws the editor.
+func (e *Editor) Draw(screen tcell.Screen) {
+	e.Box.DrawForSubclass(screen, e)
+	x, y, width, height := e.GetInnerRect()
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	gutter := len(fmt.Sprintf("%d", len(e.lines))) + 2
+	textW := width - gutter
+	if textW <= 0 {
+		return
+	}
+	if e.row < e.rowOff {
+		e.rowOff = e.row
+	}
+	if e.row >= e.rowOff+height {
+		e.rowOff = e.row - height + 1
+	}
+	if e.col < e.colOff {
+		e.colOff = e.col
+	}
+	if e.col >= e.colOff+textW {
+		e.colOff = e.col - textW + 1
+	}
+
+	bg := e.GetBackgroundColor()
+	dim := tcell.StyleDefault.Background(bg).Foreground(tcell.ColorGray)
+	for i := 0; i < height && e.rowOff+i < len(e.lines); i++ {
+		r := e.rowOff + i
+		num := fmt.Sprintf("%*d ", gutter-2, r+1)
+		for j, c := range num {
+			screen.SetContent(x+j, y+i, c, nil, dim)
+		}
+		if _, ok := e.issues[r+1]; ok {
+			screen.SetContent(x+gutter-1, y+i, issueMarker, nil, dim.Foreground(tcell.ColorRed).Bold(true))
+		}
+		l := []rune(e.lines[r])
+		styles := e.lineStyles(l, bg)
+		for j := 0; j < textW && e.colOff+j < len(l); j++ {
+			screen.SetContent(x+gutter+j, y+i, l[e.colOff+j], nil, styles[e.colOff+j])
+		}
+	}
+	if e.HasFocus() {
+		screen.ShowCursor(x+gutter+e.col-e.colOff, y+e.row-e.rowOff)
+	}
+}
+
+// lineStyles returns the syntax highlighting style of each rune in a line.
+func (e *Editor) lineStyles(l []rune, bg tcell.Color) []tcell.Style {
+	base := tcell.StyleDefault.Background(bg)
+	ss := make([]tcell.Style, len(l))
+	for i := range ss {
+		ss[i] = base.Foreground(e.fgColor)
+	}
+
+	t := strings.TrimLeft(string(l), " ")
+	start := len(l) - len([]rune(t))
+	if strings.HasPrefix(t, "- ") {
+		start += 2
+		t = t[2:]
+	}
+	if strings.HasPrefix(t, "#") {
+		for i := start; i < len(l); i++ {
+			ss[i] = base.Foreground(tcell.ColorGray)
+		}
+		return ss
+	}
+	colon := strings.Index(t, ":")
+	if colon < 0 || strings.ContainsAny(t[:colon], " \"'") {
+		for i := start; i < len(l); i++ {
+			ss[i] = base.Foreground(e.styles.ValueColor.Color())
+		}
+		return ss
+	}
+	colon = start + len([]rune(t[:colon]))
+	for i := start; i < colon; i++ {
+		ss[i] = base.Foreground(e.styles.KeyColor.Color())
+	}
+	ss[colon] = base.Foreground(e.styles.ColonColor.Color())
+	for i := colon + 1; i < len(l); i++ {
+		ss[i] = base.Foreground(e.styles.ValueColor.Color())
+	}
+
+	return ss
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func isFieldRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' || r == '.'
+}
+
+func commonPrefix(ss []string) string {
+	if len(ss) == 0 {
+		return ""
+	}
+	p := ss[0]
+	for _, s := range ss[1:] {
+		for !strings.HasPrefix(s, p) {
+			p = p[:len(p)-1]
+		}
+	}
+
+	return p
+}
+
+func clamp(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+
+	return v
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditorTyping(t *testing.T) {
+	e := ui.NewEditor()
+	e.SetText("spec:")
+	typeKeys(e,
+		tcell.NewEventKey(tcell.KeyEnd, 0, tcell.ModNone),
+		tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone),
+	)
+	typeString(e, "replicas: 3")
+
+	assert.Equal(t, "spec:\n  replicas: 3", e.GetText())
+	r, c := e.Cursor()
+	assert.Equal(t, 1, r)
+	assert.Equal(t, 13, c)
+
+	typeKeys(e,
+		tcell.NewEventKey(tcell.KeyHome, 0, tcell.ModNone),
+		tcell.NewEventKey(tcell.KeyBackspace2, 0, tcell.ModNone),
+	)
+	assert.Equal(t, "spec:  replicas: 3", e.GetText())
+}
+
+func TestEditorComplete(t *testing.T) {
+	e := ui.NewEditor()
+	var sugs []string
+	e.SetSuggestFunc(func(ss []string) { sugs = ss })
+	e.SetCompleteFunc(func(_ []string, _ int, prefix string) []string {
+		switch prefix {
+		case "re":
+			return []string{"replicas"}
+		case "s":
+			return []string{"selector", "serviceName"}
+		}
+		return nil
+	})
+
+	typeString(e, "re")
+	typeKeys(e, tcell.NewEventKey(tcell.KeyCtrlSpace, 0, tcell.ModNone))
+	assert.Equal(t, "replicas: ", e.GetText())
+
+	e.SetText("")
+	typeString(e, "s")
+	typeKeys(e, tcell.NewEventKey(tcell.KeyCtrlSpace, 0, tcell.ModNone))
+	assert.Equal(t, "se", e.GetText())
+	assert.Equal(t, []string{"selector", "serviceName"}, sugs)
+}
+
+func TestEditorIssues(t *testing.T) {
+	e := ui.NewEditor()
+	e.SetText("a: 1\nb: 2")
+	e.SetIssues(map[int]string{2: "unknown field"})
+
+	_, ok := e.IssueAt()
+	assert.False(t, ok)
+	typeKeys(e, tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+	msg, ok := e.IssueAt()
+	assert.True(t, ok)
+	assert.Equal(t, "unknown field", msg)
+}
+
+// Helpers...
+
+func typeString(e *ui.Editor, s string) {
+	for _, r := range s {
+		typeKeys(e, tcell.NewEventKey(tcell.KeyRune, r, tcell.ModNone))
+	}
+}
+
+func typeKeys(e *ui.Editor, kk ...*tcell.EventKey) {
+	h := e.InputHandler()
+	for _, k := range kk {
+		h(k, func(tview.Primitive) {})
+	}
+}
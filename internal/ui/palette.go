@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/sahilm/fuzzy"
+)
+
+const (
+	paletteWidth  = 100
+	paletteHeight = 24
+)
+
+// PaletteItem represents a command palette entry.
+type PaletteItem struct {
+	Kind        string
+	Name        string
+	Key         string
+	Description string
+	Run         func()
+}
+
+func (p PaletteItem) text() string {
+	return strings.Join([]string{p.Kind, p.Name, p.Key, p.Description}, " ")
+}
+
+// PaletteItems represents a collection of palette entries.
+type PaletteItems []PaletteItem
+
+// Match returns the indexes of the items fuzzy matching a query ranked by score.
+func (pp PaletteItems) Match(q string) []int {
+	if q == "" {
+		ii := make([]int, 0, len(pp))
+		for i := range pp {
+			ii = append(ii, i)
+		}
+		return ii
+	}
+
+	texts := make([]string, 0, len(pp))
+	for _, p := range pp {
+		texts = append(texts, p.text())
+	}
+	mm := fuzzy.Find(q, texts)
+	ii := make([]int, 0, len(mm))
+	for _, m := range mm {
+		ii = append(ii, m.Index)
+	}
+
+	return ii
+}
+
+// Palette represents a fuzzy searchable command palette.
+type Palette struct {
+	*tview.Flex
+
+	input   *tview.InputField
+	list    *tview.List
+	items   PaletteItems
+	matches []int
+	styles  config.Dialog
+	done    func(*PaletteItem)
+}
+
+// NewPalette returns a new command palette.
+func NewPalette(styles config.Dialog, title string, items PaletteItems) *Palette {
+	p := Palette{
+		Flex:   tview.NewFlex().SetDirection(tview.FlexRow),
+		input:  tview.NewInputField(),
+		list:   tview.NewList(),
+		items:  items,
+		styles: styles,
+	}
+	p.SetBorder(true)
+	p.SetBorderPadding(0, 0, 1, 1)
+	p.SetTitle(title)
+	p.SetBackgroundColor(styles.BgColor.Color())
+	p.SetTitleColor(styles.FgColor.Color())
+
+	p.input.SetLabel("> ")
+	p.input.SetLabelColor(styles.LabelFgColor.Color())
+	p.input.SetFieldTextColor(styles.FieldFgColor.Color())
+	p.input.SetFieldBackgroundColor(styles.BgColor.Color())
+	p.input.SetBackgroundColor(styles.BgColor.Color())
+	p.input.SetChangedFunc(p.filter)
+	p.input.SetInputCapture(p.keyboard)
+
+	p.list.ShowSecondaryText(false)
+	p.list.SetHighlightFullLine(true)
+	p.list.SetBackgroundColor(styles.BgColor.Color())
+	p.list.SetMainTextColor(styles.FgColor.Color())
+	p.list.SetSelectedTextColor(styles.ButtonFocusFgColor.Color())
+	p.list.SetSelectedBackgroundColor(styles.ButtonFocusBgColor.Color())
+
+	p.AddItem(p.input, 1, 0, true)
+	p.AddItem(p.list, 0, 1, false)
+	p.filter("")
+
+	return &p
+}
+
+// SetDoneFunc sets a callback for when an item was selected or the palette was cancelled.
+func (p *Palette) SetDoneFunc(f func(*PaletteItem)) {
+	p.done = f
+}
+
+// Draw draws the palette centered on screen.
+func (p *Palette) Draw(screen tcell.Screen) {
+	sw, sh := screen.Size()
+	w, h := min(paletteWidth, sw-4), min(paletteHeight, sh-4)
+	p.SetRect((sw-w)/2, (sh-h)/2, w, h)
+	p.Flex.Draw(screen)
+}
+
+// Focus delegates focus to the search field.
+func (p *Palette) Focus(delegate func(tview.Primitive)) {
+	delegate(p.input)
+}
+
+func (p *Palette) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	switch evt.Key() {
+	case tcell.KeyUp, tcell.KeyCtrlP, tcell.KeyBacktab:
+		p.move(-1)
+	case tcell.KeyDown, tcell.KeyCtrlN, tcell.KeyTab:
+		p.move(1)
+	case tcell.KeyEnter:
+		p.selected()
+	case tcell.KeyEscape:
+		p.fire(nil)
+	default:
+		return evt
+	}
+
+	return nil
+}
+
+func (p *Palette) move(d int) {
+	c := p.list.GetItemCount()
+	if c == 0 {
+		return
+	}
+	p.list.SetCurrentItem((p.list.GetCurrentItem() + d + c) % c)
+}
+
+func (p *Palette) selected() {
+	if len(p.matches) == 0 {
+		return
+	}
+	item := p.items[p.matches[p.list.GetCurrentItem()]]
+	p.fire(&item)
+}
+
+func (p *Palette) fire(item *PaletteItem) {
+	if p.done != nil {
+		p.done(item)
+	}
+}
+
+func (p *Palette) filter(q string) {
+	p.matches = p.items.Match(q)
+	p.list.Clear()
+	label := p.styles.LabelFgColor.String()
+	for _, i := range p.matches {
+		item := p.items[i]
+		line := fmt.Sprintf("[%s::b]%-8s[-::-] %s", label, item.Kind, tview.Escape(item.Name))
+		if item.Key != "" {
+			line += fmt.Sprintf(" [%s::]<%s>[-::]", label, tview.Escape(item.Key))
+		}
+		if item.Description != "" && item.Description != item.Name {
+			line += " " + tview.Escape(item.Description)
+		}
+		p.list.AddItem(line, "", 0, nil)
+	}
+}
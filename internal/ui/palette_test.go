@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaletteItemsMatch(t *testing.T) {
+	ii := ui.PaletteItems{
+		{Kind: "action", Name: "Describe", Key: "d"},
+		{Kind: "plugin", Name: "Stern Logs", Key: "Ctrl-L"},
+		{Kind: "view", Name: "v1/pods", Key: "po", Description: "po,pod,pods"},
+		{Kind: "query", Name: "broken", Description: "pods fred /Crash"},
+	}
+
+	uu := map[string]struct {
+		q string
+		e []int
+	}{
+		"all": {
+			e: []int{0, 1, 2, 3},
+		},
+		"action": {
+			q: "desc",
+			e: []int{0},
+		},
+		"plugin": {
+			q: "stern",
+			e: []int{1},
+		},
+		"kind": {
+			q: "query",
+			e: []int{3},
+		},
+		"none": {
+			q: "zorg",
+			e: []int{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ii.Match(u.q))
+		})
+	}
+}
+
+func TestPaletteItemsMatchRanked(t *testing.T) {
+	ii := ui.PaletteItems{
+		{Kind: "view", Name: "v1/persistentvolumes", Key: "pv"},
+		{Kind: "view", Name: "v1/pods", Key: "po"},
+	}
+
+	mm := ii.Match("pods")
+	assert.Equal(t, 1, mm[0])
+}
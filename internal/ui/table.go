@@ -52,6 +52,7 @@ type Table struct {
 	wide        bool
 	toast       bool
 	hasMetrics  bool
+	selItem     string
 	ctx         context.Context
 	mx          sync.RWMutex
 }
@@ -254,6 +255,51 @@ func (t *Table) SetSortCol(name string, asc bool) {
 	t.setSortCol(model1.SortColumn{Name: name, ASC: asc})
 }
 
+// SortColumn returns the current sort column.
+func (t *Table) SortColumn() model1.SortColumn {
+	return t.getSortCol()
+}
+
+// SetManualSortCol sets the sort column overriding any view settings.
+func (t *Table) SetManualSortCol(name string, asc bool) {
+	t.SetSortCol(name, asc)
+	t.setMSort(true)
+}
+
+// SelectItem selects the row matching the given item once loaded.
+func (t *Table) SelectItem(id string) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.selItem = id
+}
+
+// SelectedItemID returns the currently selected row id if any.
+func (t *Table) SelectedItemID() string {
+	id, _ := t.GetRowID(t.GetSelectedRowIndex())
+
+	return id
+}
+
+func (t *Table) restoreSelection(rows int) {
+	t.mx.Lock()
+	sel := t.selItem
+	if rows > 0 {
+		t.selItem = ""
+	}
+	t.mx.Unlock()
+
+	if sel == "" || rows == 0 {
+		return
+	}
+	for r := 1; r <= rows; r++ {
+		if id, ok := t.GetRowID(r); ok && id == sel {
+			t.Select(r, 0)
+			return
+		}
+	}
+}
+
 // Update table content.
 func (t *Table) Update(data *model1.TableData, hasMetrics bool) *model1.TableData {
 	if t.decorateFn != nil {
@@ -268,7 +314,7 @@ func (t *Table) doUpdate(data *model1.TableData) *model1.TableData {
 	if client.IsAllNamespaces(data.GetNamespace()) {
 		t.actions.Add(
 			KeyShiftP,
-			NewKeyAction("Sort Namespace", t.SortColCmd("NAMESPACE", true), false),
+			NewKeyAction("Sort Namespace", t.SortColCmd("NAMESPACE", true), false).WithID("sortNamespace"),
 		)
 	} else {
 		t.actions.Delete(KeyShiftP)
@@ -316,20 +362,25 @@ func (t *Table) UpdateUI(cdata, data *model1.TableData) {
 			log.Error().Msgf("unable to find original re: %q", re.Row.ID)
 			return true
 		}
-		t.buildRow(row+1, re, ore, cdata.Header(), pads)
+		t.buildRow(row+1, re, ore, cdata.Header(), data.Header(), pads)
 
 		return true
 	})
 
+	t.restoreSelection(cdata.RowCount())
 	t.updateSelection(true)
 	t.UpdateTitle()
 }
 
-func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads MaxyPad) {
+func (t *Table) buildRow(r int, re, ore model1.RowEvent, h, oh model1.Header, pads MaxyPad) {
 	color := model1.DefaultColorer
 	if t.colorerFn != nil {
 		color = t.colorerFn
 	}
+	var ruleColor tcell.Color
+	if vs := t.getVs(); vs != nil && ore.Kind != model1.EventDelete {
+		ruleColor, _ = model1.RuleColor(vs.ColorRules, oh, &ore)
+	}
 
 	marked := t.IsMarked(re.Row.ID)
 	var col int
@@ -368,6 +419,9 @@ func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads M
 		cell.SetExpansion(1)
 		cell.SetAlign(h[c].Align)
 		fgColor := color(ns, h, &re)
+		if ruleColor != tcell.ColorDefault {
+			fgColor = ruleColor
+		}
 		cell.SetTextColor(fgColor)
 		if marked {
 			cell.SetTextColor(t.styles.Table().MarkColor.Color())
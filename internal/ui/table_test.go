@@ -63,6 +63,22 @@ func TestTableSelection(t *testing.T) {
 	assert.Equal(t, 1, v.GetSelectedRowIndex())
 }
 
+func TestTableSelectItem(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.SetModel(&mockModel{})
+	v.SelectItem("r2")
+
+	data := makeTableData()
+	cdata := v.Update(data, false)
+	v.UpdateUI(cdata, data)
+	assert.Equal(t, "r2", v.SelectedItemID())
+
+	v.SelectRow(1, 0, true)
+	v.UpdateUI(cdata, data)
+	assert.Equal(t, "r1", v.SelectedItemID())
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -92,8 +92,8 @@ func (t *Tree) ExtraHints() map[string]string {
 // BindKeys binds default mnemonics.
 func (t *Tree) BindKeys() {
 	t.Actions().Merge(NewKeyActionsFromMap(KeyMap{
-		KeySpace: NewKeyAction("Expand/Collapse", t.noopCmd, true),
-		KeyX:     NewKeyAction("Expand/Collapse All", t.toggleCollapseCmd, true),
+		KeySpace: NewKeyAction("Expand/Collapse", t.noopCmd, true).WithID("expandCollapse"),
+		KeyX:     NewKeyAction("Expand/Collapse All", t.toggleCollapseCmd, true).WithID("expandCollapseAll"),
 	}))
 }
 
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// AlertFired notifies a watched resource transitioned to an alerting state.
+func (a *App) AlertFired(al model.Alert) {
+	msg := fmt.Sprintf("%s %s is %s (was %s)", al.GVR, al.FQN, al.To, al.From)
+	a.QueueUpdateDraw(func() {
+		a.Flash().Warnf("Alert %s: %s", al.Rule, msg)
+		if al.Spec.Notify {
+			a.Logo().Warn(fmt.Sprintf("Alert %s!", al.Rule))
+		}
+	})
+	if al.Spec.Bell {
+		a.Beep()
+	}
+	if al.Spec.Exec != "" {
+		go alertHook(al)
+	}
+}
+
+func alertHook(al model.Alert) {
+	ns, n := client.Namespaced(al.FQN)
+	c := exec.Command("sh", "-c", al.Spec.Exec)
+	c.Env = append(os.Environ(),
+		"ALERT="+al.Rule,
+		"GVR="+al.GVR,
+		"NAMESPACE="+ns,
+		"NAME="+n,
+		"STATE="+al.To,
+		"PREVIOUS_STATE="+al.From,
+	)
+	if bb, err := c.CombinedOutput(); err != nil {
+		log.Error().Err(err).Msgf("Alert hook %q failed: %s", al.Rule, string(bb))
+	}
+}
+
+// watchCmd watches the selected resources or a resources query for alerts.
+func (c *Command) watchCmd(p *cmd.Interpreter) error {
+	rules := config.NewAlerts()
+	if err := rules.Load(c.app.Config.ContextAlertsPath()); err != nil {
+		return err
+	}
+	c.app.alerter.SetRules(rules)
+
+	line, _ := p.WatchArg()
+	ww, err := c.alertWatches(line)
+	if err != nil {
+		return err
+	}
+	for _, w := range ww {
+		if err := c.app.alerter.Watch(w); err != nil {
+			return err
+		}
+	}
+	c.app.Flash().Infof("Watching %d resource set(s) for alerts", c.app.alerter.Count())
+
+	return nil
+}
+
+func (c *Command) alertWatches(line string) ([]model.AlertWatch, error) {
+	if line == "" {
+		return c.selectionWatches()
+	}
+
+	p := cmd.NewInterpreter(line)
+	gvr, _, ok := c.alias.AsGVR(p.Cmd())
+	if !ok {
+		return nil, fmt.Errorf("`%s` command not found", p.Cmd())
+	}
+	ns, ok := p.NSArg()
+	if !ok {
+		ns = c.app.Config.ActiveNamespace()
+	}
+	var sel labels.Selector
+	if ll, ok := p.LabelsArg(); ok {
+		sel = labels.SelectorFromSet(ll)
+	}
+
+	nss := []string{client.CleanseNamespace(ns)}
+	if client.IsMultiNamespace(ns) {
+		nss = client.Namespaces(ns)
+	}
+	ww := make([]model.AlertWatch, 0, len(nss))
+	for _, ns := range nss {
+		ww = append(ww, model.AlertWatch{
+			GVR:       gvr.String(),
+			Namespace: ns,
+			Labels:    sel,
+		})
+	}
+
+	return ww, nil
+}
+
+func (c *Command) selectionWatches() ([]model.AlertWatch, error) {
+	v, ok := c.app.Content.Top().(ResourceViewer)
+	if !ok {
+		return nil, errors.New("no resources to watch in this view")
+	}
+	sels := v.GetTable().GetSelectedItems()
+	if len(sels) == 0 {
+		return nil, errors.New("no resources selected")
+	}
+
+	byNS := make(map[string]map[string]struct{})
+	for _, fqn := range sels {
+		ns, _ := client.Namespaced(fqn)
+		if _, ok := byNS[ns]; !ok {
+			byNS[ns] = make(map[string]struct{})
+		}
+		byNS[ns][fqn] = struct{}{}
+	}
+	ww := make([]model.AlertWatch, 0, len(byNS))
+	for ns, names := range byNS {
+		ww = append(ww, model.AlertWatch{
+			GVR:       v.GVR().String(),
+			Namespace: ns,
+			Names:     names,
+		})
+	}
+
+	return ww, nil
+}
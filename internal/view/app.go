@@ -20,6 +20,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
@@ -45,17 +46,22 @@ const (
 type App struct {
 	version string
 	*ui.App
-	Content       *PageStack
-	command       *Command
-	factory       *watch.Factory
-	cancelFn      context.CancelFunc
-	clusterModel  *model.ClusterInfo
-	cmdHistory    *model.History
-	filterHistory *model.History
-	conRetry      int32
-	showHeader    bool
-	showLogo      bool
-	showCrumbs    bool
+	Content         *PageStack
+	workspace       *Workspace
+	command         *Command
+	alerter         *model.Alerter
+	chord           *ui.KeyActions
+	prom            promDial
+	factory         *watch.Factory
+	cancelFn        context.CancelFunc
+	samplerCancelFn context.CancelFunc
+	clusterModel    *model.ClusterInfo
+	cmdHistory      *model.History
+	filterHistory   *model.History
+	conRetry        int32
+	showHeader      bool
+	showLogo        bool
+	showCrumbs      bool
 }
 
 // NewApp returns a K9s app instance.
@@ -66,6 +72,7 @@ func NewApp(cfg *config.Config) *App {
 		filterHistory: model.NewHistory(model.MaxHistory),
 		Content:       NewPageStack(),
 	}
+	a.workspace = NewWorkspace(&a)
 	a.ReloadStyles()
 
 	a.Views()["statusIndicator"] = ui.NewStatusIndicator(a.App, a.Styles)
@@ -101,6 +108,7 @@ func (a *App) Init(version string, rate int) error {
 	}
 	a.Content.Stack.AddListener(a.Crumbs())
 	a.Content.Stack.AddListener(a.Menu())
+	a.workspace.Init(ctx, a.Content)
 
 	a.App.Init()
 	a.SetInputCapture(a.keyboard)
@@ -111,6 +119,8 @@ func (a *App) Init(version string, rate int) error {
 	ns := a.Config.ActiveNamespace()
 
 	a.factory = watch.NewFactory(a.Conn())
+	a.alerter = model.NewAlerter(a.factory)
+	a.alerter.AddListener(a)
 	a.initFactory(ns)
 
 	a.clusterModel = model.NewClusterInfo(a.factory, a.version, a.Config.K9s)
@@ -126,6 +136,7 @@ func (a *App) Init(version string, rate int) error {
 		return err
 	}
 	a.CmdBuff().SetSuggestionFn(a.suggestCommand())
+	a.refreshKeyMap()
 
 	a.layout(ctx)
 	a.initSignals()
@@ -138,6 +149,24 @@ func (a *App) Init(version string, rate int) error {
 	return nil
 }
 
+func (a *App) refreshKeyMap() {
+	if err := a.RefreshKeyMap(); err != nil {
+		log.Warn().Err(err).Msg("Key bindings load failed")
+		a.Logo().Warn("Key bindings load failed!")
+	}
+	a.KeyMapChanged()
+}
+
+// KeyMapChanged rebinds app actions per the global key bindings. Views rebind
+// their own actions when they are refreshed.
+func (a *App) KeyMapChanged() {
+	resetKeyMap(a.GetActions())
+	if err := keyMapActions(a, nil, a.GetActions()); err != nil {
+		log.Warn().Err(err).Msg("Key bindings load failed")
+		a.Logo().Warn("Key bindings load failed!")
+	}
+}
+
 func (a *App) stopImgScanner() {
 	if vul.ImgScanner != nil {
 		vul.ImgScanner.Stop()
@@ -153,13 +182,44 @@ func (a *App) initImgScanner(version string) {
 	go vul.ImgScanner.Init("k9s", version)
 }
 
+// initUsageSampler starts sampling the active context containers usage. Samples
+// are retained until the next context switch.
+func (a *App) initUsageSampler() {
+	a.stopUsageSampler()
+	if !a.Config.K9s.RightSizing.Enable {
+		return
+	}
+	cfg := a.Config.K9s.RightSizing
+	if a.Config.K9s.IsOffline() {
+		cfg.Persist = false
+	}
+	s := dao.NewUsageSampler(
+		a.factory,
+		cfg,
+		a.Config.K9s.ContextUsagePath(),
+		a.Config.ActiveNamespace,
+	)
+	var ctx context.Context
+	ctx, a.samplerCancelFn = context.WithCancel(context.Background())
+	dao.SetSampler(s)
+	go s.Run(ctx)
+}
+
+func (a *App) stopUsageSampler() {
+	if a.samplerCancelFn != nil {
+		a.samplerCancelFn()
+		a.samplerCancelFn = nil
+	}
+	dao.SetSampler(nil)
+}
+
 func (a *App) layout(ctx context.Context) {
 	flash := ui.NewFlash(a.App)
 	go flash.Watch(ctx, a.Flash().Channel())
 
 	main := tview.NewFlex().SetDirection(tview.FlexRow)
 	main.AddItem(a.statusIndicator(), 1, 1, false)
-	main.AddItem(a.Content, 0, 10, true)
+	main.AddItem(a.workspace, 0, 10, true)
 	if !a.Config.K9s.IsCrumbsless() {
 		main.AddItem(a.Crumbs(), 1, 1, false)
 	}
@@ -231,6 +291,16 @@ func (a *App) contextNames() ([]string, error) {
 }
 
 func (a *App) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if _, ok := a.GetFocus().(*ui.Editor); ok {
+		return evt
+	}
+	if c := a.chord; c != nil {
+		a.chord = nil
+		if k, ok := c.Get(ui.AsKey(evt)); ok {
+			return k.Action(evt)
+		}
+		return nil
+	}
 	if k, ok := a.HasAction(ui.AsKey(evt)); ok && !a.Content.IsTopDialog() {
 		return k.Action(evt)
 	}
@@ -240,13 +310,16 @@ func (a *App) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 
 func (a *App) bindKeys() {
 	a.AddActions(ui.NewKeyActionsFromMap(ui.KeyMap{
-		ui.KeyShift9:   ui.NewSharedKeyAction("DumpGOR", a.dumpGOR, false),
-		tcell.KeyCtrlE: ui.NewSharedKeyAction("ToggleHeader", a.toggleHeaderCmd, false),
-		tcell.KeyCtrlG: ui.NewSharedKeyAction("toggleCrumbs", a.toggleCrumbsCmd, false),
-		ui.KeyHelp:     ui.NewSharedKeyAction("Help", a.helpCmd, false),
-		tcell.KeyCtrlA: ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
-		tcell.KeyEnter: ui.NewKeyAction("Goto", a.gotoCmd, false),
-		tcell.KeyCtrlC: ui.NewKeyAction("Quit", a.quitCmd, false),
+		ui.KeyShift9:   ui.NewSharedKeyAction("DumpGOR", a.dumpGOR, false).WithID("dumpGOR"),
+		tcell.KeyCtrlE: ui.NewSharedKeyAction("ToggleHeader", a.toggleHeaderCmd, false).WithID("toggleHeader"),
+		tcell.KeyCtrlG: ui.NewSharedKeyAction("toggleCrumbs", a.toggleCrumbsCmd, false).WithID("toggleCrumbs"),
+		ui.KeyHelp:     ui.NewSharedKeyAction("Help", a.helpCmd, false).WithID("help"),
+		tcell.KeyCtrlA: ui.NewSharedKeyAction("Aliases", a.aliasCmd, false).WithID("aliases"),
+		tcell.KeyCtrlO: ui.NewSharedKeyAction("Next Pane", a.nextPaneCmd, false).WithID("nextPane"),
+		tcell.KeyCtrlT: ui.NewSharedKeyAction("Next Tab", a.nextTabCmd, false).WithID("nextTab"),
+		tcell.KeyCtrlY: ui.NewSharedKeyAction("Palette", a.paletteCmd, false).WithID("palette"),
+		tcell.KeyEnter: ui.NewKeyAction("Goto", a.gotoCmd, false).WithID("goto"),
+		tcell.KeyCtrlC: ui.NewKeyAction("Quit", a.quitCmd, false).WithID("quit"),
 	}))
 }
 
@@ -446,12 +519,13 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 	a.Halt()
 	defer a.Resume()
 	{
+		saveSession(a)
 		a.Config.Reset()
 		ct, err := a.Config.K9s.ActivateContext(name)
 		if err != nil {
 			return err
 		}
-		if cns, ok := ci.NSArg(); ok {
+		if cns, ok := ci.NSArg(); ok && !client.IsMultiNamespace(cns) {
 			ct.Namespace.Active = cns
 		}
 
@@ -475,6 +549,7 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 			log.Debug().Msgf("Saved context config for: %q", name)
 		}
 		a.initFactory(ns)
+		a.refreshKeyMap()
 		if err := a.command.Reset(a.Config.ContextAliasesPath(), true); err != nil {
 			return err
 		}
@@ -482,7 +557,9 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 		log.Debug().Msgf("--> Switching Context %q -- %q -- %q", name, ns, a.Config.ActiveView())
 		a.Flash().Infof("Switching context to %q::%q", name, ns)
 		a.ReloadStyles()
-		a.gotoResource(a.Config.ActiveView(), "", true)
+		if !a.Config.K9s.RestoreSession || restoreSession(a) != nil {
+			a.gotoResource(a.Config.ActiveView(), "", true)
+		}
 		a.clusterModel.Reset(a.factory)
 	}
 
@@ -490,8 +567,13 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 }
 
 func (a *App) initFactory(ns string) {
+	a.alerter.Clear()
+	a.prom.reset()
 	a.factory.Terminate()
+	client.Diag.Reset()
+	a.factory.SetLargeResources(a.Config.K9s.LargeResources)
 	a.factory.Start(ns)
+	a.initUsageSampler()
 }
 
 // BailOut exists the application.
@@ -502,6 +584,7 @@ func (a *App) BailOut() {
 		}
 	}()
 
+	saveSession(a)
 	if err := a.Config.Save(true); err != nil {
 		log.Error().Err(err).Msg("config save failed!")
 	}
@@ -511,6 +594,8 @@ func (a *App) BailOut() {
 	}
 
 	a.stopImgScanner()
+	a.stopUsageSampler()
+	a.alerter.Clear()
 	a.factory.Terminate()
 	a.App.BailOut()
 }
@@ -628,6 +713,41 @@ func (a *App) toggleCrumbsCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (a *App) nextPaneCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.Prompt().InCmdMode() {
+		return evt
+	}
+	a.workspace.NextPane()
+
+	return nil
+}
+
+func (a *App) nextTabCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.Prompt().InCmdMode() {
+		return evt
+	}
+	a.workspace.NextTab()
+
+	return nil
+}
+
+// chordCmd waits for the next key of a chord.
+func (a *App) chordCmd(aa *ui.KeyActions) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		a.chord = aa
+		return nil
+	}
+}
+
+func (a *App) paletteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.Prompt().InCmdMode() {
+		return evt
+	}
+	showPalette(a)
+
+	return nil
+}
+
 func (a *App) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if a.CmdBuff().IsActive() && !a.CmdBuff().Empty() {
 		a.gotoResource(a.GetCmd(), "", true)
@@ -14,10 +14,12 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/prom"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
@@ -36,6 +38,8 @@ type Browser struct {
 	cancelFn   context.CancelFunc
 	mx         sync.RWMutex
 	updating   bool
+	fieldSel   string
+	localNS    string
 }
 
 // NewBrowser returns a new browser.
@@ -74,9 +78,14 @@ func (b *Browser) Init(ctx context.Context) error {
 		return err
 	}
 	ns := client.CleanseNamespace(b.app.Config.ActiveNamespace())
+	if b.localNS != "" {
+		ns = b.localNS
+	}
 	if dao.IsK8sMeta(b.meta) && b.app.ConOK() {
-		if _, e := b.app.factory.CanForResource(ns, b.GVR().String(), client.ListAccess); e != nil {
-			return e
+		for _, n := range client.Namespaces(ns) {
+			if _, e := b.app.factory.CanForResource(n, b.GVR().String(), client.ListAccess); e != nil {
+				return e
+			}
 		}
 	}
 	if b.App().IsRunning() {
@@ -133,9 +142,10 @@ func (b *Browser) suggestFilter() model.SuggestionFunc {
 
 func (b *Browser) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
-		tcell.KeyEscape: ui.NewSharedKeyAction("Filter Reset", b.resetCmd, false),
-		tcell.KeyEnter:  ui.NewSharedKeyAction("Filter", b.filterCmd, false),
-		tcell.KeyHelp:   ui.NewSharedKeyAction("Help", b.helpCmd, false),
+		tcell.KeyEscape: ui.NewSharedKeyAction("Filter Reset", b.resetCmd, false).WithID("filterReset"),
+		tcell.KeyEnter:  ui.NewSharedKeyAction("Filter", b.filterCmd, false).WithID("filter"),
+		tcell.KeyHelp:   ui.NewSharedKeyAction("Help", b.helpCmd, false).WithID("help"),
+		ui.KeyShiftQ:    ui.NewSharedKeyAction("Save Query", b.saveQueryCmd, false).WithID("saveQuery"),
 	})
 }
 
@@ -150,7 +160,7 @@ func (b *Browser) Start() {
 	if n := b.GetModel().GetNamespace(); !client.IsClusterScoped(n) {
 		ns = n
 	}
-	if err := b.app.switchNS(ns); err != nil {
+	if err := b.useNamespace(ns); err != nil {
 		log.Error().Err(err).Msgf("ns switch failed")
 	}
 
@@ -187,6 +197,16 @@ func (b *Browser) SetLabelFilter(labels map[string]string) {
 	b.GetModel().SetLabelFilter(toLabelsStr(labels))
 }
 
+// SetFieldSelector sets the resource field selector.
+func (b *Browser) SetFieldSelector(sel string) {
+	b.fieldSel = sel
+}
+
+// SetNamespace sets a namespace local to this view. The active namespace is left as is.
+func (b *Browser) SetNamespace(ns string) {
+	b.localNS = ns
+}
+
 // BufferChanged indicates the buffer was changed.
 func (b *Browser) BufferChanged(_, _ string) {}
 
@@ -268,6 +288,13 @@ func (b *Browser) Aliases() map[string]struct{} {
 	return aliasesFor(b.meta, b.app.command.AliasesFor(b.meta.Name))
 }
 
+func (b *Browser) keyScopes() map[string]struct{} {
+	ss := b.Aliases()
+	ss[config.TableKeyScope] = struct{}{}
+
+	return ss
+}
+
 // ----------------------------------------------------------------------------
 // Model Protocol...
 
@@ -406,6 +433,31 @@ func (b *Browser) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) saveQueryCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if b.CmdBuff().IsActive() {
+		return evt
+	}
+	q := config.Query{
+		Command: b.GVR().String(),
+		Filter:  b.CmdBuff().GetText(),
+	}
+	if ns := b.GetModel().GetNamespace(); b.meta.Namespaced && ns != "" {
+		q.Namespace = ns
+	}
+	if l := b.GetModel().GetLabelFilter(); l != "" && !internal.IsLabelSelector(q.Filter) {
+		q.Labels = l
+	}
+	if sc := b.GetTable().SortColumn(); sc.Name != "" {
+		q.SortColumn = sc.Name + ":asc"
+		if !sc.ASC {
+			q.SortColumn = sc.Name + ":desc"
+		}
+	}
+	showSaveQueryDialog(b.app, q)
+
+	return nil
+}
+
 func (b *Browser) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := b.GetSelectedItem()
 	if path == "" {
@@ -416,6 +468,16 @@ func (b *Browser) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) whoCanCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showWhoCan(b.app, b.GVR(), path)
+
+	return nil
+}
+
 func (b *Browser) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := b.GetSelectedItem()
 	if path == "" {
@@ -480,7 +542,10 @@ func (b *Browser) switchNamespaceCmd(evt *tcell.EventKey) *tcell.EventKey {
 		b.GetTable().SetSortCol("NAMESPACE", true)
 	}
 
-	if err := b.app.switchNS(ns); err != nil {
+	if b.localNS != "" {
+		b.localNS = ns
+	}
+	if err := b.useNamespace(ns); err != nil {
 		b.App().Flash().Err(err)
 		return nil
 	}
@@ -490,6 +555,9 @@ func (b *Browser) switchNamespaceCmd(evt *tcell.EventKey) *tcell.EventKey {
 	b.UpdateTitle()
 	b.SelectRow(1, 0, true)
 	b.app.CmdBuff().Reset()
+	if b.localNS != "" {
+		return nil
+	}
 	if err := b.app.Config.SetActiveNamespace(b.GetModel().GetNamespace()); err != nil {
 		log.Error().Err(err).Msg("Config save NS failed!")
 	}
@@ -497,6 +565,19 @@ func (b *Browser) switchNamespaceCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// useNamespace switches the active namespace unless this view namespace is local
+// in which case only the namespace informers are started.
+func (b *Browser) useNamespace(ns string) error {
+	if b.localNS == "" {
+		return b.app.switchNS(ns)
+	}
+	if client.IsClusterScoped(ns) {
+		ns = client.BlankNamespace
+	}
+
+	return b.app.factory.SetActiveNS(ns)
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -518,8 +599,21 @@ func (b *Browser) defaultContext() context.Context {
 	if internal.IsLabelSelector(b.CmdBuff().GetText()) {
 		ctx = context.WithValue(ctx, internal.KeyLabels, ui.TrimLabelSelector(b.CmdBuff().GetText()))
 	}
-	ctx = context.WithValue(ctx, internal.KeyNamespace, client.CleanseNamespace(b.App().Config.ActiveNamespace()))
+	if b.fieldSel != "" {
+		ctx = context.WithValue(ctx, internal.KeyFields, b.fieldSel)
+	}
+	ns := b.App().Config.ActiveNamespace()
+	if b.localNS != "" {
+		ns = b.localNS
+	}
+	ctx = context.WithValue(ctx, internal.KeyNamespace, client.CleanseNamespace(ns))
 	ctx = context.WithValue(ctx, internal.KeyWithMetrics, b.app.factory.Client().HasMetrics())
+	ctx = context.WithValue(ctx, internal.KeyViewConfig, b.app.CustomView)
+	if gvr := b.GVR().String(); b.app.promConfigured(gvr) {
+		ctx = context.WithValue(ctx, internal.KeyPrometheus, prom.MetricsFn(func() *prom.Metrics {
+			return b.app.prometheus(gvr)
+		}))
+	}
 
 	return ctx
 }
@@ -529,9 +623,9 @@ func (b *Browser) refreshActions() {
 		return
 	}
 	aa := ui.NewKeyActionsFromMap(ui.KeyMap{
-		ui.KeyC:        ui.NewKeyAction("Copy", b.cpCmd, false),
-		tcell.KeyEnter: ui.NewKeyAction("View", b.enterCmd, false),
-		tcell.KeyCtrlR: ui.NewKeyAction("Refresh", b.refreshCmd, false),
+		ui.KeyC:        ui.NewKeyAction("Copy", b.cpCmd, false).WithID("copy"),
+		tcell.KeyEnter: ui.NewKeyAction("View", b.enterCmd, false).WithID("view"),
+		tcell.KeyCtrlR: ui.NewKeyAction("Refresh", b.refreshCmd, false).WithID("refresh"),
 	})
 
 	if b.app.ConOK() {
@@ -542,26 +636,31 @@ func (b *Browser) refreshActions() {
 					ui.ActionOpts{
 						Visible:   true,
 						Dangerous: true,
-					}))
+					}).WithID("edit"))
 			}
 			if client.Can(b.meta.Verbs, "delete") {
 				aa.Add(tcell.KeyCtrlD, ui.NewKeyActionWithOpts("Delete", b.deleteCmd,
 					ui.ActionOpts{
 						Visible:   true,
 						Dangerous: true,
-					}))
+					}).WithID("delete"))
 			}
 		} else {
 			b.Actions().ClearDanger()
 		}
 	}
 	if !dao.IsK9sMeta(b.meta) {
-		aa.Add(ui.KeyY, ui.NewKeyAction(yamlAction, b.viewCmd, true))
-		aa.Add(ui.KeyD, ui.NewKeyAction("Describe", b.describeCmd, true))
+		aa.Add(ui.KeyY, ui.NewKeyAction(yamlAction, b.viewCmd, true).WithID("yaml"))
+		aa.Add(ui.KeyD, ui.NewKeyAction("Describe", b.describeCmd, true).WithID("describe"))
+	}
+	if dao.IsK8sMeta(b.meta) {
+		aa.Add(ui.KeyShiftH, ui.NewKeyAction("Who Can", b.whoCanCmd, true).WithID("whoCan"))
 	}
+	b.promActions(aa)
 	for _, f := range b.bindKeysFn {
 		f(aa)
 	}
+	resetKeyMap(b.Actions())
 	b.Actions().Merge(aa)
 
 	if err := pluginActions(b, b.Actions()); err != nil {
@@ -572,6 +671,14 @@ func (b *Browser) refreshActions() {
 		log.Warn().Msgf("Hotkeys load failed: %s", err)
 		b.app.Logo().Warn("HotKeys load failed!")
 	}
+	if err := queryActions(b, b.Actions()); err != nil {
+		log.Warn().Msgf("Queries load failed: %s", err)
+		b.app.Logo().Warn("Queries load failed!")
+	}
+	if err := keyMapActions(b.app, b.keyScopes(), b.Actions()); err != nil {
+		log.Warn().Msgf("Key bindings load failed: %s", err)
+		b.app.Logo().Warn("Key bindings load failed!")
+	}
 	b.app.Menu().HydrateMenu(b.Hints())
 }
 
@@ -579,7 +686,7 @@ func (b *Browser) namespaceActions(aa *ui.KeyActions) {
 	if !b.meta.Namespaced || b.GetTable().Path != "" {
 		return
 	}
-	aa.Add(ui.KeyN, ui.NewKeyAction("Copy Namespace", b.cpNsCmd, false))
+	aa.Add(ui.KeyN, ui.NewKeyAction("Copy Namespace", b.cpNsCmd, false).WithID("copyNamespace"))
 
 	b.namespaces = make(map[int]string, data.MaxFavoritesNS)
 	aa.Add(ui.Key0, ui.NewKeyAction(client.NamespaceAll, b.switchNamespaceCmd, true))
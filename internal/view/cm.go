@@ -29,7 +29,7 @@ func NewConfigMap(gvr client.GVR) ResourceViewer {
 }
 
 func (s *ConfigMap) bindKeys(aa *ui.KeyActions) {
-	aa.Add(ui.KeyU, ui.NewKeyAction("UsedBy", s.refCmd, true))
+	aa.Add(ui.KeyU, ui.NewKeyAction("UsedBy", s.refCmd, true).WithID("usedBy"))
 }
 
 func (s *ConfigMap) refCmd(evt *tcell.EventKey) *tcell.EventKey {
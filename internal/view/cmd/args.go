@@ -14,6 +14,7 @@ const (
 	fuzzyKey   = "fuzzy"
 	labelKey   = "labels"
 	contextKey = "context"
+	fieldKey   = "fields"
 )
 
 type args map[string]string
@@ -42,6 +43,18 @@ func newArgs(p *Interpreter, aa []string) args {
 		case strings.Index(a, filterFlag) == 0:
 			args[filterKey] = strings.ToLower(a[1:])
 
+		case strings.Index(a, fieldFlag) == 0:
+			if a == fieldFlag {
+				if i++; i < len(aa) {
+					args[fieldKey] = strings.TrimSpace(aa[i])
+				}
+			} else {
+				args[fieldKey] = strings.TrimPrefix(a[len(fieldFlag):], "=")
+			}
+
+		case IsFieldSelector(a):
+			args[fieldKey] = a
+
 		case strings.Contains(a, labelFlag):
 			if ll := ToLabels(a); len(ll) != 0 {
 				args[labelKey] = strings.ToLower(a)
@@ -51,11 +64,11 @@ func newArgs(p *Interpreter, aa []string) args {
 			switch {
 			case p.IsContextCmd():
 				args[contextKey] = a
-			case p.IsDirCmd():
+			case p.IsDirCmd(), p.IsLayoutCmd():
 				if _, ok := args[topicKey]; !ok {
 					args[topicKey] = a
 				}
-			case p.IsXrayCmd():
+			case p.IsXrayCmd(), p.IsSnapshotCmd():
 				if _, ok := args[topicKey]; ok {
 					args[nsKey] = strings.ToLower(a)
 				} else {
@@ -74,6 +87,7 @@ func (a args) hasFilters() bool {
 	_, fok := a[filterKey]
 	_, zok := a[fuzzyKey]
 	_, lok := a[labelKey]
+	_, sok := a[fieldKey]
 
-	return fok || zok || lok
+	return fok || zok || lok || sok
 }
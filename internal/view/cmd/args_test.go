@@ -69,6 +69,31 @@ func TestFlagsNew(t *testing.T) {
 			aa: []string{"app=fred,blee=duh"},
 			ll: args{labelKey: "app=fred,blee=duh"},
 		},
+		"multi-ns": {
+			i:  NewInterpreter("po"),
+			aa: []string{"ns1,ns2"},
+			ll: args{nsKey: "ns1,ns2"},
+		},
+		"field": {
+			i:  NewInterpreter("po"),
+			aa: []string{"spec.nodeName=n1,status.phase!=Running"},
+			ll: args{fieldKey: "spec.nodeName=n1,status.phase!=Running"},
+		},
+		"field-flag": {
+			i:  NewInterpreter("po"),
+			aa: []string{"--field-selector", "metadata.name=fred", "ns1"},
+			ll: args{fieldKey: "metadata.name=fred", nsKey: "ns1"},
+		},
+		"field-flag-eq": {
+			i:  NewInterpreter("po"),
+			aa: []string{"--field-selector=status.phase=Running"},
+			ll: args{fieldKey: "status.phase=Running"},
+		},
+		"field+label": {
+			i:  NewInterpreter("po"),
+			aa: []string{"app=fred", "spec.nodeName=n1"},
+			ll: args{labelKey: "app=fred", fieldKey: "spec.nodeName=n1"},
+		},
 		"label+ns": {
 			i:  NewInterpreter("po"),
 			aa: []string{"a=b,c=d", "  ns1  "},
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/fields"
 )
 
 func ToLabels(s string) map[string]string {
@@ -29,6 +30,23 @@ func ToLabels(s string) map[string]string {
 	return lbls
 }
 
+// IsFieldSelector checks if s is a field selector ie spec.nodeName=n1,status.phase!=Running.
+func IsFieldSelector(s string) bool {
+	sel, err := fields.ParseSelector(s)
+	if err != nil || sel.Empty() {
+		return false
+	}
+	for _, r := range sel.Requirements() {
+		if !slices.ContainsFunc(fieldRoots, func(root string) bool {
+			return strings.HasPrefix(r.Field, root)
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // ShouldAddSuggest checks if a suggestion match the given command.
 func ShouldAddSuggest(command, suggest string) (string, bool) {
 	if command != suggest && strings.HasPrefix(suggest, command) {
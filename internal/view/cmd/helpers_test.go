@@ -58,6 +58,39 @@ func Test_toLabels(t *testing.T) {
 	}
 }
 
+func TestIsFieldSelector(t *testing.T) {
+	uu := map[string]struct {
+		s string
+		e bool
+	}{
+		"empty": {},
+		"node": {
+			s: "spec.nodeName=n1",
+			e: true,
+		},
+		"multi": {
+			s: "spec.nodeName=n1,status.phase!=Running",
+			e: true,
+		},
+		"label": {
+			s: "app=fred",
+		},
+		"mixed": {
+			s: "spec.nodeName=n1,app=fred",
+		},
+		"ns": {
+			s: "ns1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, IsFieldSelector(u.s))
+		})
+	}
+}
+
 func TestSuggestSubCommand(t *testing.T) {
 	namespaceNames := map[string]struct{}{
 		"kube-system":   {},
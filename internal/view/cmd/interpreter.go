@@ -127,11 +127,58 @@ func (c *Interpreter) IsDirCmd() bool {
 	return ok
 }
 
+// IsSplitCmd returns true if split cmd is detected.
+func (c *Interpreter) IsSplitCmd() bool {
+	_, ok := splitCmd[c.cmd]
+	return ok
+}
+
+// IsVSplitCmd returns true if vertical split cmd is detected.
+func (c *Interpreter) IsVSplitCmd() bool {
+	return c.cmd == vsplitCmd
+}
+
+// IsTabCmd returns true if new tab cmd is detected.
+func (c *Interpreter) IsTabCmd() bool {
+	_, ok := tabCmd[c.cmd]
+	return ok
+}
+
+// IsCloseCmd returns true if close pane cmd is detected.
+func (c *Interpreter) IsCloseCmd() bool {
+	return c.cmd == closeCmd
+}
+
+// IsLayoutCmd returns true if layout cmd is detected.
+func (c *Interpreter) IsLayoutCmd() bool {
+	return c.cmd == layoutCmd
+}
+
+// IsWatchCmd returns true if watch alerts cmd is detected.
+func (c *Interpreter) IsWatchCmd() bool {
+	return c.cmd == watchCmd
+}
+
+// IsUnwatchCmd returns true if clear watch alerts cmd is detected.
+func (c *Interpreter) IsUnwatchCmd() bool {
+	return c.cmd == unwatchCmd
+}
+
+// IsSnapshotCmd returns true if snapshot capture cmd is detected.
+func (c *Interpreter) IsSnapshotCmd() bool {
+	return c.cmd == snapshotCmd
+}
+
 // IsRBACCmd returns true if rbac cmd is detected.
 func (c *Interpreter) IsRBACCmd() bool {
 	return c.cmd == canCmd
 }
 
+// IsWhoCanCmd returns true if reverse rbac cmd is detected.
+func (c *Interpreter) IsWhoCanCmd() bool {
+	return c.cmd == whoCanCmd
+}
+
 // ContextArg returns context cmd arg.
 func (c *Interpreter) ContextArg() (string, bool) {
 	if !c.IsContextCmd() {
@@ -156,6 +203,35 @@ func (c *Interpreter) DirArg() (string, bool) {
 	return d, ok && d != ""
 }
 
+// PaneArg returns the command to run in a new pane if any.
+func (c *Interpreter) PaneArg() (string, bool) {
+	if !c.IsSplitCmd() && !c.IsVSplitCmd() && !c.IsTabCmd() {
+		return "", false
+	}
+	_, line, _ := strings.Cut(strings.TrimSpace(c.line), " ")
+
+	return strings.TrimSpace(line), true
+}
+
+// LayoutArg returns the layout action if any.
+func (c *Interpreter) LayoutArg() (string, bool) {
+	if !c.IsLayoutCmd() {
+		return "", false
+	}
+
+	return c.args[topicKey], true
+}
+
+// WatchArg returns the resources query to watch if any.
+func (c *Interpreter) WatchArg() (string, bool) {
+	if !c.IsWatchCmd() {
+		return "", false
+	}
+	_, line, _ := strings.Cut(strings.TrimSpace(c.line), " ")
+
+	return strings.TrimSpace(line), true
+}
+
 // CowArg returns the cow message.
 func (c *Interpreter) CowArg() (string, bool) {
 	if !c.IsCowCmd() {
@@ -179,6 +255,19 @@ func (c *Interpreter) RBACArgs() (string, string, bool) {
 	return tt[1], tt[2], true
 }
 
+// WhoCanArgs returns the verb, resource and namespace if any.
+func (c *Interpreter) WhoCanArgs() (string, string, string, bool) {
+	if !c.IsWhoCanCmd() {
+		return "", "", "", false
+	}
+	tt := whoCanRX.FindStringSubmatch(strings.TrimSpace(c.line))
+	if len(tt) < 4 {
+		return "", "", "", false
+	}
+
+	return strings.ToLower(tt[1]), strings.ToLower(tt[2]), tt[3], true
+}
+
 // XRayArgs return the gvr and ns if any.
 func (c *Interpreter) XrayArgs() (string, string, bool) {
 	if !c.IsXrayCmd() {
@@ -200,6 +289,24 @@ func (c *Interpreter) XrayArgs() (string, string, bool) {
 	}
 }
 
+// SnapshotArgs returns the resources and namespace to capture if any.
+// No resources or * captures all resources.
+func (c *Interpreter) SnapshotArgs() ([]string, string, bool) {
+	if !c.IsSnapshotCmd() {
+		return nil, "", false
+	}
+	var rr []string
+	if t := c.args[topicKey]; t != "" && t != allRes {
+		for _, r := range strings.Split(t, ",") {
+			if r = strings.TrimSpace(r); r != "" {
+				rr = append(rr, r)
+			}
+		}
+	}
+
+	return rr, c.args[nsKey], true
+}
+
 // FilterArg returns the current filter if any.
 func (c *Interpreter) FilterArg() (string, bool) {
 	f, ok := c.args[filterKey]
@@ -228,6 +335,13 @@ func (c *Interpreter) HasContext() (string, bool) {
 	return ctx, ok && ctx != ""
 }
 
+// FieldsArg returns the field selector if any.
+func (c *Interpreter) FieldsArg() (string, bool) {
+	f, ok := c.args[fieldKey]
+
+	return f, ok && f != ""
+}
+
 // LabelsArg return the labels map if any.
 func (c *Interpreter) LabelsArg() (map[string]string, bool) {
 	ll, ok := c.args[labelKey]
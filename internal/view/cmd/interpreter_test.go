@@ -260,6 +260,163 @@ func TestDirCmd(t *testing.T) {
 	}
 }
 
+func TestPaneCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd  string
+		ok   bool
+		line string
+	}{
+		"empty": {},
+		"split": {
+			cmd: "split",
+			ok:  true,
+		},
+		"split-cmd": {
+			cmd:  "split pods ns1 app=fred",
+			ok:   true,
+			line: "pods ns1 app=fred",
+		},
+		"vsplit": {
+			cmd:  "vsplit events",
+			ok:   true,
+			line: "events",
+		},
+		"tab": {
+			cmd:  "tabnew  deploy ",
+			ok:   true,
+			line: "deploy",
+		},
+		"toast": {
+			cmd: "pods ns1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			line, ok := p.PaneArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.line, line)
+		})
+	}
+}
+
+func TestLayoutCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+		op  string
+	}{
+		"empty": {},
+		"load": {
+			cmd: "layout",
+			ok:  true,
+		},
+		"save": {
+			cmd: "layout save",
+			ok:  true,
+			op:  "save",
+		},
+		"toast": {
+			cmd: "layouts save",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			op, ok := p.LayoutArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.op, op)
+		})
+	}
+}
+
+func TestWatchCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd     string
+		ok      bool
+		unwatch bool
+		line    string
+	}{
+		"empty": {},
+		"selection": {
+			cmd: "watch",
+			ok:  true,
+		},
+		"query": {
+			cmd:  "watch  pods ns1 app=fred ",
+			ok:   true,
+			line: "pods ns1 app=fred",
+		},
+		"unwatch": {
+			cmd:     "unwatch",
+			unwatch: true,
+		},
+		"toast": {
+			cmd: "watches pods",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			line, ok := p.WatchArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.line, line)
+			assert.Equal(t, u.unwatch, p.IsUnwatchCmd())
+		})
+	}
+}
+
+func TestSnapshotCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+		rr  []string
+		ns  string
+	}{
+		"empty": {},
+		"all": {
+			cmd: "snapshot",
+			ok:  true,
+		},
+		"all-ns": {
+			cmd: "snapshot * ns1",
+			ok:  true,
+			ns:  "ns1",
+		},
+		"resources": {
+			cmd: "snapshot pods,deploy,",
+			ok:  true,
+			rr:  []string{"pods", "deploy"},
+		},
+		"resources-ns": {
+			cmd: "snapshot pods,Deploy ns1",
+			ok:  true,
+			rr:  []string{"pods", "deploy"},
+			ns:  "ns1",
+		},
+		"toast": {
+			cmd: "snapshots pods",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			rr, ns, ok := p.SnapshotArgs()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.rr, rr)
+			assert.Equal(t, u.ns, ns)
+		})
+	}
+}
+
 func TestRBACCmd(t *testing.T) {
 	uu := map[string]struct {
 		cmd      string
@@ -310,6 +467,61 @@ func TestRBACCmd(t *testing.T) {
 	}
 }
 
+func TestWhoCanCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd           string
+		ok            bool
+		verb, res, ns string
+	}{
+		"empty": {},
+		"toast": {
+			cmd: "whocan",
+		},
+		"toast-1": {
+			cmd: "whocan delete",
+		},
+		"toast-2": {
+			cmd: "whocanopy delete secrets",
+		},
+		"toast-3": {
+			cmd: "whocan delete secrets -n",
+		},
+		"cluster": {
+			cmd:  "whocan delete secrets",
+			ok:   true,
+			verb: "delete",
+			res:  "secrets",
+		},
+		"namespaced": {
+			cmd:  "whocan Delete secrets -n prod",
+			ok:   true,
+			verb: "delete",
+			res:  "secrets",
+			ns:   "prod",
+		},
+		"wildcard": {
+			cmd:  "whocan * apps/v1/deployments",
+			ok:   true,
+			verb: "*",
+			res:  "apps/v1/deployments",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			verb, res, ns, ok := p.WhoCanArgs()
+			assert.Equal(t, u.ok, ok)
+			if u.ok {
+				assert.Equal(t, u.verb, verb)
+				assert.Equal(t, u.res, res)
+				assert.Equal(t, u.ns, ns)
+			}
+		})
+	}
+}
+
 func TestContextCmd(t *testing.T) {
 	uu := map[string]struct {
 		cmd string
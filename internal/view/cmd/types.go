@@ -8,15 +8,28 @@ import "regexp"
 const (
 	cowCmd      = "cow"
 	canCmd      = "can"
+	whoCanCmd   = "whocan"
+	vsplitCmd   = "vsplit"
+	closeCmd    = "close"
+	layoutCmd   = "layout"
+	watchCmd    = "watch"
+	unwatchCmd  = "unwatch"
+	snapshotCmd = "snapshot"
+	allRes      = "*"
 	nsFlag      = "-n"
 	filterFlag  = "/"
 	labelFlag   = "="
 	fuzzyFlag   = "-f"
 	contextFlag = "@"
+	fieldFlag   = "--field-selector"
 )
 
 var (
-	rbacRX = regexp.MustCompile(`^can\s+([u|g|s]):\s*([\w-:]+)\s*$`)
+	// fieldRoots tracks resource fields prefixes denoting a field selector.
+	fieldRoots = []string{"metadata.", "spec.", "status.", "involvedObject."}
+
+	rbacRX   = regexp.MustCompile(`^can\s+([u|g|s]):\s*([\w-:]+)\s*$`)
+	whoCanRX = regexp.MustCompile(`^whocan\s+([\w*]+)\s+([\w.*/-]+)(?:\s+-n\s+([\w-]+))?\s*$`)
 
 	contextCmd = map[string]struct{}{
 		"ctx":      {},
@@ -50,6 +63,14 @@ var (
 		"a":     {},
 		"alias": {},
 	}
+	splitCmd = map[string]struct{}{
+		"split":  {},
+		"hsplit": {},
+	}
+	tabCmd = map[string]struct{}{
+		"tab":    {},
+		"tabnew": {},
+	}
 	xrayCmd = map[string]struct{}{
 		"x":    {},
 		"xr":   {},
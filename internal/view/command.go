@@ -12,6 +12,7 @@ import (
 	"sync"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/view/cmd"
@@ -140,6 +141,9 @@ func (c *Command) xrayCmd(p *cmd.Interpreter) error {
 	if cns != "" {
 		ns = cns
 	}
+	if client.IsMultiNamespace(ns) {
+		return fmt.Errorf("xray does not support multiple namespaces %q", ns)
+	}
 	if err := c.app.Config.SetActiveNamespace(client.CleanseNamespace(ns)); err != nil {
 		return err
 	}
@@ -150,8 +154,66 @@ func (c *Command) xrayCmd(p *cmd.Interpreter) error {
 	return c.exec(p, client.NewGVR("xrays"), NewXray(gvr), true)
 }
 
+func (c *Command) whoCanCmd(p *cmd.Interpreter) error {
+	verb, res, ns, ok := p.WhoCanArgs()
+	if !ok {
+		return errors.New("invalid command. use `whocan verb resource [-n namespace]`")
+	}
+	gvr, _, ok := c.alias.AsGVR(res)
+	if !ok {
+		return fmt.Errorf("invalid resource name: %q", res)
+	}
+
+	return c.app.inject(NewWhoCan(verb, gvr, "", ns), true)
+}
+
+func (c *Command) paneCmd(p *cmd.Interpreter) error {
+	line, ok := p.PaneArg()
+	if !ok {
+		return errors.New("invalid command. use `split|vsplit|tab [cmd]`")
+	}
+	switch {
+	case p.IsTabCmd():
+		return c.app.workspace.NewTab(line)
+	case p.IsVSplitCmd():
+		return c.app.workspace.Split(config.SplitVertical, line)
+	default:
+		return c.app.workspace.Split(config.SplitHorizontal, line)
+	}
+}
+
+func (c *Command) layoutCmd(p *cmd.Interpreter) error {
+	op, ok := p.LayoutArg()
+	if !ok {
+		return errors.New("invalid command. use `layout [save|load]`")
+	}
+	path := c.app.Config.ContextLayoutPath()
+	switch op {
+	case "save":
+		if err := c.app.workspace.Layout().Save(path); err != nil {
+			return err
+		}
+		c.app.Flash().Infof("Layout saved to %s", path)
+		return nil
+	case "", "load":
+		l := config.NewLayout()
+		if err := l.Load(path); err != nil {
+			return err
+		}
+		return c.app.workspace.Restore(l)
+	default:
+		return fmt.Errorf("invalid layout action %q. use `layout [save|load]`", op)
+	}
+}
+
 // Run execs the command by showing associated display.
 func (c *Command) run(p *cmd.Interpreter, fqn string, clearStack bool) error {
+	return c.runIn(p, fqn, clearStack, false)
+}
+
+// runIn execs the command. A local namespace stays with the view rather than
+// becoming the active namespace.
+func (c *Command) runIn(p *cmd.Interpreter, fqn string, clearStack, localNS bool) error {
 	if c.specialCmd(p) {
 		return nil
 	}
@@ -164,8 +226,12 @@ func (c *Command) run(p *cmd.Interpreter, fqn string, clearStack bool) error {
 	if cns, ok := p.NSArg(); ok {
 		ns = cns
 	}
-	if err := c.app.switchNS(ns); err != nil {
-		return err
+	// Multiple namespaces are local to the view and never become the active namespace.
+	localNS = localNS || client.IsMultiNamespace(ns)
+	if !localNS {
+		if err := c.app.switchNS(ns); err != nil {
+			return err
+		}
 	}
 
 	if context, ok := p.HasContext(); ok {
@@ -196,6 +262,10 @@ func (c *Command) run(p *cmd.Interpreter, fqn string, clearStack bool) error {
 	co := c.componentFor(gvr, fqn, v)
 	co.SetFilter("")
 	co.SetLabelFilter(nil)
+	co.SetFieldSelector("")
+	if localNS {
+		co.SetNamespace(ns)
+	}
 	if f, ok := p.FilterArg(); ok {
 		co.SetFilter(f)
 	}
@@ -205,6 +275,9 @@ func (c *Command) run(p *cmd.Interpreter, fqn string, clearStack bool) error {
 	if ll, ok := p.LabelsArg(); ok {
 		co.SetLabelFilter(ll)
 	}
+	if f, ok := p.FieldsArg(); ok {
+		co.SetFieldSelector(f)
+	}
 
 	return c.exec(p, gvr, co, clearStack)
 }
@@ -214,6 +287,14 @@ func (c *Command) defaultCmd() error {
 		return c.run(cmd.NewInterpreter("context"), "", true)
 	}
 
+	if c.app.Config.K9s.RestoreSession && !c.app.Config.K9s.HasManualCommand() {
+		err := restoreSession(c.app)
+		if err == nil {
+			return nil
+		}
+		log.Debug().Err(err).Msg("Session restore skipped")
+	}
+
 	p := cmd.NewInterpreter(c.app.Config.ActiveView())
 	if p.IsBlank() {
 		return c.run(p.Reset("pod"), "", true)
@@ -253,10 +334,37 @@ func (c *Command) specialCmd(p *cmd.Interpreter) bool {
 		} else if err := c.app.inject(NewPolicy(c.app, cat, sub), true); err != nil {
 			c.app.Flash().Err(err)
 		}
+	case p.IsWhoCanCmd():
+		if err := c.whoCanCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
 	case p.IsContextCmd():
 		if err := c.contextCmd(p); err != nil {
 			c.app.Flash().Err(err)
 		}
+	case p.IsSplitCmd(), p.IsVSplitCmd(), p.IsTabCmd():
+		if err := c.paneCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsCloseCmd():
+		if err := c.app.workspace.Close(); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsLayoutCmd():
+		if err := c.layoutCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsWatchCmd():
+		if err := c.watchCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsUnwatchCmd():
+		c.app.alerter.Clear()
+		c.app.Flash().Info("Alert watches cleared")
+	case p.IsSnapshotCmd():
+		if err := c.snapshotCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
 	case p.IsNamespaceCmd():
 		return c.namespaceCmd(p)
 	case p.IsDirCmd():
@@ -333,12 +441,14 @@ func (c *Command) exec(p *cmd.Interpreter, gvr client.GVR, comp model.Component,
 	if clearStack {
 		cmd := contextRX.ReplaceAllString(p.GetLine(), "")
 		c.app.Config.SetActiveView(cmd)
+		c.app.Content.cmd = cmd
 	}
 	if err := c.app.inject(comp, clearStack); err != nil {
 		return err
 	}
 
 	c.app.cmdHistory.Push(p.GetLine())
+	c.app.workspace.refreshTabs()
 
 	return
 }
@@ -68,14 +68,14 @@ func (c *Container) bindDangerousKeys(aa *ui.KeyActions) {
 			ui.ActionOpts{
 				Visible:   true,
 				Dangerous: true,
-			}),
+			}).WithID("shell"),
 		ui.KeyA: ui.NewKeyActionWithOpts(
 			"Attach",
 			c.attachCmd,
 			ui.ActionOpts{
 				Visible:   true,
 				Dangerous: true,
-			}),
+			}).WithID("attach"),
 	})
 }
 
@@ -87,9 +87,10 @@ func (c *Container) bindKeys(aa *ui.KeyActions) {
 	}
 
 	aa.Bulk(ui.KeyMap{
-		ui.KeyF:      ui.NewKeyAction("Show PortForward", c.showPFCmd, true),
-		ui.KeyShiftF: ui.NewKeyAction("PortForward", c.portFwdCmd, true),
-		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", c.GetTable().SortColCmd("RESTARTS", false), false),
+		ui.KeyM:      ui.NewKeyAction("Metrics", c.metricsCmd, true).WithID("metrics"),
+		ui.KeyF:      ui.NewKeyAction("Show PortForward", c.showPFCmd, true).WithID("showPortForward"),
+		ui.KeyShiftF: ui.NewKeyAction("PortForward", c.portFwdCmd, true).WithID("portForward"),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", c.GetTable().SortColCmd("RESTARTS", false), false).WithID("sortRestart"),
 	})
 	aa.Merge(resourceSorters(c.GetTable()))
 }
@@ -129,6 +130,22 @@ func (c *Container) viewLogs(app *App, model ui.Tabular, gvr client.GVR, path st
 
 // Handlers...
 
+func (c *Container) metricsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if !c.App().Conn().HasMetrics() {
+		c.App().Flash().Err(errors.New("no metrics-server detected"))
+		return nil
+	}
+	if err := c.App().inject(NewContainerCharts(c.App(), c.GetTable().Path, path), false); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (c *Container) showPFCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := c.GetTable().GetSelectedItem()
 	if path == "" {
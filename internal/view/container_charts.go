@@ -0,0 +1,266 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	containerChartsTitle = "Container Metrics"
+	requestLineColor     = "lightskyblue"
+	limitLineColor       = "orangered"
+	nearLimitPerc        = 90
+)
+
+// ContainerCharts represents a container usage history view.
+type ContainerCharts struct {
+	*tview.Flex
+
+	app         *App
+	fqn, co     string
+	cpu, mem    *tchart.SparkLine
+	status      *tview.TextView
+	actions     *ui.KeyActions
+	cancelFn    context.CancelFunc
+	last        time.Time
+	restarts    int32
+	req, lim    v1.ResourceList
+	lastCPU     int64
+	lastMEM     int64
+	lastReason  string
+	initialized bool
+}
+
+// NewContainerCharts returns a new container usage history view.
+func NewContainerCharts(app *App, fqn, co string) *ContainerCharts {
+	return &ContainerCharts{
+		Flex:    tview.NewFlex().SetDirection(tview.FlexRow),
+		app:     app,
+		fqn:     fqn,
+		co:      co,
+		cpu:     tchart.NewSparkLine("cpu"),
+		mem:     tchart.NewSparkLine("mem"),
+		status:  tview.NewTextView(),
+		actions: ui.NewKeyActions(),
+	}
+}
+
+func (c *ContainerCharts) SetFilter(string)                 {}
+func (c *ContainerCharts) SetLabelFilter(map[string]string) {}
+
+// Init initializes the view.
+func (c *ContainerCharts) Init(_ context.Context) error {
+	c.SetBorder(true)
+	c.SetBorderPadding(0, 0, 1, 1)
+	c.SetTitle(fmt.Sprintf(" %s(%s:%s) ", containerChartsTitle, c.fqn, c.co))
+	c.SetTitleColor(tcell.ColorAqua)
+	c.status.SetDynamicColors(true)
+	for _, s := range []*tchart.SparkLine{c.cpu, c.mem} {
+		s.SetMultiSeries(false)
+		s.SetBorderPadding(1, 1, 0, 1)
+		s.SetInputCapture(c.keyboard)
+	}
+	c.AddItem(c.cpu, 0, 1, true)
+	c.AddItem(c.mem, 0, 1, false)
+	c.AddItem(c.status, 1, 0, false)
+
+	c.bindKeys()
+	c.SetInputCapture(c.keyboard)
+	c.app.Styles.AddListener(c)
+	c.StylesChanged(c.app.Styles)
+
+	var co dao.Container
+	co.Init(c.app.factory, client.NewGVR("containers"))
+	c.addSamples(co.History(c.fqn, c.co))
+
+	return nil
+}
+
+// InCmdMode checks if prompt is active.
+func (*ContainerCharts) InCmdMode() bool {
+	return false
+}
+
+// StylesChanged notifies the skin changed.
+func (c *ContainerCharts) StylesChanged(s *config.Styles) {
+	c.SetBackgroundColor(s.Charts().BgColor.Color())
+	c.status.SetBackgroundColor(s.Charts().BgColor.Color())
+	c.status.SetTextColor(s.Body().FgColor.Color())
+	for _, sp := range []*tchart.SparkLine{c.cpu, c.mem} {
+		sp.SetBackgroundColor(s.Charts().BgColor.Color())
+		sp.SetFocusColorNames(s.Table().BgColor.String(), s.Table().CursorBgColor.String())
+		if cc, ok := s.Charts().ResourceColors[sp.ID()]; ok {
+			sp.SetSeriesColors(cc.Colors()...)
+		} else {
+			sp.SetSeriesColors(s.Charts().DefaultChartColors.Colors()...)
+		}
+	}
+}
+
+func (c *ContainerCharts) bindKeys() {
+	c.actions.Merge(ui.NewKeyActionsFromMap(ui.KeyMap{
+		tcell.KeyEscape: ui.NewKeyAction("Back", c.app.PrevCmd, false).WithID("back"),
+	}))
+}
+
+func (c *ContainerCharts) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := c.actions.Get(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+// Start starts the sampling loop.
+func (c *ContainerCharts) Start() {
+	c.Stop()
+
+	var ctx context.Context
+	ctx, c.cancelFn = context.WithCancel(context.Background())
+	go c.sample(ctx)
+}
+
+// Stop terminates the sampling loop.
+func (c *ContainerCharts) Stop() {
+	if c.cancelFn == nil {
+		return
+	}
+	c.cancelFn()
+	c.cancelFn = nil
+}
+
+// Name returns the component name.
+func (c *ContainerCharts) Name() string { return containerChartsTitle }
+
+// Hints returns the view hints.
+func (c *ContainerCharts) Hints() model.MenuHints {
+	return c.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (c *ContainerCharts) ExtraHints() map[string]string {
+	return nil
+}
+
+func (c *ContainerCharts) sample(ctx context.Context) {
+	var co dao.Container
+	co.Init(c.app.factory, client.NewGVR("containers"))
+	for {
+		po, err := co.Sample(ctx, c.fqn)
+		if err != nil {
+			c.app.QueueUpdateDraw(func() {
+				c.app.Flash().Err(err)
+			})
+		}
+		ss := co.History(c.fqn, c.co)
+		c.app.QueueUpdateDraw(func() {
+			if po != nil {
+				c.updateSpec(po)
+			}
+			c.addSamples(ss)
+		})
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(c.app.Config.K9s.GetRefreshRate()) * time.Second):
+		}
+	}
+}
+
+func (c *ContainerCharts) updateSpec(po *v1.Pod) {
+	for _, co := range append(po.Spec.InitContainers, po.Spec.Containers...) {
+		if co.Name == c.co {
+			c.req, c.lim = co.Resources.Requests, co.Resources.Limits
+			break
+		}
+	}
+	c.cpu.SetThresholds(
+		tchart.Threshold{Value: c.req.Cpu().MilliValue(), Color: tcell.GetColor(requestLineColor)},
+		tchart.Threshold{Value: c.lim.Cpu().MilliValue(), Color: tcell.GetColor(limitLineColor)},
+	)
+	c.mem.SetThresholds(
+		tchart.Threshold{Value: client.ToMB(c.req.Memory().Value()), Color: tcell.GetColor(requestLineColor)},
+		tchart.Threshold{Value: client.ToMB(c.lim.Memory().Value()), Color: tcell.GetColor(limitLineColor)},
+	)
+
+	c.lastReason = ""
+	for _, st := range append(po.Status.InitContainerStatuses, po.Status.ContainerStatuses...) {
+		if st.Name == c.co && st.LastTerminationState.Terminated != nil {
+			t := st.LastTerminationState.Terminated
+			c.lastReason = fmt.Sprintf("%s (exit %d) %s ago", t.Reason, t.ExitCode, time.Since(t.FinishedAt.Time).Truncate(time.Second))
+			break
+		}
+	}
+}
+
+func (c *ContainerCharts) addSamples(ss []dao.ContainerSample) {
+	for _, s := range ss {
+		if !s.At.After(c.last) {
+			continue
+		}
+		c.last = s.At
+		c.cpu.Add(tchart.Metric{S1: s.CPU})
+		c.mem.Add(tchart.Metric{S1: client.ToMB(s.MEM)})
+		if c.initialized && s.Restarts > c.restarts {
+			c.cpu.Mark()
+			c.mem.Mark()
+		}
+		c.restarts, c.initialized = s.Restarts, true
+		c.lastCPU, c.lastMEM = s.CPU, s.MEM
+	}
+	c.refreshLegends()
+}
+
+func (c *ContainerCharts) refreshLegends() {
+	c.cpu.SetLegend(fmt.Sprintf(" CPU %dm (req %s / lim %s) ",
+		c.lastCPU,
+		quantityOrNA(c.req, v1.ResourceCPU),
+		quantityOrNA(c.lim, v1.ResourceCPU),
+	))
+	c.mem.SetLegend(fmt.Sprintf(" MEM %dMi (req %s / lim %s) ",
+		client.ToMB(c.lastMEM),
+		quantityOrNA(c.req, v1.ResourceMemory),
+		quantityOrNA(c.lim, v1.ResourceMemory),
+	))
+
+	ss := []string{fmt.Sprintf("[%s::b]┄[-::-] request  [%s::b]┄[-::-] limit  [orangered::b]▼[-::-] restart", requestLineColor, limitLineColor)}
+	ss = append(ss, fmt.Sprintf("restarts: %d", c.restarts))
+	if c.lastReason != "" {
+		ss = append(ss, "last termination: "+c.lastReason)
+	}
+	if lim := c.lim.Cpu().MilliValue(); lim > 0 && client.ToPercentage(c.lastCPU, lim) >= nearLimitPerc {
+		ss = append(ss, "[orangered::b]CPU near limit (throttling likely)[-::-]")
+	}
+	if lim := c.lim.Memory().Value(); lim > 0 && client.ToPercentage(c.lastMEM, lim) >= nearLimitPerc {
+		ss = append(ss, "[orangered::b]MEM near limit (OOM risk)[-::-]")
+	}
+	c.status.SetText(strings.Join(ss, " | "))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func quantityOrNA(rl v1.ResourceList, n v1.ResourceName) string {
+	q, ok := rl[n]
+	if !ok {
+		return "n/a"
+	}
+
+	return q.String()
+}
@@ -39,7 +39,7 @@ func NewContext(gvr client.GVR) ResourceViewer {
 
 func (c *Context) bindKeys(aa *ui.KeyActions) {
 	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
-	aa.Add(ui.KeyR, ui.NewKeyAction("Rename", c.renameCmd, true))
+	aa.Add(ui.KeyR, ui.NewKeyAction("Rename", c.renameCmd, true).WithID("rename"))
 }
 
 func (c *Context) renameCmd(evt *tcell.EventKey) *tcell.EventKey {
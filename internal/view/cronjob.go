@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -20,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
 )
 
 const (
@@ -73,12 +75,38 @@ func jobCtx(path, uid string) ContextFunc {
 
 func (c *CronJob) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
-		ui.KeyT:      ui.NewKeyAction("Trigger", c.triggerCmd, true),
-		ui.KeyS:      ui.NewKeyAction("Suspend/Resume", c.toggleSuspendCmd, true),
-		ui.KeyShiftL: ui.NewKeyAction("Sort LastScheduled", c.GetTable().SortColCmd(lastScheduledCol, true), false),
+		ui.KeyT:      ui.NewKeyAction("Trigger", c.triggerCmd, true).WithID("trigger"),
+		ui.KeyS:      ui.NewKeyAction("Suspend/Resume", c.toggleSuspendCmd, true).WithID("suspendResume"),
+		ui.KeyH:      ui.NewKeyAction("History", c.historyCmd, true).WithID("history"),
+		ui.KeyShiftL: ui.NewKeyAction("Sort LastScheduled", c.GetTable().SortColCmd(lastScheduledCol, true), false).WithID("sortLastScheduled"),
 	})
 }
 
+func (c *CronJob) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	var cj dao.CronJob
+	cj.Init(c.App().factory, c.GVR())
+	o, rr, err := cj.History(path)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	raw, err := yaml.Marshal(newCronJobHistory(o, rr, time.Now()))
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	if err := c.App().inject(NewDetails(c.App(), "History", path, contentYAML, true).Update(string(raw)), false); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (c *CronJob) triggerCmd(evt *tcell.EventKey) *tcell.EventKey {
 	fqn := c.GetTable().GetSelectedItem()
 	if fqn == "" {
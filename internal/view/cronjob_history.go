@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/tchart"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const cronNextRuns = 5
+
+type cronJobHistory struct {
+	Schedule  string         `json:"schedule"`
+	TimeZone  string         `json:"timeZone"`
+	Suspended bool           `json:"suspended"`
+	NextRuns  []string       `json:"nextRuns"`
+	Stats     cronJobStats   `json:"stats"`
+	Durations string         `json:"durations"`
+	Runs      []cronJobEntry `json:"runs"`
+}
+
+type cronJobStats struct {
+	Runs        int    `json:"runs"`
+	Succeeded   int    `json:"succeeded"`
+	Failed      int    `json:"failed"`
+	SuccessRate string `json:"successRate"`
+	AvgDuration string `json:"avgDuration"`
+	LastSuccess string `json:"lastSuccess"`
+	LastFailure string `json:"lastFailure"`
+}
+
+type cronJobEntry struct {
+	Job      string `json:"job"`
+	Status   string `json:"status"`
+	Started  string `json:"started"`
+	Duration string `json:"duration"`
+}
+
+// newCronJobHistory summarizes a cronjob schedule and its job runs, newest runs first.
+func newCronJobHistory(cj *batchv1.CronJob, rr []dao.CronJobRun, now time.Time) cronJobHistory {
+	h := cronJobHistory{
+		Schedule:  cj.Spec.Schedule,
+		TimeZone:  "UTC",
+		Suspended: cj.Spec.Suspend != nil && *cj.Spec.Suspend,
+		Runs:      make([]cronJobEntry, 0, len(rr)),
+	}
+	if tz := cj.Spec.TimeZone; tz != nil && *tz != "" {
+		h.TimeZone = *tz
+	}
+	tt, err := dao.NextSchedules(cj, now, cronNextRuns)
+	if err != nil {
+		h.NextRuns = []string{err.Error()}
+	}
+	for _, t := range tt {
+		h.NextRuns = append(h.NextRuns, fmt.Sprintf("%s (in %s)", t.Format(time.RFC3339), duration.HumanDuration(t.Sub(now))))
+	}
+
+	s := dao.ComputeCronJobStats(rr)
+	h.Stats = cronJobStats{
+		Runs:        s.Runs,
+		Succeeded:   s.Succeeded,
+		Failed:      s.Failed,
+		SuccessRate: render.NAValue,
+		AvgDuration: render.NAValue,
+		LastSuccess: sinceOrNA(s.LastSuccess, now),
+		LastFailure: sinceOrNA(s.LastFailure, now),
+	}
+	if s.Succeeded+s.Failed > 0 {
+		h.Stats.SuccessRate = fmt.Sprintf("%d%%", s.SuccessRate())
+		h.Stats.AvgDuration = duration.HumanDuration(s.AvgDuration)
+	}
+
+	dd := make([]int64, 0, len(rr))
+	for i := len(rr) - 1; i >= 0; i-- {
+		r := rr[i]
+		h.Runs = append(h.Runs, cronJobEntry{
+			Job:      r.Job,
+			Status:   r.Status,
+			Started:  sinceOrNA(r.Start, now),
+			Duration: duration.HumanDuration(r.Duration),
+		})
+	}
+	for _, r := range rr {
+		dd = append(dd, int64(r.Duration.Seconds()))
+	}
+	h.Durations = tchart.SparkText(dd)
+
+	return h
+}
+
+func sinceOrNA(t, now time.Time) string {
+	if t.IsZero() {
+		return render.NAValue
+	}
+
+	return duration.HumanDuration(now.Sub(t)) + " ago"
+}
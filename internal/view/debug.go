@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"sigs.k8s.io/yaml"
+)
+
+const debugContainersHeader = `# Ephemeral containers can not be removed from a pod once added.
+# Terminated debug containers no longer consume resources.
+`
+
+// debugPod injects an ephemeral debug container in a pod and attaches to it.
+func debugPod(a *App, comp model.Component, path, target string) {
+	var po dao.Pod
+	po.Init(a.factory, client.NewGVR("v1/pods"))
+	cfg := a.Config.K9s.DebugContainer
+
+	msg := fmt.Sprintf("Launching %s debug container on %s...", cfg.Profile, path)
+	dialog.ShowPrompt(a.Styles.Dialog(), a.Content.Pages, "Debugging", msg, func(ctx context.Context) {
+		co, err := po.Debug(ctx, path, target, cfg)
+		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				a.Flash().Errf("Launching debug container failed: %s", err)
+			}
+			return
+		}
+
+		go resumeAttachIn(a, comp, path, co)
+	}, func() {})
+}
+
+// showDebugContainers lists a pod's ephemeral debug containers.
+func showDebugContainers(a *App, path string) error {
+	var po dao.Pod
+	po.Init(a.factory, client.NewGVR("v1/pods"))
+	ii, err := po.DebugContainers(path)
+	if err != nil {
+		return err
+	}
+	if len(ii) == 0 {
+		a.Flash().Infof("No debug containers found on %s", path)
+		return nil
+	}
+	raw, err := yaml.Marshal(map[string]interface{}{"debugContainers": ii})
+	if err != nil {
+		return err
+	}
+
+	return a.inject(NewDetails(a, "Debug Containers", path, contentYAML, true).Update(debugContainersHeader+string(raw)), false)
+}
+
+// debugNode launches a node debug pod, attaches to it and deletes it once done.
+func debugNode(a *App, comp model.Component, node string) {
+	var no dao.Node
+	no.Init(a.factory, client.NewGVR("v1/nodes"))
+	cfg := a.Config.K9s.DebugContainer
+
+	msg := fmt.Sprintf("Launching %s debug pod on %s...", cfg.Profile, node)
+	dialog.ShowPrompt(a.Styles.Dialog(), a.Content.Pages, "Debugging", msg, func(ctx context.Context) {
+		path, err := no.Debug(ctx, node, cfg)
+		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				a.Flash().Errf("Launching debug pod failed: %s", err)
+			}
+			return
+		}
+
+		go func() {
+			resumeAttachIn(a, comp, path, dao.DebugContainerName)
+			if err := no.DeleteDebugPod(context.Background(), path); err != nil {
+				a.Flash().Errf("Debug pod cleanup failed: %s", err)
+			}
+		}()
+	}, func() {})
+}
+
+// cleanupDebugPods deletes all leftover node debug pods.
+func cleanupDebugPods(a *App) {
+	ns := a.Config.K9s.DebugContainer.Namespace
+	msg := fmt.Sprintf("Delete all k9s debug pods in namespace %s?", ns)
+	dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Confirm Cleanup", msg, func() {
+		var no dao.Node
+		no.Init(a.factory, client.NewGVR("v1/nodes"))
+		count, err := no.CleanupDebugPods(context.Background(), ns)
+		if err != nil {
+			a.Flash().Errf("Debug pods cleanup failed: %s", err)
+			return
+		}
+		a.Flash().Infof("Deleted %d debug pod(s)", count)
+	}, func() {})
+}
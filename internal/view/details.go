@@ -133,15 +133,15 @@ func (d *Details) BufferActive(state bool, k model.BufferKind) {
 
 func (d *Details) bindKeys() {
 	d.actions.Bulk(ui.KeyMap{
-		tcell.KeyEnter:  ui.NewSharedKeyAction("Filter", d.filterCmd, false),
-		tcell.KeyEscape: ui.NewKeyAction("Back", d.resetCmd, false),
-		tcell.KeyCtrlS:  ui.NewKeyAction("Save", d.saveCmd, false),
-		ui.KeyC:         ui.NewKeyAction("Copy", cpCmd(d.app.Flash(), d.text), true),
-		ui.KeyF:         ui.NewKeyAction("Toggle FullScreen", d.toggleFullScreenCmd, true),
-		ui.KeyN:         ui.NewKeyAction("Next Match", d.nextCmd, true),
-		ui.KeyShiftN:    ui.NewKeyAction("Prev Match", d.prevCmd, true),
-		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", d.activateCmd, false),
-		tcell.KeyDelete: ui.NewSharedKeyAction("Erase", d.eraseCmd, false),
+		tcell.KeyEnter:  ui.NewSharedKeyAction("Filter", d.filterCmd, false).WithID("filter"),
+		tcell.KeyEscape: ui.NewKeyAction("Back", d.resetCmd, false).WithID("back"),
+		tcell.KeyCtrlS:  ui.NewKeyAction("Save", d.saveCmd, false).WithID("save"),
+		ui.KeyC:         ui.NewKeyAction("Copy", cpCmd(d.app.Flash(), d.text), true).WithID("copy"),
+		ui.KeyF:         ui.NewKeyAction("Toggle FullScreen", d.toggleFullScreenCmd, true).WithID("toggleFullScreen"),
+		ui.KeyN:         ui.NewKeyAction("Next Match", d.nextCmd, true).WithID("nextMatch"),
+		ui.KeyShiftN:    ui.NewKeyAction("Prev Match", d.prevCmd, true).WithID("prevMatch"),
+		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", d.activateCmd, false).WithID("filterMode"),
+		tcell.KeyDelete: ui.NewSharedKeyAction("Erase", d.eraseCmd, false).WithID("erase"),
 	})
 
 	if !d.searchable {
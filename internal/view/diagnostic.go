@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// Diagnostic represents an api client diagnostics view.
+type Diagnostic struct {
+	ResourceViewer
+}
+
+// NewDiagnostic returns a new diagnostics view.
+func NewDiagnostic(gvr client.GVR) ResourceViewer {
+	d := Diagnostic{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	d.GetTable().SetSortCol("GVR", true)
+	d.AddBindKeysFn(d.bindKeys)
+	d.GetTable().SetEnterFn(d.detailsCmd)
+
+	return &d
+}
+
+func (d *Diagnostic) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, tcell.KeyCtrlD, ui.KeyY, ui.KeyD)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftR: ui.NewKeyAction("Reset", d.resetCmd, true).WithID("reset"),
+		ui.KeyShiftL: ui.NewKeyAction("Sort List-P99", d.GetTable().SortColCmd("LIST-P99", false), false).WithID("sortListP99"),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Throttle-Max", d.GetTable().SortColCmd("THROTTLE-MAX", false), false).WithID("sortThrottleMax"),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Cache", d.GetTable().SortColCmd("CACHE(KB)", false), false).WithID("sortCache"),
+	})
+}
+
+func (d *Diagnostic) resetCmd(*tcell.EventKey) *tcell.EventKey {
+	client.Diag.Reset()
+	d.App().Flash().Info("API client diagnostics reset")
+	d.GetTable().Refresh()
+
+	return nil
+}
+
+func (d *Diagnostic) detailsCmd(app *App, _ ui.Tabular, _ client.GVR, gvr string) {
+	var stats *client.RequestStats
+	for _, s := range client.Diag.Stats() {
+		if s.GVR == gvr {
+			stats = &s
+			break
+		}
+	}
+	var ii []diagInformer
+	for _, i := range app.factory.Informers() {
+		if i.GVR != gvr {
+			continue
+		}
+		ii = append(ii, diagInformer{
+			Namespace:       client.CleanseNamespace(i.Namespace),
+			Synced:          i.Synced,
+			Items:           i.Items,
+			CacheBytes:      i.CacheBytes,
+			ResourceVersion: i.ResourceVersion,
+		})
+	}
+
+	raw, err := yaml.Marshal(newDiagDetails(stats, ii, client.Diag.Since()))
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if err := app.inject(NewDetails(app, "Diagnostics", gvr, contentYAML, true).Update(string(raw)), false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+type diagDetails struct {
+	Since         string         `json:"since"`
+	Informers     []diagInformer `json:"informers"`
+	Requests      int            `json:"requests"`
+	Errors        int            `json:"errors"`
+	LastError     string         `json:"lastError,omitempty"`
+	Lists         int            `json:"lists"`
+	Relists       int            `json:"relists"`
+	Watches       int            `json:"watches"`
+	ActiveWatches int            `json:"activeWatches"`
+	WatchRestarts int            `json:"watchRestarts"`
+	WatchErrors   int            `json:"watchErrors"`
+	ListLatency   diagHistogram  `json:"listLatency"`
+	WatchLatency  diagHistogram  `json:"watchLatency"`
+	ThrottleWait  diagHistogram  `json:"throttleWait"`
+}
+
+type diagInformer struct {
+	Namespace       string `json:"namespace"`
+	Synced          bool   `json:"synced"`
+	Items           int    `json:"items"`
+	CacheBytes      int64  `json:"cacheBytes"`
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+type diagHistogram struct {
+	Count   int            `json:"count"`
+	Avg     string         `json:"avg"`
+	P50     string         `json:"p50"`
+	P90     string         `json:"p90"`
+	P99     string         `json:"p99"`
+	Max     string         `json:"max"`
+	Buckets map[string]int `json:"buckets,omitempty"`
+}
+
+func newDiagDetails(s *client.RequestStats, ii []diagInformer, since time.Time) diagDetails {
+	d := diagDetails{
+		Since:     since.Format(time.RFC3339),
+		Informers: ii,
+	}
+	if s == nil {
+		return d
+	}
+	d.Requests, d.Errors, d.LastError = s.Requests, s.Errors, s.LastError
+	d.Lists, d.Relists = s.Lists, s.Relists
+	d.Watches, d.ActiveWatches, d.WatchRestarts, d.WatchErrors = s.Watches, s.ActiveWatches, s.WatchRestarts, s.WatchErrors
+	d.ListLatency = newDiagHistogram(s.ListLatency)
+	d.WatchLatency = newDiagHistogram(s.WatchLatency)
+	d.ThrottleWait = newDiagHistogram(s.ThrottleWait)
+
+	return d
+}
+
+func newDiagHistogram(h client.Histogram) diagHistogram {
+	d := diagHistogram{
+		Count: h.Total,
+		Avg:   h.Avg().String(),
+		P50:   h.Quantile(0.5).String(),
+		P90:   h.Quantile(0.9).String(),
+		P99:   h.Quantile(0.99).String(),
+		Max:   h.Max.String(),
+	}
+	if h.Total == 0 {
+		return d
+	}
+	d.Buckets = make(map[string]int, len(h.Counts))
+	for i, c := range h.Counts {
+		if c == 0 {
+			continue
+		}
+		if i < len(client.LatencyBuckets) {
+			d.Buckets[fmt.Sprintf("<=%s", client.LatencyBuckets[i])] = c
+		} else {
+			d.Buckets[fmt.Sprintf(">%s", client.LatencyBuckets[i-1])] = c
+		}
+	}
+
+	return d
+}
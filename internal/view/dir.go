@@ -65,15 +65,15 @@ func (d *Dir) bindDangerousKeys(aa *ui.KeyActions) {
 		ui.KeyA: ui.NewKeyActionWithOpts("Apply", d.applyCmd, ui.ActionOpts{
 			Visible:   true,
 			Dangerous: true,
-		}),
+		}).WithID("apply"),
 		ui.KeyD: ui.NewKeyActionWithOpts("Delete", d.delCmd, ui.ActionOpts{
 			Visible:   true,
 			Dangerous: true,
-		}),
+		}).WithID("delete"),
 		ui.KeyE: ui.NewKeyActionWithOpts("Edit", d.editCmd, ui.ActionOpts{
 			Visible:   true,
 			Dangerous: true,
-		}),
+		}).WithID("edit"),
 	})
 }
 
@@ -85,8 +85,8 @@ func (d *Dir) bindKeys(aa *ui.KeyActions) {
 		d.bindDangerousKeys(aa)
 	}
 	aa.Bulk(ui.KeyMap{
-		ui.KeyY:        ui.NewKeyAction(yamlAction, d.viewCmd, true),
-		tcell.KeyEnter: ui.NewKeyAction("Goto", d.gotoCmd, true),
+		ui.KeyY:        ui.NewKeyAction(yamlAction, d.viewCmd, true).WithID("yaml"),
+		tcell.KeyEnter: ui.NewKeyAction("Goto", d.gotoCmd, true).WithID("goto"),
 	})
 }
 
@@ -205,31 +205,16 @@ func (d *Dir) applyCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	opts := []string{"-f"}
-	if containsDir(sel) {
-		opts = append(opts, "-R")
-	}
-	if isKustomized(sel) {
-		opts = []string{"-k"}
-	}
+	opts := manifestOpts(sel)
 	d.Stop()
 	defer d.Start()
-	{
-		args := make([]string, 0, 10)
-		args = append(args, "apply")
-		args = append(args, opts...)
-		args = append(args, sel)
-		res, err := runKu(d.App(), shellOpts{clear: false, args: args})
-		if err != nil {
-			res = "status:\n  " + err.Error() + "\nmessage:\n" + fmtResults(res)
-		} else {
-			res = "message:\n" + fmtResults(res)
-		}
-
-		details := NewDetails(d.App(), "Applied Manifest", sel, contentYAML, true).Update(res)
-		if err := d.App().inject(details, false); err != nil {
-			d.App().Flash().Err(err)
-		}
+	details := NewDetails(d.App(), applyPreviewTitle, sel, contentYAML, true).Update(d.previewApply(sel, opts))
+	details.Actions().Add(ui.KeyA, ui.NewKeyActionWithOpts("Apply", d.confirmApplyCmd(details, sel, opts), ui.ActionOpts{
+		Visible:   true,
+		Dangerous: true,
+	}).WithID("apply"))
+	if err := d.App().inject(details, false); err != nil {
+		d.App().Flash().Err(err)
 	}
 
 	return nil
@@ -241,13 +226,9 @@ func (d *Dir) delCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	opts := []string{"-f"}
+	opts := manifestOpts(sel)
 	msgResource := "manifest"
-	if containsDir(sel) {
-		opts = append(opts, "-R")
-	}
 	if isKustomized(sel) {
-		opts = []string{"-k"}
 		msgResource = "kustomization"
 	}
 
@@ -255,11 +236,7 @@ func (d *Dir) delCmd(evt *tcell.EventKey) *tcell.EventKey {
 	defer d.Start()
 	msg := fmt.Sprintf("Delete resource(s) in %s %s", msgResource, sel)
 	dialog.ShowConfirm(d.App().Styles.Dialog(), d.App().Content.Pages, "Confirm Delete", msg, func() {
-		args := make([]string, 0, 10)
-		args = append(args, "delete")
-		args = append(args, opts...)
-		args = append(args, sel)
-		res, err := runKu(d.App(), shellOpts{clear: false, args: args})
+		res, err := runKu(d.App(), shellOpts{clear: false, args: kubectlArgs("delete", opts, sel)})
 		if err != nil {
 			res = "status:\n  " + err.Error() + "\nmessage:\n" + fmtResults(res)
 		} else {
@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+const (
+	applyPreviewTitle = "Apply Preview"
+	dryRunSuffix      = "(server dry run)"
+	diffFoundExitCode = 1
+)
+
+// dryRunResults tracks server side dry-run outcomes per object.
+type dryRunResults struct {
+	created, changed, unchanged, other []string
+}
+
+// parseDryRun groups the output of a server side dry-run apply by outcome.
+func parseDryRun(out string) dryRunResults {
+	var rr dryRunResults
+	for _, l := range strings.Split(out, "\n") {
+		l = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(l), dryRunSuffix))
+		if l == "" {
+			continue
+		}
+		idx := strings.LastIndex(l, " ")
+		if idx < 0 {
+			rr.other = append(rr.other, l)
+			continue
+		}
+		res, status := l[:idx], l[idx+1:]
+		switch status {
+		case "created":
+			rr.created = append(rr.created, res)
+		case "configured", "serverside-applied":
+			rr.changed = append(rr.changed, res)
+		case "unchanged":
+			rr.unchanged = append(rr.unchanged, res)
+		default:
+			rr.other = append(rr.other, l)
+		}
+	}
+
+	return rr
+}
+
+func (r dryRunResults) String() string {
+	var b strings.Builder
+	section := func(title string, ll []string) {
+		if len(ll) == 0 {
+			fmt.Fprintf(&b, "%s: []\n", title)
+			return
+		}
+		fmt.Fprintf(&b, "%s:\n", title)
+		for _, l := range ll {
+			fmt.Fprintf(&b, "  - %s\n", l)
+		}
+	}
+	section("created", r.created)
+	section("changed", r.changed)
+	section("unchanged", r.unchanged)
+	if len(r.other) > 0 {
+		section("messages", r.other)
+	}
+
+	return b.String()
+}
+
+// objectDiff tracks the diff of a single object.
+type objectDiff struct {
+	name, diff string
+}
+
+// splitDiff breaks kubectl diff output into per object diffs. Each object diff
+// starts with a `diff -u -N LIVE/<object> MERGED/<object>` header.
+func splitDiff(out string) []objectDiff {
+	var (
+		dd   []objectDiff
+		curr *objectDiff
+		b    strings.Builder
+	)
+	flush := func() {
+		if curr != nil {
+			curr.diff = strings.TrimRight(b.String(), "\n")
+			dd = append(dd, *curr)
+		}
+		b.Reset()
+	}
+	for _, l := range strings.Split(out, "\n") {
+		if strings.HasPrefix(l, "diff ") {
+			flush()
+			ff := strings.Fields(l)
+			curr = &objectDiff{name: path.Base(ff[len(ff)-1])}
+			continue
+		}
+		if curr == nil {
+			continue
+		}
+		b.WriteString(l + "\n")
+	}
+	flush()
+
+	return dd
+}
+
+func manifestOpts(sel string) []string {
+	if isKustomized(sel) {
+		return []string{"-k"}
+	}
+	opts := []string{"-f"}
+	if containsDir(sel) {
+		opts = append(opts, "-R")
+	}
+
+	return opts
+}
+
+func kubectlArgs(cmd string, opts []string, sel string, extras ...string) []string {
+	args := make([]string, 0, 10)
+	args = append(args, cmd)
+	args = append(args, extras...)
+	args = append(args, opts...)
+
+	return append(args, sel)
+}
+
+// previewApply builds a preview of the changes a given apply would incur.
+func (d *Dir) previewApply(sel string, opts []string) string {
+	var b strings.Builder
+	if isKustomized(sel) {
+		res, err := runKu(d.App(), shellOpts{args: []string{"kustomize", sel}})
+		if err != nil {
+			fmt.Fprintf(&b, "status:\n  %s\n", err)
+		}
+		fmt.Fprintf(&b, "build: |\n%s\n", fmtResults(res))
+	}
+
+	res, err := runKu(d.App(), shellOpts{args: kubectlArgs("apply", opts, sel, "--dry-run=server")})
+	if err != nil {
+		fmt.Fprintf(&b, "status:\n  %s\n", err)
+	}
+	b.WriteString(parseDryRun(res).String())
+
+	res, err = runKu(d.App(), shellOpts{args: kubectlArgs("diff", opts, sel)})
+	var exitErr *exec.ExitError
+	if err != nil && !(errors.As(err, &exitErr) && exitErr.ExitCode() == diffFoundExitCode) {
+		fmt.Fprintf(&b, "diffStatus:\n  %s\n", err)
+	}
+	dd := splitDiff(res)
+	if len(dd) == 0 {
+		b.WriteString("diff: No changes\n")
+		return b.String()
+	}
+	b.WriteString("diff:\n")
+	for _, d := range dd {
+		fmt.Fprintf(&b, "  %s: |\n", d.name)
+		for _, l := range strings.Split(d.diff, "\n") {
+			fmt.Fprintf(&b, "    %s\n", l)
+		}
+	}
+
+	return b.String()
+}
+
+func (d *Dir) confirmApplyCmd(details *Details, sel string, opts []string) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		msg := fmt.Sprintf("Apply resource(s) in %s?", sel)
+		dialog.ShowConfirm(d.App().Styles.Dialog(), d.App().Content.Pages, "Confirm Apply", msg, func() {
+			res, err := runKu(d.App(), shellOpts{clear: false, args: kubectlArgs("apply", opts, sel)})
+			if err != nil {
+				res = "status:\n  " + err.Error() + "\nmessage:\n" + fmtResults(res)
+				d.App().Flash().Errf("Apply failed for %s", sel)
+			} else {
+				res = "message:\n" + fmtResults(res)
+				d.App().Flash().Infof("Applied %s", sel)
+			}
+			details.Update(res)
+			details.Actions().Delete(ui.KeyA)
+			d.App().Menu().HydrateMenu(details.Hints())
+		}, func() {})
+
+		return nil
+	}
+}
@@ -45,3 +45,75 @@ func TestIsKustomized(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDryRun(t *testing.T) {
+	uu := map[string]struct {
+		out string
+		e   dryRunResults
+	}{
+		"empty": {},
+		"mixed": {
+			out: `deployment.apps/fred created (server dry run)
+service/fred configured (server dry run)
+configmap/blee unchanged (server dry run)`,
+			e: dryRunResults{
+				created:   []string{"deployment.apps/fred"},
+				changed:   []string{"service/fred"},
+				unchanged: []string{"configmap/blee"},
+			},
+		},
+		"errors": {
+			out: `error: the path "fred.yaml" does not exist`,
+			e: dryRunResults{
+				other: []string{`error: the path "fred.yaml" does not exist`},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, parseDryRun(u.out))
+		})
+	}
+}
+
+func TestSplitDiff(t *testing.T) {
+	uu := map[string]struct {
+		out string
+		e   []objectDiff
+	}{
+		"empty": {},
+		"multi": {
+			out: `diff -u -N /tmp/LIVE-1/apps.v1.Deployment.default.fred /tmp/MERGED-2/apps.v1.Deployment.default.fred
+--- /tmp/LIVE-1/apps.v1.Deployment.default.fred
++++ /tmp/MERGED-2/apps.v1.Deployment.default.fred
+@@ -1 +1 @@
+-  replicas: 1
++  replicas: 2
+diff -u -N /tmp/LIVE-1/v1.Service.default.fred /tmp/MERGED-2/v1.Service.default.fred
+--- /tmp/LIVE-1/v1.Service.default.fred
++++ /tmp/MERGED-2/v1.Service.default.fred
+@@ -0,0 +1 @@
++kind: Service
+`,
+			e: []objectDiff{
+				{
+					name: "apps.v1.Deployment.default.fred",
+					diff: "--- /tmp/LIVE-1/apps.v1.Deployment.default.fred\n+++ /tmp/MERGED-2/apps.v1.Deployment.default.fred\n@@ -1 +1 @@\n-  replicas: 1\n+  replicas: 2",
+				},
+				{
+					name: "v1.Service.default.fred",
+					diff: "--- /tmp/LIVE-1/v1.Service.default.fred\n+++ /tmp/MERGED-2/v1.Service.default.fred\n@@ -0,0 +1 @@\n+kind: Service",
+				},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, splitDiff(u.out))
+		})
+	}
+}
@@ -4,11 +4,15 @@
 package view
 
 import (
+	"context"
 	"errors"
+	"fmt"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -41,13 +45,72 @@ func NewDeploy(gvr client.GVR) ResourceViewer {
 }
 
 func (d *Deploy) bindKeys(aa *ui.KeyActions) {
+	if !d.App().Config.K9s.IsReadOnly() {
+		aa.Add(ui.KeyZ, ui.NewKeyActionWithOpts("Pause/Resume", d.togglePauseCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			},
+		).WithID("pauseResume"))
+	}
 	aa.Bulk(ui.KeyMap{
-		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", d.GetTable().SortColCmd(readyCol, true), false),
-		ui.KeyShiftU: ui.NewKeyAction("Sort UpToDate", d.GetTable().SortColCmd(uptodateCol, true), false),
-		ui.KeyShiftL: ui.NewKeyAction("Sort Available", d.GetTable().SortColCmd(availCol, true), false),
+		ui.KeyH:      ui.NewKeyAction("History", d.historyCmd, true).WithID("history"),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", d.GetTable().SortColCmd(readyCol, true), false).WithID("sortReady"),
+		ui.KeyShiftU: ui.NewKeyAction("Sort UpToDate", d.GetTable().SortColCmd(uptodateCol, true), false).WithID("sortUpToDate"),
+		ui.KeyShiftL: ui.NewKeyAction("Sort Available", d.GetTable().SortColCmd(availCol, true), false).WithID("sortAvailable"),
 	})
 }
 
+func (d *Deploy) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := d.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := d.App().inject(NewRolloutHistory(path), false); err != nil {
+		d.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (d *Deploy) togglePauseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := d.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	dp, err := d.getInstance(path)
+	if err != nil {
+		d.App().Flash().Err(err)
+		return nil
+	}
+	action := "Pause"
+	if dp.Spec.Paused {
+		action = "Resume"
+	}
+
+	d.Stop()
+	defer d.Start()
+	msg := fmt.Sprintf("%s rollout for deployment %s?", action, path)
+	dialog.ShowConfirm(d.App().Styles.Dialog(), d.App().Content.Pages, "Confirm "+action, msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), d.App().Conn().Config().CallTimeout())
+		defer cancel()
+		var ddp dao.Deployment
+		ddp.Init(d.App().factory, d.GVR())
+		if dp.Spec.Paused {
+			err = ddp.Resume(ctx, path)
+		} else {
+			err = ddp.Pause(ctx, path)
+		}
+		if err != nil {
+			d.App().Flash().Err(err)
+			return
+		}
+		d.App().Flash().Infof("%s rollout requested for `%s", action, path)
+	}, func() {})
+
+	return nil
+}
+
 func (d *Deploy) logOptions(prev bool) (*dao.LogOptions, error) {
 	path := d.GetTable().GetSelectedItem()
 	if path == "" {
@@ -4,6 +4,7 @@
 package view
 
 import (
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
@@ -28,11 +29,33 @@ func NewEvent(gvr client.GVR) ResourceViewer {
 func (e *Event) bindKeys(aa *ui.KeyActions) {
 	aa.Delete(tcell.KeyCtrlD, ui.KeyE, ui.KeyA)
 	aa.Bulk(ui.KeyMap{
-		ui.KeyShiftL: ui.NewKeyAction("Sort LastSeen", e.GetTable().SortColCmd("LAST SEEN", false), false),
-		ui.KeyShiftF: ui.NewKeyAction("Sort FirstSeen", e.GetTable().SortColCmd("FIRST SEEN", false), false),
-		ui.KeyShiftT: ui.NewKeyAction("Sort Type", e.GetTable().SortColCmd("TYPE", true), false),
-		ui.KeyShiftR: ui.NewKeyAction("Sort Reason", e.GetTable().SortColCmd("REASON", true), false),
-		ui.KeyShiftS: ui.NewKeyAction("Sort Source", e.GetTable().SortColCmd("SOURCE", true), false),
-		ui.KeyShiftC: ui.NewKeyAction("Sort Count", e.GetTable().SortColCmd("COUNT", true), false),
+		ui.KeyShiftL: ui.NewKeyAction("Sort LastSeen", e.GetTable().SortColCmd("LAST SEEN", false), false).WithID("sortLastSeen"),
+		ui.KeyShiftF: ui.NewKeyAction("Sort FirstSeen", e.GetTable().SortColCmd("FIRST SEEN", false), false).WithID("sortFirstSeen"),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Type", e.GetTable().SortColCmd("TYPE", true), false).WithID("sortType"),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Reason", e.GetTable().SortColCmd("REASON", true), false).WithID("sortReason"),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Source", e.GetTable().SortColCmd("SOURCE", true), false).WithID("sortSource"),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Count", e.GetTable().SortColCmd("COUNT", true), false).WithID("sortCount"),
+		ui.KeyT:      ui.NewKeyAction("Timeline", e.timelineCmd, true).WithID("timeline"),
 	})
 }
+
+func (e *Event) timelineCmd(evt *tcell.EventKey) *tcell.EventKey {
+	v := NewEventTimeline(client.NewGVR("timelines"))
+	if f := e.GetTable().CmdBuff().GetText(); isRegexFilter(f) {
+		v.SetFilter(f)
+	}
+	if err := e.App().inject(v, false); err != nil {
+		e.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func isRegexFilter(s string) bool {
+	if s == "" || internal.IsLabelSelector(s) || internal.IsInverseSelector(s) {
+		return false
+	}
+	_, fuzzy := internal.IsFuzzySelector(s)
+
+	return !fuzzy
+}
@@ -0,0 +1,308 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	timelineTitle    = "Timeline"
+	timelineTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-][[count:bg:b]%s[fg:bg:-]][fg:bg:-] "
+	timelineDetails  = 8
+)
+
+var timelineWindows = []time.Duration{
+	15 * time.Minute,
+	time.Hour,
+	6 * time.Hour,
+	24 * time.Hour,
+}
+
+var _ ResourceViewer = (*EventTimeline)(nil)
+
+// EventTimeline represents a timeline of events correlated per object.
+type EventTimeline struct {
+	*tview.Flex
+
+	app      *App
+	gvr      client.GVR
+	model    *model.Timeline
+	chart    *tchart.Timeline
+	details  *tview.TextView
+	cancelFn context.CancelFunc
+	actions  *ui.KeyActions
+	filter   *regexp.Regexp
+}
+
+// NewEventTimeline returns a new timeline view.
+func NewEventTimeline(gvr client.GVR) ResourceViewer {
+	return &EventTimeline{
+		Flex:    tview.NewFlex(),
+		gvr:     gvr,
+		chart:   tchart.NewTimeline(gvr.String()),
+		details: tview.NewTextView(),
+		actions: ui.NewKeyActions(),
+	}
+}
+
+// Init initializes the view.
+func (t *EventTimeline) Init(ctx context.Context) error {
+	var err error
+	if t.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+
+	t.model = model.NewTimeline(client.CleanseNamespace(t.app.Config.ActiveNamespace()))
+	t.SetBorder(true)
+	t.SetBorderPadding(0, 0, 1, 1)
+	t.SetDirection(tview.FlexRow)
+	t.chart.SetChangedFunc(t.laneChanged)
+	t.chart.SetInputCapture(t.keyboard)
+	t.details.SetDynamicColors(true)
+	t.details.SetWrap(false)
+	t.details.SetBorder(true)
+	t.AddItem(t.chart, 0, 1, true)
+	t.AddItem(t.details, timelineDetails, 0, false)
+
+	t.bindKeys()
+	t.model.AddListener(t)
+	t.app.Styles.AddListener(t)
+	t.StylesChanged(t.app.Styles)
+	t.updateTitle(0)
+
+	return nil
+}
+
+// InCmdMode checks if prompt is active.
+func (*EventTimeline) InCmdMode() bool {
+	return false
+}
+
+// SetFilter sets a lane filter.
+func (t *EventTimeline) SetFilter(s string) {
+	if s == "" {
+		t.filter = nil
+		return
+	}
+	rx, err := regexp.Compile(`(?i)` + s)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Invalid timeline filter %q", s)
+		return
+	}
+	t.filter = rx
+}
+
+// SetLabelFilter sets the labels filter.
+func (*EventTimeline) SetLabelFilter(map[string]string) {}
+
+// StylesChanged notifies the skin changed.
+func (t *EventTimeline) StylesChanged(s *config.Styles) {
+	t.SetBackgroundColor(s.Charts().BgColor.Color())
+	t.chart.SetBackgroundColor(s.Charts().ChartBgColor.Color())
+	t.chart.SetSeriesColors(s.Charts().DefaultChartColors.Colors()...)
+	t.chart.SetFocusColorNames(s.Table().BgColor.String(), s.Table().CursorBgColor.String())
+	t.details.SetBackgroundColor(s.Charts().BgColor.Color())
+	t.details.SetTextColor(s.Body().FgColor.Color())
+}
+
+// TimelineChanged notifies the model data changed.
+func (t *EventTimeline) TimelineChanged(ll []tchart.Lane) {
+	if t.filter != nil {
+		// Keeps matching lanes owners and dependents.
+		groups := make(map[string]struct{})
+		for _, l := range ll {
+			if t.filter.MatchString(l.Label) {
+				groups[l.Group] = struct{}{}
+			}
+		}
+		fll := make([]tchart.Lane, 0, len(ll))
+		for _, l := range ll {
+			if _, ok := groups[l.Group]; ok {
+				fll = append(fll, l)
+			}
+		}
+		ll = fll
+	}
+	t.app.QueueUpdateDraw(func() {
+		t.chart.SetLanes(ll)
+		t.updateTitle(len(ll))
+		if len(ll) == 0 {
+			t.details.Clear()
+		}
+	})
+}
+
+// TimelineFailed notifies the load failed.
+func (t *EventTimeline) TimelineFailed(err error) {
+	t.app.Flash().Err(err)
+}
+
+func (t *EventTimeline) laneChanged(l tchart.Lane) {
+	t.details.SetTitle(" " + l.Label + " ")
+	t.details.Clear()
+	for i := len(l.Marks) - 1; i >= 0; i-- {
+		m := l.Marks[i]
+		fmt.Fprintf(t.details, "%c %s %s (x%d)\n",
+			tchart.MarkRune(m.Kind),
+			m.End.Format(time.RFC3339),
+			tview.Escape(m.Label),
+			m.Count,
+		)
+	}
+	t.details.ScrollToBeginning()
+}
+
+func (t *EventTimeline) updateTitle(count int) {
+	ns := t.model.GetNamespace()
+	if client.IsAllNamespaces(ns) {
+		ns = client.NamespaceAll
+	}
+	base := fmt.Sprintf("%s %s", timelineTitle, duration.HumanDuration(t.model.Window()))
+	t.SetTitle(ui.SkinTitle(fmt.Sprintf(timelineTitleFmt, base, ns, render.AsThousands(int64(count))), t.app.Styles.Frame()))
+}
+
+func (t *EventTimeline) bindKeys() {
+	t.actions.Merge(ui.NewKeyActionsFromMap(ui.KeyMap{
+		tcell.KeyEscape: ui.NewKeyAction("Back", t.app.PrevCmd, true).WithID("back"),
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", t.refreshCmd, false).WithID("refresh"),
+		tcell.KeyEnter:  ui.NewKeyAction("Events", t.eventsCmd, true).WithID("events"),
+	}))
+	for i, w := range timelineWindows {
+		t.actions.Add(ui.NumKeys[i+1], ui.NewKeyAction("Last "+duration.HumanDuration(w), t.windowCmd(w), true))
+	}
+}
+
+func (t *EventTimeline) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := t.actions.Get(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (t *EventTimeline) windowCmd(w time.Duration) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		t.model.SetWindow(w)
+		t.chart.SetWindow(w)
+		t.Start()
+
+		return nil
+	}
+}
+
+func (t *EventTimeline) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.Start()
+
+	return nil
+}
+
+func (t *EventTimeline) eventsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	l, ok := t.chart.SelectedLane()
+	if !ok {
+		return evt
+	}
+	_, fqn, _ := strings.Cut(l.ID, ":")
+	ns, n := client.Namespaced(fqn)
+	if ns == "" {
+		ns = client.NamespaceAll
+	}
+	t.app.gotoResource(fmt.Sprintf("events %s /%s", ns, n), "", false)
+
+	return nil
+}
+
+func (t *EventTimeline) defaultContext() context.Context {
+	return context.WithValue(context.Background(), internal.KeyFactory, t.app.factory)
+}
+
+// Start initializes the timeline watch loop.
+func (t *EventTimeline) Start() {
+	t.Stop()
+
+	ctx := t.defaultContext()
+	ctx, t.cancelFn = context.WithCancel(ctx)
+	t.model.Watch(ctx)
+}
+
+// Stop terminates the watch loop.
+func (t *EventTimeline) Stop() {
+	if t.cancelFn == nil {
+		return
+	}
+	t.cancelFn()
+	t.cancelFn = nil
+}
+
+// Refresh updates the view.
+func (*EventTimeline) Refresh() {}
+
+// GVR returns a resource descriptor.
+func (t *EventTimeline) GVR() client.GVR {
+	return t.gvr
+}
+
+// Name returns the component name.
+func (*EventTimeline) Name() string {
+	return timelineTitle
+}
+
+// App returns the current app handle.
+func (t *EventTimeline) App() *App {
+	return t.app
+}
+
+// SetInstance sets specific resource instance.
+func (*EventTimeline) SetInstance(string) {}
+
+// SetFieldSelector sets a resource field selector.
+func (*EventTimeline) SetFieldSelector(string) {}
+
+// SetNamespace sets a view local namespace.
+func (*EventTimeline) SetNamespace(string) {}
+
+// SetEnvFn sets the custom environment function.
+func (*EventTimeline) SetEnvFn(EnvFunc) {}
+
+// AddBindKeysFn sets up extra key bindings.
+func (*EventTimeline) AddBindKeysFn(BindKeysFunc) {}
+
+// SetContextFn sets custom context.
+func (*EventTimeline) SetContextFn(ContextFunc) {}
+
+// GetTable return the view table if any.
+func (*EventTimeline) GetTable() *Table {
+	return nil
+}
+
+// Actions returns active menu bindings.
+func (t *EventTimeline) Actions() *ui.KeyActions {
+	return t.actions
+}
+
+// Hints returns the view hints.
+func (t *EventTimeline) Hints() model.MenuHints {
+	return t.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (*EventTimeline) ExtraHints() map[string]string {
+	return nil
+}
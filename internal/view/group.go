@@ -29,8 +29,8 @@ func NewGroup(gvr client.GVR) ResourceViewer {
 func (g *Group) bindKeys(aa *ui.KeyActions) {
 	aa.Delete(ui.KeyShiftA, ui.KeyShiftP, tcell.KeyCtrlSpace, ui.KeySpace)
 	aa.Bulk(ui.KeyMap{
-		tcell.KeyEnter: ui.NewKeyAction("Rules", g.policyCmd, true),
-		ui.KeyShiftK:   ui.NewKeyAction("Sort Kind", g.GetTable().SortColCmd("KIND", true), false),
+		tcell.KeyEnter: ui.NewKeyAction("Rules", g.policyCmd, true).WithID("rules"),
+		ui.KeyShiftK:   ui.NewKeyAction("Sort Kind", g.GetTable().SortColCmd("KIND", true), false).WithID("sortKind"),
 	})
 }
 
@@ -60,9 +60,9 @@ func (h *History) bindKeys(aa *ui.KeyActions) {
 
 	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, tcell.KeyCtrlD)
 	aa.Bulk(ui.KeyMap{
-		ui.KeyShiftN: ui.NewKeyAction("Sort Revision", h.GetTable().SortColCmd("REVISION", true), false),
-		ui.KeyShiftS: ui.NewKeyAction("Sort Status", h.GetTable().SortColCmd("STATUS", true), false),
-		ui.KeyShiftA: ui.NewKeyAction("Sort Age", h.GetTable().SortColCmd("AGE", true), false),
+		ui.KeyShiftN: ui.NewKeyAction("Sort Revision", h.GetTable().SortColCmd("REVISION", true), false).WithID("sortRevision"),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", h.GetTable().SortColCmd("STATUS", true), false).WithID("sortStatus"),
+		ui.KeyShiftA: ui.NewKeyAction("Sort Age", h.GetTable().SortColCmd("AGE", true), false).WithID("sortAge"),
 	})
 }
 
@@ -87,7 +87,7 @@ func (h *History) bindDangerousKeys(aa *ui.KeyActions) {
 			Visible:   true,
 			Dangerous: true,
 		},
-	))
+	).WithID("rollBackTo"))
 }
 
 func (h *History) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -78,9 +78,9 @@ func (h *Help) StylesChanged(s *config.Styles) {
 func (h *Help) bindKeys() {
 	h.Actions().Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlS, ui.KeySlash)
 	h.Actions().Bulk(ui.KeyMap{
-		tcell.KeyEscape: ui.NewKeyAction("Back", h.app.PrevCmd, true),
-		ui.KeyHelp:      ui.NewKeyAction("Back", h.app.PrevCmd, false),
-		tcell.KeyEnter:  ui.NewKeyAction("Back", h.app.PrevCmd, false),
+		tcell.KeyEscape: ui.NewKeyAction("Back", h.app.PrevCmd, true).WithID("back"),
+		ui.KeyHelp:      ui.NewKeyAction("Back", h.app.PrevCmd, false).WithID("back"),
+		tcell.KeyEnter:  ui.NewKeyAction("Back", h.app.PrevCmd, false).WithID("back"),
 	})
 }
 
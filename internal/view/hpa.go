@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"sigs.k8s.io/yaml"
+)
+
+const replicasCol = "REPLICAS"
+
+// HPA represents a horizontal pod autoscaler viewer.
+type HPA struct {
+	ResourceViewer
+}
+
+// NewHPA returns a new viewer.
+func NewHPA(gvr client.GVR) ResourceViewer {
+	h := HPA{ResourceViewer: NewBrowser(gvr)}
+	h.AddBindKeysFn(h.bindKeys)
+	h.GetTable().SetEnterFn(h.showTarget)
+
+	return &h
+}
+
+func (h *HPA) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyH:      ui.NewKeyAction("History", h.historyCmd, true).WithID("history"),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Replicas", h.GetTable().SortColCmd(replicasCol, false), false).WithID("sortReplicas"),
+	})
+}
+
+func (h *HPA) getInstance(path string) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	var res dao.HPA
+	res.Init(h.App().factory, h.GVR())
+	o, err := res.Get(context.Background(), path)
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return render.ToHPA(u)
+}
+
+func (h *HPA) showTarget(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	hpa, err := h.getInstance(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	ref := hpa.Spec.ScaleTargetRef
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	gvr, _, ok := dao.MetaAccess.GVK2GVR(gv, ref.Kind)
+	if !ok {
+		app.Flash().Errf("Unsupported scale target %s/%s", ref.APIVersion, ref.Kind)
+		return
+	}
+	app.gotoResource(gvr.String(), client.FQN(hpa.Namespace, ref.Name), false)
+}
+
+func (h *HPA) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := h.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	hpa, err := h.getInstance(path)
+	if err != nil {
+		h.App().Flash().Err(err)
+		return nil
+	}
+	var res dao.HPA
+	res.Init(h.App().factory, h.GVR())
+	ctx, cancel := context.WithTimeout(context.Background(), h.App().Conn().Config().CallTimeout())
+	defer cancel()
+	ee, err := res.ScalingEvents(ctx, path)
+	if err != nil {
+		h.App().Flash().Err(err)
+		return nil
+	}
+
+	raw, err := yaml.Marshal(newHPAHistory(hpa, res.Replicas(path), ee, time.Now()))
+	if err != nil {
+		h.App().Flash().Err(err)
+		return nil
+	}
+	if err := h.App().inject(NewDetails(h.App(), "History", path, contentYAML, true).Update(string(raw)), false); err != nil {
+		h.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+type hpaHistory struct {
+	Target     string           `json:"target"`
+	Replicas   hpaReplicaCounts `json:"replicas"`
+	Trend      string           `json:"trend"`
+	Metrics    []string         `json:"metrics"`
+	Conditions []string         `json:"conditions"`
+	Events     []hpaEvent       `json:"events"`
+}
+
+type hpaReplicaCounts struct {
+	Min     int32 `json:"min"`
+	Max     int32 `json:"max"`
+	Current int32 `json:"current"`
+	Desired int32 `json:"desired"`
+}
+
+type hpaEvent struct {
+	Age     string `json:"age"`
+	Type    string `json:"type"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// newHPAHistory summarizes an HPA targets, session replica counts and recent events.
+func newHPAHistory(hpa *autoscalingv2.HorizontalPodAutoscaler, rr []int64, ee []v1.Event, now time.Time) hpaHistory {
+	var minReplicas int32 = 1
+	if hpa.Spec.MinReplicas != nil {
+		minReplicas = *hpa.Spec.MinReplicas
+	}
+	h := hpaHistory{
+		Target: hpa.Spec.ScaleTargetRef.Kind + "/" + hpa.Spec.ScaleTargetRef.Name,
+		Replicas: hpaReplicaCounts{
+			Min:     minReplicas,
+			Max:     hpa.Spec.MaxReplicas,
+			Current: hpa.Status.CurrentReplicas,
+			Desired: hpa.Status.DesiredReplicas,
+		},
+		Trend:      render.NAValue,
+		Metrics:    render.HPATargets(hpa),
+		Conditions: make([]string, 0, len(hpa.Status.Conditions)),
+		Events:     make([]hpaEvent, 0, len(ee)),
+	}
+	if len(rr) > 0 {
+		lo, hi := rr[0], rr[0]
+		for _, r := range rr {
+			lo, hi = min(lo, r), max(hi, r)
+		}
+		h.Trend = fmt.Sprintf("%s (%d..%d)", tchart.SparkText(rr), lo, hi)
+	}
+	for _, c := range hpa.Status.Conditions {
+		h.Conditions = append(h.Conditions, fmt.Sprintf("%s=%s %s: %s", c.Type, c.Status, c.Reason, c.Message))
+	}
+	for _, e := range ee {
+		t := e.LastTimestamp.Time
+		if t.IsZero() {
+			t = e.EventTime.Time
+		}
+		age := render.NAValue
+		if !t.IsZero() {
+			age = duration.HumanDuration(now.Sub(t))
+		}
+		if e.Count > 1 {
+			age += " (x" + strconv.Itoa(int(e.Count)) + ")"
+		}
+		h.Events = append(h.Events, hpaEvent{
+			Age:     age,
+			Type:    e.Type,
+			Reason:  e.Reason,
+			Message: e.Message,
+		})
+	}
+
+	return h
+}
@@ -60,7 +60,7 @@ func (s *ImageExtender) bindKeys(aa *ui.KeyActions) {
 	if s.App().Config.K9s.IsReadOnly() {
 		return
 	}
-	aa.Add(ui.KeyI, ui.NewKeyAction("Set Image", s.setImageCmd, false))
+	aa.Add(ui.KeyI, ui.NewKeyAction("Set Image", s.setImageCmd, false).WithID("setImage"))
 }
 
 func (s *ImageExtender) setImageCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// keyMapActions rebinds built-in actions per the key bindings in scope.
+func keyMapActions(app *App, scopes map[string]struct{}, aa *ui.KeyActions) error {
+	km := app.KeyMap
+
+	type rebind struct {
+		binding config.KeyBinding
+		action  ui.KeyAction
+	}
+	rr := make([]rebind, 0, len(km.Bindings))
+	for _, b := range km.For(scopes) {
+		if a, ok := detachAction(aa, b.Action); ok {
+			rr = append(rr, rebind{binding: b, action: a})
+		}
+	}
+
+	var errs error
+	for _, r := range rr {
+		kk := make([]tcell.Key, 0, len(r.binding.Keys))
+		for _, s := range r.binding.Keys {
+			k, err := asKey(s)
+			if err != nil {
+				errs = errors.Join(errs, fmt.Errorf("key binding %q: %w", r.binding.ID(), err))
+				break
+			}
+			kk = append(kk, k)
+		}
+		if len(kk) != len(r.binding.Keys) {
+			continue
+		}
+		if err := bindAction(app, aa, kk, r.action); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("key binding %q: %w", r.binding.ID(), err))
+		}
+	}
+
+	return errs
+}
+
+// resetKeyMap restores remapped actions to their default keys.
+func resetKeyMap(aa *ui.KeyActions) {
+	for _, a := range detachRemapped(aa) {
+		if _, ok := aa.Get(a.Default); ok {
+			continue
+		}
+		a.Opts.Remapped = false
+		aa.Add(a.Default, a)
+	}
+}
+
+func detachRemapped(aa *ui.KeyActions) []ui.KeyAction {
+	var (
+		kk []tcell.Key
+		rr []ui.KeyAction
+	)
+	aa.Range(func(k tcell.Key, a ui.KeyAction) {
+		kk = append(kk, k)
+	})
+	for _, k := range kk {
+		a, ok := aa.Get(k)
+		if !ok {
+			continue
+		}
+		if a.IsChord() {
+			rr = append(rr, detachRemapped(a.Chord)...)
+			if a.Chord.Len() == 0 {
+				aa.Delete(k)
+			}
+			continue
+		}
+		if a.Opts.Remapped {
+			aa.Delete(k)
+			rr = append(rr, a)
+		}
+	}
+
+	return rr
+}
+
+// detachAction removes all built-in actions matching an id and returns the first one.
+func detachAction(aa *ui.KeyActions, id string) (ui.KeyAction, bool) {
+	var kk []int
+	aa.Range(func(k tcell.Key, a ui.KeyAction) {
+		kk = append(kk, int(k))
+	})
+	sort.Ints(kk)
+
+	var (
+		action ui.KeyAction
+		found  bool
+	)
+	for _, k := range kk {
+		a, ok := aa.Get(tcell.Key(k))
+		if !ok {
+			continue
+		}
+		if a.IsChord() {
+			if ca, ok := detachAction(a.Chord, id); ok && !found {
+				action, found = ca, true
+			}
+			if a.Chord.Len() == 0 {
+				aa.Delete(tcell.Key(k))
+			}
+			continue
+		}
+		if a.Opts.Plugin || a.Opts.HotKey || a.ID() == "" || a.ID() != id {
+			continue
+		}
+		aa.Delete(tcell.Key(k))
+		if !found {
+			if !a.Opts.Remapped {
+				a.Opts.Remapped, a.Default = true, tcell.Key(k)
+			}
+			action, found = a, true
+		}
+	}
+
+	return action, found
+}
+
+// bindAction binds an action to a key sequence, registering chords as needed.
+func bindAction(app *App, aa *ui.KeyActions, kk []tcell.Key, a ui.KeyAction) error {
+	k := kk[0]
+	prev, ok := aa.Get(k)
+	if len(kk) == 1 {
+		if !ok {
+			aa.Add(k, a)
+			return nil
+		}
+		if prev.IsChord() {
+			return fmt.Errorf("key %q already prefixes a chord", tcell.KeyNames[k])
+		}
+		return fmt.Errorf("key %q already bound to %q", tcell.KeyNames[k], prev.Description)
+	}
+
+	if !ok {
+		chord := ui.NewKeyActions()
+		prev = ui.NewChordKeyAction(app.chordCmd(chord), chord)
+		aa.Add(k, prev)
+	}
+	if !prev.IsChord() {
+		return fmt.Errorf("key %q already bound to %q", tcell.KeyNames[k], prev.Description)
+	}
+
+	return bindAction(app, prev.Chord, kk[1:], a)
+}
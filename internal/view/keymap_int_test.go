@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/mock"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyMapRebind(t *testing.T) {
+	aa := ui.NewKeyActionsFromMap(ui.KeyMap{
+		ui.KeyS:      ui.NewKeyAction("Shell", nil, true).WithID("shell"),
+		ui.KeyShiftN: ui.NewKeyAction("Sort Name", nil, false).WithID("sortName"),
+		ui.KeyL:      ui.NewKeyAction("Logs", nil, true).WithID("logs"),
+		ui.Key1:      ui.NewKeyAction("default", nil, true),
+	})
+
+	_, ok := detachAction(aa, "default")
+	assert.False(t, ok)
+
+	a, ok := detachAction(aa, "sortName")
+	assert.True(t, ok)
+	assert.Equal(t, ui.KeyShiftN, a.Default)
+	assert.True(t, a.Opts.Remapped)
+	assert.NoError(t, bindAction(&App{}, aa, []tcell.Key{ui.KeyShiftX}, a))
+
+	_, ok = aa.Get(ui.KeyShiftN)
+	assert.False(t, ok)
+	a, ok = aa.Get(ui.KeyShiftX)
+	assert.True(t, ok)
+	assert.Equal(t, "Sort Name", a.Description)
+
+	resetKeyMap(aa)
+	_, ok = aa.Get(ui.KeyShiftX)
+	assert.False(t, ok)
+	a, ok = aa.Get(ui.KeyShiftN)
+	assert.True(t, ok)
+	assert.False(t, a.Opts.Remapped)
+}
+
+func TestKeyMapRebindConflict(t *testing.T) {
+	aa := ui.NewKeyActionsFromMap(ui.KeyMap{
+		ui.KeyS: ui.NewKeyAction("Shell", nil, true).WithID("shell"),
+		ui.KeyL: ui.NewKeyAction("Logs", nil, true).WithID("logs"),
+	})
+
+	a, ok := detachAction(aa, "shell")
+	assert.True(t, ok)
+	err := bindAction(&App{}, aa, []tcell.Key{ui.KeyL}, a)
+	assert.Equal(t, `key "l" already bound to "Logs"`, err.Error())
+
+	err = bindAction(&App{}, aa, []tcell.Key{ui.KeyL, ui.KeyS}, a)
+	assert.Equal(t, `key "l" already bound to "Logs"`, err.Error())
+}
+
+func TestKeyMapRebindChord(t *testing.T) {
+	app := App{}
+	aa := ui.NewKeyActionsFromMap(ui.KeyMap{
+		ui.KeyS: ui.NewKeyAction("Shell", nil, true).WithID("shell"),
+		ui.KeyL: ui.NewKeyAction("Logs", nil, true).WithID("logs"),
+	})
+
+	for _, id := range []string{"shell", "logs"} {
+		a, ok := detachAction(aa, id)
+		assert.True(t, ok)
+		assert.NoError(t, bindAction(&app, aa, []tcell.Key{ui.KeyG, a.Default}, a))
+	}
+	assert.Equal(t, 1, aa.Len())
+	c, ok := aa.Get(ui.KeyG)
+	assert.True(t, ok)
+	assert.True(t, c.IsChord())
+	assert.Equal(t, 2, c.Chord.Len())
+
+	c.Action(nil)
+	assert.Equal(t, c.Chord, app.chord)
+
+	err := bindAction(&app, aa, []tcell.Key{ui.KeyG}, ui.NewKeyAction("Fred", nil, true))
+	assert.Equal(t, `key "g" already prefixes a chord`, err.Error())
+
+	resetKeyMap(aa)
+	assert.Equal(t, 2, aa.Len())
+	_, ok = aa.Get(ui.KeyS)
+	assert.True(t, ok)
+}
+
+func TestAppKeyMapChanged(t *testing.T) {
+	a := NewApp(mock.NewMockConfig())
+	a.bindKeys()
+
+	a.KeyMap = config.KeyMap{Bindings: map[string]string{"palette": "Ctrl-K"}}
+	a.KeyMapChanged()
+	_, ok := a.GetActions().Get(tcell.KeyCtrlY)
+	assert.False(t, ok)
+	k, ok := a.GetActions().Get(tcell.KeyCtrlK)
+	assert.True(t, ok)
+	assert.Equal(t, "palette", k.ID())
+
+	a.KeyMap = config.NewKeyMap()
+	a.KeyMapChanged()
+	_, ok = a.GetActions().Get(tcell.KeyCtrlK)
+	assert.False(t, ok)
+	k, ok = a.GetActions().Get(tcell.KeyCtrlY)
+	assert.True(t, ok)
+	assert.Equal(t, "palette", k.ID())
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
@@ -20,8 +21,9 @@ import (
 )
 
 const (
-	liveViewTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
-	yamlAction       = "YAML"
+	liveViewTitleFmt  = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
+	yamlAction        = "YAML"
+	liveViewIssuesFmt = "[red:bg:b]<issues:%d>[fg:bg:-] "
 )
 
 // LiveView represents a live text viewer.
@@ -39,6 +41,10 @@ type LiveView struct {
 	fullScreen                bool
 	managedField              bool
 	autoRefresh               bool
+	editor                    *ui.Editor
+	editActions               *ui.KeyActions
+	schema                    *dao.Schema
+	issues                    int
 }
 
 // NewLiveView returns a live viewer.
@@ -140,26 +146,26 @@ func (v *LiveView) BufferActive(state bool, k model.BufferKind) {
 
 func (v *LiveView) bindKeys() {
 	v.actions.Bulk(ui.KeyMap{
-		tcell.KeyEnter:  ui.NewSharedKeyAction("Filter", v.filterCmd, false),
-		tcell.KeyEscape: ui.NewKeyAction("Back", v.resetCmd, false),
-		tcell.KeyCtrlS:  ui.NewKeyAction("Save", v.saveCmd, false),
-		ui.KeyC:         ui.NewKeyAction("Copy", cpCmd(v.app.Flash(), v.text), true),
-		ui.KeyF:         ui.NewKeyAction("Toggle FullScreen", v.toggleFullScreenCmd, true),
-		ui.KeyR:         ui.NewKeyAction("Toggle Auto-Refresh", v.toggleRefreshCmd, true),
-		ui.KeyN:         ui.NewKeyAction("Next Match", v.nextCmd, true),
-		ui.KeyShiftN:    ui.NewKeyAction("Prev Match", v.prevCmd, true),
-		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", v.activateCmd, false),
-		tcell.KeyDelete: ui.NewSharedKeyAction("Erase", v.eraseCmd, false),
+		tcell.KeyEnter:  ui.NewSharedKeyAction("Filter", v.filterCmd, false).WithID("filter"),
+		tcell.KeyEscape: ui.NewKeyAction("Back", v.resetCmd, false).WithID("back"),
+		tcell.KeyCtrlS:  ui.NewKeyAction("Save", v.saveCmd, false).WithID("save"),
+		ui.KeyC:         ui.NewKeyAction("Copy", cpCmd(v.app.Flash(), v.text), true).WithID("copy"),
+		ui.KeyF:         ui.NewKeyAction("Toggle FullScreen", v.toggleFullScreenCmd, true).WithID("toggleFullScreen"),
+		ui.KeyR:         ui.NewKeyAction("Toggle Auto-Refresh", v.toggleRefreshCmd, true).WithID("toggleAutoRefresh"),
+		ui.KeyN:         ui.NewKeyAction("Next Match", v.nextCmd, true).WithID("nextMatch"),
+		ui.KeyShiftN:    ui.NewKeyAction("Prev Match", v.prevCmd, true).WithID("prevMatch"),
+		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", v.activateCmd, false).WithID("filterMode"),
+		tcell.KeyDelete: ui.NewSharedKeyAction("Erase", v.eraseCmd, false).WithID("erase"),
 	})
 
 	if !v.app.Config.K9s.IsReadOnly() {
-		v.actions.Add(ui.KeyE, ui.NewKeyAction("Edit", v.editCmd, true))
+		v.actions.Add(ui.KeyE, ui.NewKeyAction("Edit", v.editCmd, true).WithID("edit"))
 	}
 	if v.title == yamlAction {
-		v.actions.Add(ui.KeyM, ui.NewKeyAction("Toggle ManagedFields", v.toggleManagedCmd, true))
+		v.actions.Add(ui.KeyM, ui.NewKeyAction("Toggle ManagedFields", v.toggleManagedCmd, true).WithID("toggleManagedFields"))
 	}
 	if v.model != nil && v.model.GVR().IsDecodable() {
-		v.actions.Add(ui.KeyX, ui.NewKeyAction("Toggle Decode", v.toggleEncodedDecodedCmd, true))
+		v.actions.Add(ui.KeyX, ui.NewKeyAction("Toggle Decode", v.toggleEncodedDecodedCmd, true).WithID("toggleDecode"))
 	}
 }
 
@@ -180,6 +186,9 @@ func (v *LiveView) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if path == "" {
 		return evt
 	}
+	if v.canEditInline() {
+		return v.inlineEditCmd(evt)
+	}
 	v.Stop()
 	defer v.Start()
 	if err := editRes(v.app, v.model.GVR(), path); err != nil {
@@ -204,7 +213,11 @@ func (v *LiveView) toggleRefreshCmd(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (v *LiveView) keyboard(evt *tcell.EventKey) *tcell.EventKey {
-	if a, ok := v.actions.Get(ui.AsKey(evt)); ok {
+	aa := v.actions
+	if v.editor != nil {
+		aa = v.editActions
+	}
+	if a, ok := aa.Get(ui.AsKey(evt)); ok {
 		return a.Action(evt)
 	}
 
@@ -257,6 +270,9 @@ func (v *LiveView) Stop() {
 
 // Hints returns menu hints.
 func (v *LiveView) Hints() model.MenuHints {
+	if v.editor != nil {
+		return v.editActions.Hints()
+	}
 	return v.actions.Hints()
 }
 
@@ -388,7 +404,14 @@ func (v *LiveView) updateTitle() {
 	}
 	var fmat string
 	if v.model != nil {
-		fmat = fmt.Sprintf(liveViewTitleFmt, v.title, v.model.GetPath())
+		title := v.title
+		if v.editor != nil {
+			title = editTitle + " " + title
+		}
+		fmat = fmt.Sprintf(liveViewTitleFmt, title, v.model.GetPath())
+	}
+	if v.issues > 0 {
+		fmat += fmt.Sprintf(liveViewIssuesFmt, v.issues)
 	}
 
 	buff := v.cmdBuff.GetText()
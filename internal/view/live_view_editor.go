@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/rs/zerolog/log"
+)
+
+const editTitle = "Edit"
+
+// canEditInline checks if the built-in editor should be used. Decodable views
+// are edited externally as their content may not reflect the raw resource.
+func (v *LiveView) canEditInline() bool {
+	if !v.app.Config.K9s.UI.InlineEditor || v.title != yamlAction || v.model == nil {
+		return false
+	}
+	_, ok := v.model.(model.EncDecResourceViewer)
+
+	return !ok
+}
+
+// rawYAML returns the resource manifest as stored on the cluster.
+func (v *LiveView) rawYAML(path string) (string, error) {
+	acc, err := dao.AccessorFor(v.app.factory, v.model.GVR())
+	if err != nil {
+		return "", err
+	}
+	desc, ok := acc.(dao.Describer)
+	if !ok {
+		return "", fmt.Errorf("no describer for %q", v.model.GVR())
+	}
+
+	return desc.ToYAML(path, false)
+}
+
+func (v *LiveView) inlineEditCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := v.model.GetPath()
+	if path == "" {
+		return evt
+	}
+	// Edits the raw manifest rather than the currently viewed representation.
+	raw, err := v.rawYAML(path)
+	if err != nil {
+		v.app.Flash().Err(err)
+		return nil
+	}
+	if v.cancel != nil {
+		v.cancel()
+		v.cancel = nil
+	}
+
+	v.editor = ui.NewEditor()
+	v.editor.SetBackgroundColor(v.app.Styles.BgColor())
+	v.editor.SetStyles(v.app.Styles.FgColor(), v.app.Styles.Views().Yaml)
+	v.editor.SetText(raw)
+	v.editor.SetChangedFunc(v.validateEdit)
+	v.editor.SetCompleteFunc(v.completeEdit)
+	v.editor.SetSuggestFunc(func(ss []string) {
+		v.app.Flash().Info(strings.Join(ss, " | "))
+	})
+	v.RemoveItem(v.text)
+	v.AddItem(v.editor, 0, 1, true)
+	v.app.SetFocus(v.editor)
+	v.bindEditKeys()
+	v.app.Menu().HydrateMenu(v.Hints())
+	v.updateTitle()
+
+	go v.loadSchema()
+
+	return nil
+}
+
+func (v *LiveView) loadSchema() {
+	s, err := dao.NewSchema(v.app.factory)
+	if err != nil {
+		log.Warn().Err(err).Msgf("OpenAPI schema load failed")
+		v.app.Flash().Warnf("Schema validation unavailable: %s", err)
+		return
+	}
+	v.app.QueueUpdateDraw(func() {
+		v.schema = s
+		v.validateEdit()
+	})
+}
+
+func (v *LiveView) bindEditKeys() {
+	v.editActions = ui.NewKeyActionsFromMap(ui.KeyMap{
+		tcell.KeyEscape: ui.NewKeyAction("Cancel", v.cancelEditCmd, true).WithID("cancel"),
+		tcell.KeyCtrlS: ui.NewKeyActionWithOpts("Save", v.saveEditCmd, ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}).WithID("save"),
+		tcell.KeyCtrlSpace: ui.NewKeyAction("Complete", passThroughCmd, true).WithID("complete"),
+	})
+}
+
+// passThroughCmd lets the editor handle the key.
+func passThroughCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return evt
+}
+
+func (v *LiveView) completeEdit(lines []string, row int, prefix string) []string {
+	if v.schema == nil {
+		return nil
+	}
+
+	return v.schema.Complete(lines, row, prefix)
+}
+
+func (v *LiveView) validateEdit() {
+	if v.editor == nil {
+		return
+	}
+	ii, err := v.schema.Validate(v.editor.GetText())
+	if err != nil {
+		log.Debug().Err(err).Msgf("Schema validation skipped")
+	}
+	mm := make(map[int]string, len(ii))
+	for _, i := range ii {
+		mm[i.Line] = i.Error()
+	}
+	v.editor.SetIssues(mm)
+	v.issues = len(ii)
+	v.updateTitle()
+	if msg, ok := v.editor.IssueAt(); ok {
+		v.app.Flash().Warn(msg)
+	}
+}
+
+func (v *LiveView) saveEditCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.issues > 0 {
+		v.app.Flash().Errf("Resolve %d schema issue(s) before saving", v.issues)
+		return nil
+	}
+
+	path, raw := v.model.GetPath(), v.editor.GetText()
+	if err := dao.UpdateYAML(context.Background(), v.app.factory, v.model.GVR(), path, raw, true); err != nil {
+		v.app.Flash().Errf("Dry-run failed: %s", err)
+		return nil
+	}
+	msg := fmt.Sprintf("Dry-run succeeded. Save changes to %s?", path)
+	dialog.ShowConfirm(v.app.Styles.Dialog(), v.app.Content.Pages, "Confirm Save", msg, func() {
+		if err := dao.UpdateYAML(context.Background(), v.app.factory, v.model.GVR(), path, raw, false); err != nil {
+			v.app.Flash().Err(err)
+			return
+		}
+		v.app.Flash().Infof("%s saved successfully!", path)
+		v.exitEdit()
+	}, func() {
+		v.app.SetFocus(v.editor)
+	})
+
+	return nil
+}
+
+func (v *LiveView) cancelEditCmd(evt *tcell.EventKey) *tcell.EventKey {
+	v.exitEdit()
+	v.app.Flash().Info("Edit canceled")
+
+	return nil
+}
+
+func (v *LiveView) exitEdit() {
+	v.RemoveItem(v.editor)
+	v.AddItem(v.text, 0, 1, true)
+	v.editor, v.editActions, v.schema, v.issues = nil, nil, nil, 0
+	v.app.SetFocus(v.text)
+	v.app.Menu().HydrateMenu(v.Hints())
+	v.updateTitle()
+	v.Start()
+}
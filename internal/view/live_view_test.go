@@ -7,8 +7,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/config/mock"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -25,3 +27,36 @@ apiVersion: v1
 
 	assert.Equal(t, s, sanitizeEsc(v.text.GetText(true)))
 }
+
+func TestLiveViewCanEditInline(t *testing.T) {
+	gvr := client.NewGVR("v1/secrets")
+	uu := map[string]struct {
+		title string
+		m     model.ResourceViewer
+		e     bool
+	}{
+		"yaml": {
+			title: yamlAction,
+			m:     model.NewYAML(gvr, "default/s1"),
+			e:     true,
+		},
+		"decodable": {
+			title: yamlAction,
+			m:     model.NewDescribe(gvr, "default/s1"),
+		},
+		"describe": {
+			title: "Describe",
+			m:     model.NewYAML(gvr, "default/s1"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			app := NewApp(mock.NewMockConfig())
+			app.Config.K9s.UI.InlineEditor = true
+			v := NewLiveView(app, u.title, u.m)
+			assert.Equal(t, u.e, v.canEditInline())
+		})
+	}
+}
@@ -243,26 +243,30 @@ func (l *Log) Name() string { return logTitle }
 
 func (l *Log) bindKeys() {
 	l.logs.Actions().Bulk(ui.KeyMap{
-		ui.Key0:         ui.NewKeyAction("tail", l.sinceCmd(-1), true),
-		ui.Key1:         ui.NewKeyAction("head", l.sinceCmd(0), true),
-		ui.Key2:         ui.NewKeyAction("1m", l.sinceCmd(60), true),
-		ui.Key3:         ui.NewKeyAction("5m", l.sinceCmd(5*60), true),
-		ui.Key4:         ui.NewKeyAction("15m", l.sinceCmd(15*60), true),
-		ui.Key5:         ui.NewKeyAction("30m", l.sinceCmd(30*60), true),
-		ui.Key6:         ui.NewKeyAction("1h", l.sinceCmd(60*60), true),
-		tcell.KeyEnter:  ui.NewSharedKeyAction("Filter", l.filterCmd, false),
-		tcell.KeyEscape: ui.NewKeyAction("Back", l.resetCmd, false),
-		ui.KeyShiftC:    ui.NewKeyAction("Clear", l.clearCmd, true),
-		ui.KeyM:         ui.NewKeyAction("Mark", l.markCmd, true),
-		ui.KeyS:         ui.NewKeyAction("Toggle AutoScroll", l.toggleAutoScrollCmd, true),
-		ui.KeyF:         ui.NewKeyAction("Toggle FullScreen", l.toggleFullScreenCmd, true),
-		ui.KeyT:         ui.NewKeyAction("Toggle Timestamp", l.toggleTimestampCmd, true),
-		ui.KeyW:         ui.NewKeyAction("Toggle Wrap", l.toggleTextWrapCmd, true),
-		tcell.KeyCtrlS:  ui.NewKeyAction("Save", l.SaveCmd, true),
-		ui.KeyC:         ui.NewKeyAction("Copy", cpCmd(l.app.Flash(), l.logs.TextView), true),
+		ui.Key0:         ui.NewKeyAction("tail", l.sinceCmd(-1), true).WithID("sinceTail"),
+		ui.Key1:         ui.NewKeyAction("head", l.sinceCmd(0), true).WithID("sinceHead"),
+		ui.Key2:         ui.NewKeyAction("1m", l.sinceCmd(60), true).WithID("since1m"),
+		ui.Key3:         ui.NewKeyAction("5m", l.sinceCmd(5*60), true).WithID("since5m"),
+		ui.Key4:         ui.NewKeyAction("15m", l.sinceCmd(15*60), true).WithID("since15m"),
+		ui.Key5:         ui.NewKeyAction("30m", l.sinceCmd(30*60), true).WithID("since30m"),
+		ui.Key6:         ui.NewKeyAction("1h", l.sinceCmd(60*60), true).WithID("since1h"),
+		tcell.KeyEnter:  ui.NewSharedKeyAction("Filter", l.filterCmd, false).WithID("filter"),
+		tcell.KeyEscape: ui.NewKeyAction("Back", l.resetCmd, false).WithID("back"),
+		ui.KeyShiftC:    ui.NewKeyAction("Clear", l.clearCmd, true).WithID("clear"),
+		ui.KeyM:         ui.NewKeyAction("Mark", l.markCmd, true).WithID("mark"),
+		ui.KeyS:         ui.NewKeyAction("Toggle AutoScroll", l.toggleAutoScrollCmd, true).WithID("toggleAutoScroll"),
+		ui.KeyF:         ui.NewKeyAction("Toggle FullScreen", l.toggleFullScreenCmd, true).WithID("toggleFullScreen"),
+		ui.KeyT:         ui.NewKeyAction("Toggle Timestamp", l.toggleTimestampCmd, true).WithID("toggleTimestamp"),
+		ui.KeyW:         ui.NewKeyAction("Toggle Wrap", l.toggleTextWrapCmd, true).WithID("toggleWrap"),
+		tcell.KeyCtrlS:  ui.NewKeyAction("Save", l.SaveCmd, true).WithID("save"),
+		ui.KeyC:         ui.NewKeyAction("Copy", cpCmd(l.app.Flash(), l.logs.TextView), true).WithID("copy"),
 	})
 	if l.model.HasDefaultContainer() {
-		l.logs.Actions().Add(ui.KeyA, ui.NewKeyAction("Toggle AllContainers", l.toggleAllContainers, true))
+		l.logs.Actions().Add(ui.KeyA, ui.NewKeyAction("Toggle AllContainers", l.toggleAllContainers, true).WithID("toggleAllContainers"))
+	}
+	if err := keyMapActions(l.app, map[string]struct{}{"log": {}}, l.logs.Actions()); err != nil {
+		log.Warn().Msgf("Key bindings load failed: %s", err)
+		l.app.Logo().Warn("Key bindings load failed!")
 	}
 }
 
@@ -70,11 +70,11 @@ func (l *Logger) BufferActive(state bool, k model.BufferKind) {
 
 func (l *Logger) bindKeys() {
 	l.actions.Bulk(ui.KeyMap{
-		tcell.KeyEscape: ui.NewKeyAction("Back", l.resetCmd, false),
-		tcell.KeyCtrlS:  ui.NewKeyAction("Save", l.saveCmd, false),
-		ui.KeyC:         ui.NewKeyAction("Copy", cpCmd(l.app.Flash(), l.TextView), true),
-		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", l.activateCmd, false),
-		tcell.KeyDelete: ui.NewSharedKeyAction("Erase", l.eraseCmd, false),
+		tcell.KeyEscape: ui.NewKeyAction("Back", l.resetCmd, false).WithID("back"),
+		tcell.KeyCtrlS:  ui.NewKeyAction("Save", l.saveCmd, false).WithID("save"),
+		ui.KeyC:         ui.NewKeyAction("Copy", cpCmd(l.app.Flash(), l.TextView), true).WithID("copy"),
+		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", l.activateCmd, false).WithID("filterMode"),
+		tcell.KeyDelete: ui.NewSharedKeyAction("Erase", l.eraseCmd, false).WithID("erase"),
 	})
 }
 
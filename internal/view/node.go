@@ -48,7 +48,7 @@ func (n *Node) bindDangerousKeys(aa *ui.KeyActions) {
 				Visible:   true,
 				Dangerous: true,
 			},
-		),
+		).WithID("cordon"),
 		ui.KeyU: ui.NewKeyActionWithOpts(
 			"Uncordon",
 			n.toggleCordonCmd(false),
@@ -56,7 +56,7 @@ func (n *Node) bindDangerousKeys(aa *ui.KeyActions) {
 				Visible:   true,
 				Dangerous: true,
 			},
-		),
+		).WithID("uncordon"),
 		ui.KeyR: ui.NewKeyActionWithOpts(
 			"Drain",
 			n.drainCmd,
@@ -64,7 +64,23 @@ func (n *Node) bindDangerousKeys(aa *ui.KeyActions) {
 				Visible:   true,
 				Dangerous: true,
 			},
-		),
+		).WithID("drain"),
+		ui.KeyB: ui.NewKeyActionWithOpts(
+			"Debug",
+			n.debugCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			},
+		).WithID("debug"),
+		ui.KeyShiftB: ui.NewKeyActionWithOpts(
+			"Debug Cleanup",
+			n.debugCleanupCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			},
+		).WithID("debugCleanup"),
 	})
 	ct, err := n.App().Config.K9s.ActiveContext()
 	if err != nil {
@@ -72,7 +88,7 @@ func (n *Node) bindDangerousKeys(aa *ui.KeyActions) {
 		return
 	}
 	if ct.FeatureGates.NodeShell {
-		aa.Add(ui.KeyS, ui.NewKeyAction("Shell", n.sshCmd, true))
+		aa.Add(ui.KeyS, ui.NewKeyAction("Shell", n.sshCmd, true).WithID("shell"))
 	}
 }
 
@@ -82,11 +98,11 @@ func (n *Node) bindKeys(aa *ui.KeyActions) {
 	}
 
 	aa.Bulk(ui.KeyMap{
-		ui.KeyY:      ui.NewKeyAction(yamlAction, n.yamlCmd, true),
-		ui.KeyShiftR: ui.NewKeyAction("Sort ROLE", n.GetTable().SortColCmd("ROLE", true), false),
-		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", n.GetTable().SortColCmd(cpuCol, false), false),
-		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", n.GetTable().SortColCmd(memCol, false), false),
-		ui.KeyShiftO: ui.NewKeyAction("Sort Pods", n.GetTable().SortColCmd("PODS", false), false),
+		ui.KeyY:      ui.NewKeyAction(yamlAction, n.yamlCmd, true).WithID("yaml"),
+		ui.KeyShiftR: ui.NewKeyAction("Sort ROLE", n.GetTable().SortColCmd("ROLE", true), false).WithID("sortRole"),
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", n.GetTable().SortColCmd(cpuCol, false), false).WithID("sortCPU"),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", n.GetTable().SortColCmd(memCol, false), false).WithID("sortMEM"),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Pods", n.GetTable().SortColCmd("PODS", false), false).WithID("sortPods"),
 	})
 }
 
@@ -94,6 +110,22 @@ func (n *Node) showPods(a *App, _ ui.Tabular, _ client.GVR, path string) {
 	showPods(a, n.GetTable().GetSelectedItem(), client.BlankNamespace, "spec.nodeName="+path)
 }
 
+func (n *Node) debugCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	debugNode(n.App(), n, path)
+
+	return nil
+}
+
+func (n *Node) debugCleanupCmd(evt *tcell.EventKey) *tcell.EventKey {
+	cleanupDebugPods(n.App())
+
+	return nil
+}
+
 func (n *Node) drainCmd(evt *tcell.EventKey) *tcell.EventKey {
 	sels := n.GetTable().GetSelectedItems()
 	if len(sels) == 0 {
@@ -34,8 +34,8 @@ func NewNamespace(gvr client.GVR) ResourceViewer {
 
 func (n *Namespace) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
-		ui.KeyU:      ui.NewKeyAction("Use", n.useNsCmd, true),
-		ui.KeyShiftS: ui.NewKeyAction("Sort Status", n.GetTable().SortColCmd(statusCol, true), false),
+		ui.KeyU:      ui.NewKeyAction("Use", n.useNsCmd, true).WithID("use"),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", n.GetTable().SortColCmd(statusCol, true), false).WithID("sortStatus"),
 	})
 }
 
@@ -34,7 +34,7 @@ func NewOwnerExtender(r ResourceViewer) ResourceViewer {
 }
 
 func (v *OwnerExtender) bindKeys(aa *ui.KeyActions) {
-	aa.Add(ui.KeyShiftJ, ui.NewKeyAction("Jump Owner", v.ownerCmd, true))
+	aa.Add(ui.KeyShiftJ, ui.NewKeyAction("Jump Owner", v.ownerCmd, true).WithID("jumpOwner"))
 }
 
 func (v *OwnerExtender) ownerCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -6,6 +6,7 @@ package view
 import (
 	"context"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
 )
@@ -15,6 +16,7 @@ type PageStack struct {
 	*ui.Pages
 
 	app *App
+	cmd string
 }
 
 // NewPageStack returns a new page stack.
@@ -54,3 +56,26 @@ func (p *PageStack) StackTop(top model.Component) {
 	top.Start()
 	p.app.SetFocus(top)
 }
+
+// namespace returns the top resource view namespace if any.
+func (p *PageStack) namespace() (string, bool) {
+	v, ok := p.Top().(ResourceViewer)
+	if !ok {
+		return "", false
+	}
+	ns := v.GetTable().GetModel().GetNamespace()
+	if client.IsClusterScoped(ns) || ns == client.NotNamespaced {
+		return "", false
+	}
+	if ns == client.BlankNamespace {
+		ns = client.NamespaceAll
+	}
+
+	return ns, true
+}
+
+// close stops all the page components.
+func (p *PageStack) close() {
+	p.Stack.RemoveListener(p)
+	p.Stack.Clear()
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+const (
+	paletteKey   = "palette"
+	paletteTitle = " Command Palette "
+
+	paletteAction = "action"
+	palettePlugin = "plugin"
+	paletteHotKey = "hotkey"
+	paletteView   = "view"
+	paletteQuery  = "query"
+)
+
+type actioner interface {
+	Actions() *ui.KeyActions
+}
+
+func showPalette(app *App) {
+	p := ui.NewPalette(app.Styles.Dialog(), paletteTitle, paletteItems(app))
+	p.SetDoneFunc(func(item *ui.PaletteItem) {
+		app.Content.RemovePage(paletteKey)
+		if top := app.Content.Top(); top != nil {
+			app.SetFocus(top)
+		}
+		if item != nil && item.Run != nil {
+			item.Run()
+		}
+	})
+	app.Content.AddPage(paletteKey, p, false, false)
+	app.Content.ShowPage(paletteKey)
+}
+
+// paletteItems collects the current view actions, resource views and saved queries.
+func paletteItems(app *App) ui.PaletteItems {
+	aa := ui.NewKeyActions()
+	if top, ok := app.Content.Top().(actioner); ok {
+		aa.Merge(top.Actions())
+	}
+	aa.Merge(app.GetActions())
+	aa.Delete(tcell.KeyCtrlY)
+
+	ii := actionItems(aa, "")
+	ii = append(ii, viewItems(app)...)
+
+	return append(ii, queryItems(app)...)
+}
+
+func actionItems(aa *ui.KeyActions, prefix string) ui.PaletteItems {
+	kk := make([]int, 0, aa.Len())
+	aa.Range(func(k tcell.Key, a ui.KeyAction) {
+		if a.Description != "" || a.IsChord() {
+			kk = append(kk, int(k))
+		}
+	})
+	sort.Ints(kk)
+
+	ii := make(ui.PaletteItems, 0, len(kk))
+	for _, k := range kk {
+		key := tcell.Key(k)
+		a, ok := aa.Get(key)
+		if !ok {
+			continue
+		}
+		if a.IsChord() {
+			ii = append(ii, actionItems(a.Chord, prefix+tcell.KeyNames[key]+" ")...)
+			continue
+		}
+		kind := paletteAction
+		switch {
+		case a.Opts.Plugin:
+			kind = palettePlugin
+		case a.Opts.HotKey:
+			kind = paletteHotKey
+		}
+		ii = append(ii, ui.PaletteItem{
+			Kind: kind,
+			Name: a.Description,
+			Key:  prefix + tcell.KeyNames[key],
+			Run: func() {
+				a.Action(ui.AsEventKey(key))
+			},
+		})
+	}
+
+	return ii
+}
+
+func viewItems(app *App) ui.PaletteItems {
+	if app.command == nil || app.command.alias == nil {
+		return nil
+	}
+	ss := app.command.alias.ShortNames()
+	ii := make(ui.PaletteItems, 0, len(ss))
+	for gvr, aa := range ss {
+		sort.Strings(aa)
+		cmd := aa[0]
+		ii = append(ii, ui.PaletteItem{
+			Kind:        paletteView,
+			Name:        gvr,
+			Key:         cmd,
+			Description: strings.Join(aa, ","),
+			Run: func() {
+				app.gotoResource(cmd, "", true)
+			},
+		})
+	}
+	sort.Slice(ii, func(i, j int) bool {
+		return ii[i].Name < ii[j].Name
+	})
+
+	return ii
+}
+
+func queryItems(app *App) ui.PaletteItems {
+	qq := loadQueries(app)
+	nn := make([]string, 0, len(qq.Query))
+	for n := range qq.Query {
+		nn = append(nn, n)
+	}
+	sort.Strings(nn)
+
+	ii := make(ui.PaletteItems, 0, len(nn))
+	for _, n := range nn {
+		q := qq.Query[n]
+		ii = append(ii, ui.PaletteItem{
+			Kind:        paletteQuery,
+			Name:        n,
+			Key:         q.ShortCut,
+			Description: queryDesc(q),
+			Run: func() {
+				if err := runQuery(app, q); err != nil {
+					dialog.ShowError(app.Styles.Dialog(), app.Content.Pages, err.Error())
+				}
+			},
+		})
+	}
+
+	return ii
+}
+
+func queryDesc(q config.Query) string {
+	if q.Description != "" {
+		return q.Description + " (" + q.Line() + ")"
+	}
+
+	return q.Line()
+}
@@ -53,11 +53,11 @@ func (p *PortForward) portForwardContext(ctx context.Context) context.Context {
 
 func (p *PortForward) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
-		tcell.KeyEnter: ui.NewKeyAction("View Benchmarks", p.showBenchCmd, true),
-		ui.KeyB:        ui.NewKeyAction("Benchmark Run/Stop", p.toggleBenchCmd, true),
-		tcell.KeyCtrlD: ui.NewKeyAction("Delete", p.deleteCmd, true),
-		ui.KeyShiftP:   ui.NewKeyAction("Sort Ports", p.GetTable().SortColCmd("PORTS", true), false),
-		ui.KeyShiftU:   ui.NewKeyAction("Sort URL", p.GetTable().SortColCmd("URL", true), false),
+		tcell.KeyEnter: ui.NewKeyAction("View Benchmarks", p.showBenchCmd, true).WithID("viewBenchmarks"),
+		ui.KeyB:        ui.NewKeyAction("Benchmark Run/Stop", p.toggleBenchCmd, true).WithID("benchmarkRunStop"),
+		tcell.KeyCtrlD: ui.NewKeyAction("Delete", p.deleteCmd, true).WithID("delete"),
+		ui.KeyShiftP:   ui.NewKeyAction("Sort Ports", p.GetTable().SortColCmd("PORTS", true), false).WithID("sortPorts"),
+		ui.KeyShiftU:   ui.NewKeyAction("Sort URL", p.GetTable().SortColCmd("URL", true), false).WithID("sortURL"),
 	})
 }
 
@@ -38,8 +38,8 @@ func NewPortForwardExtender(r ResourceViewer) ResourceViewer {
 
 func (p *PortForwardExtender) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
-		ui.KeyF:      ui.NewKeyAction("Show PortForward", p.showPFCmd, true),
-		ui.KeyShiftF: ui.NewKeyAction("Port-Forward", p.portFwdCmd, true),
+		ui.KeyF:      ui.NewKeyAction("Show PortForward", p.showPFCmd, true).WithID("showPortForward"),
+		ui.KeyShiftF: ui.NewKeyAction("Port-Forward", p.portFwdCmd, true).WithID("portForward"),
 	})
 }
 
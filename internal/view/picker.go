@@ -38,7 +38,7 @@ func (p *Picker) Init(ctx context.Context) error {
 	}
 
 	pickerView := app.Styles.Views().Picker
-	p.actions.Add(tcell.KeyEscape, ui.NewKeyAction("Back", app.PrevCmd, true))
+	p.actions.Add(tcell.KeyEscape, ui.NewKeyAction("Back", app.PrevCmd, true).WithID("back"))
 
 	p.SetBorder(true)
 	p.SetMainTextColor(pickerView.MainColor.Color())
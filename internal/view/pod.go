@@ -90,35 +90,42 @@ func (p *Pod) bindDangerousKeys(aa *ui.KeyActions) {
 			ui.ActionOpts{
 				Visible:   true,
 				Dangerous: true,
-			}),
+			}).WithID("kill"),
 		ui.KeyS: ui.NewKeyActionWithOpts(
 			"Shell",
 			p.shellCmd,
 			ui.ActionOpts{
 				Visible:   true,
 				Dangerous: true,
-			}),
+			}).WithID("shell"),
 		ui.KeyA: ui.NewKeyActionWithOpts(
 			"Attach",
 			p.attachCmd,
 			ui.ActionOpts{
 				Visible:   true,
 				Dangerous: true,
-			}),
+			}).WithID("attach"),
 		ui.KeyT: ui.NewKeyActionWithOpts(
 			"Transfer",
 			p.transferCmd,
 			ui.ActionOpts{
 				Visible:   true,
 				Dangerous: true,
-			}),
+			}).WithID("transfer"),
 		ui.KeyZ: ui.NewKeyActionWithOpts(
 			"Sanitize",
 			p.sanitizeCmd,
 			ui.ActionOpts{
 				Visible:   true,
 				Dangerous: true,
-			}),
+			}).WithID("sanitize"),
+		ui.KeyB: ui.NewKeyActionWithOpts(
+			"Debug",
+			p.debugCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}).WithID("debug"),
 	})
 }
 
@@ -128,12 +135,13 @@ func (p *Pod) bindKeys(aa *ui.KeyActions) {
 	}
 
 	aa.Bulk(ui.KeyMap{
-		ui.KeyO:      ui.NewKeyAction("Show Node", p.showNode, true),
-		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", p.GetTable().SortColCmd(readyCol, true), false),
-		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", p.GetTable().SortColCmd("RESTARTS", false), false),
-		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(statusCol, true), false),
-		ui.KeyShiftI: ui.NewKeyAction("Sort IP", p.GetTable().SortColCmd("IP", true), false),
-		ui.KeyShiftO: ui.NewKeyAction("Sort Node", p.GetTable().SortColCmd("NODE", true), false),
+		ui.KeyO:      ui.NewKeyAction("Show Node", p.showNode, true).WithID("showNode"),
+		ui.KeyShiftB: ui.NewKeyAction("Debug Containers", p.debugContainersCmd, true).WithID("debugContainers"),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", p.GetTable().SortColCmd(readyCol, true), false).WithID("sortReady"),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", p.GetTable().SortColCmd("RESTARTS", false), false).WithID("sortRestart"),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(statusCol, true), false).WithID("sortStatus"),
+		ui.KeyShiftI: ui.NewKeyAction("Sort IP", p.GetTable().SortColCmd("IP", true), false).WithID("sortIP"),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Node", p.GetTable().SortColCmd("NODE", true), false).WithID("sortNode"),
 	})
 	aa.Merge(resourceSorters(p.GetTable()))
 }
@@ -261,6 +269,37 @@ func (p *Pod) attachCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (p *Pod) debugCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if !podIsRunning(p.App().factory, path) {
+		p.App().Flash().Errf("%s is not in a running state", path)
+		return nil
+	}
+
+	if err := containerDebugIn(p.App(), p, path); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (p *Pod) debugContainersCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if err := showDebugContainers(p.App(), path); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (p *Pod) sanitizeCmd(evt *tcell.EventKey) *tcell.EventKey {
 	res, err := dao.AccessorFor(p.App().factory, p.GVR())
 	if err != nil {
@@ -430,6 +469,25 @@ func containerAttachIn(a *App, comp model.Component, path, co string) error {
 	return nil
 }
 
+func containerDebugIn(a *App, comp model.Component, path string) error {
+	pod, err := fetchPod(a.factory, path)
+	if err != nil {
+		return err
+	}
+	cc := fetchContainers(pod.ObjectMeta, pod.Spec, false)
+	if len(cc) == 1 {
+		debugPod(a, comp, path, cc[0])
+		return nil
+	}
+	picker := NewPicker()
+	picker.populate(cc)
+	picker.SetSelectedFunc(func(_ int, co, _ string, _ rune) {
+		debugPod(a, comp, path, co)
+	})
+
+	return a.inject(picker, false)
+}
+
 func resumeAttachIn(a *App, c model.Component, path, co string) {
 	c.Stop()
 	defer c.Start()
@@ -554,11 +612,11 @@ func osFromSelector(s map[string]string) (string, bool) {
 
 func resourceSorters(t *Table) *ui.KeyActions {
 	return ui.NewKeyActionsFromMap(ui.KeyMap{
-		ui.KeyShiftC:   ui.NewKeyAction("Sort CPU", t.SortColCmd(cpuCol, false), false),
-		ui.KeyShiftM:   ui.NewKeyAction("Sort MEM", t.SortColCmd(memCol, false), false),
-		ui.KeyShiftX:   ui.NewKeyAction("Sort CPU/R", t.SortColCmd("%CPU/R", false), false),
-		ui.KeyShiftZ:   ui.NewKeyAction("Sort MEM/R", t.SortColCmd("%MEM/R", false), false),
-		tcell.KeyCtrlX: ui.NewKeyAction("Sort CPU/L", t.SortColCmd("%CPU/L", false), false),
-		tcell.KeyCtrlQ: ui.NewKeyAction("Sort MEM/L", t.SortColCmd("%MEM/L", false), false),
+		ui.KeyShiftC:   ui.NewKeyAction("Sort CPU", t.SortColCmd(cpuCol, false), false).WithID("sortCPU"),
+		ui.KeyShiftM:   ui.NewKeyAction("Sort MEM", t.SortColCmd(memCol, false), false).WithID("sortMEM"),
+		ui.KeyShiftX:   ui.NewKeyAction("Sort CPU/R", t.SortColCmd("%CPU/R", false), false).WithID("sortCPUR"),
+		ui.KeyShiftZ:   ui.NewKeyAction("Sort MEM/R", t.SortColCmd("%MEM/R", false), false).WithID("sortMEMR"),
+		tcell.KeyCtrlX: ui.NewKeyAction("Sort CPU/L", t.SortColCmd("%CPU/L", false), false).WithID("sortCPUL"),
+		tcell.KeyCtrlQ: ui.NewKeyAction("Sort MEM/L", t.SortColCmd("%MEM/L", false), false).WithID("sortMEML"),
 	})
 }
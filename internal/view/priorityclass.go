@@ -26,7 +26,7 @@ func NewPriorityClass(gvr client.GVR) ResourceViewer {
 }
 
 func (s *PriorityClass) bindKeys(aa *ui.KeyActions) {
-	aa.Add(ui.KeyU, ui.NewKeyAction("UsedBy", s.refCmd, true))
+	aa.Add(ui.KeyU, ui.NewKeyAction("UsedBy", s.refCmd, true).WithID("usedBy"))
 }
 
 func (s *PriorityClass) refCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/prom"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	promChartsTitle = "Metrics"
+
+	// promChartScale preserves fractional values ie rates once charted as integers.
+	promChartScale = 1_000
+)
+
+// PromCharts represents a resource Prometheus metrics history view.
+type PromCharts struct {
+	*tview.Flex
+
+	app     *App
+	fqn     string
+	since   string
+	history []prom.History
+	charts  []*tchart.SparkLine
+	actions *ui.KeyActions
+}
+
+// NewPromCharts returns a new Prometheus metrics history view.
+func NewPromCharts(app *App, fqn string, hh []prom.History) *PromCharts {
+	return &PromCharts{
+		Flex:    tview.NewFlex().SetDirection(tview.FlexRow),
+		app:     app,
+		fqn:     fqn,
+		since:   duration.HumanDuration(promHistorySince),
+		history: hh,
+		actions: ui.NewKeyActions(),
+	}
+}
+
+func (*PromCharts) SetFilter(string)                 {}
+func (*PromCharts) SetLabelFilter(map[string]string) {}
+
+// Init initializes the view.
+func (p *PromCharts) Init(_ context.Context) error {
+	p.SetBorder(true)
+	p.SetBorderPadding(0, 0, 1, 1)
+	p.SetTitle(fmt.Sprintf(" %s(%s) [last %s] ", promChartsTitle, p.fqn, p.since))
+	p.SetTitleColor(tcell.ColorAqua)
+	for i, h := range p.history {
+		s := tchart.NewSparkLine(h.Name)
+		s.SetMultiSeries(false)
+		s.SetBorderPadding(1, 1, 0, 1)
+		s.SetInputCapture(p.keyboard)
+		for _, v := range h.Series.Values {
+			s.Add(tchart.Metric{S1: promChartValue(v)})
+		}
+		s.SetLegend(promLegend(h))
+		p.charts = append(p.charts, s)
+		p.AddItem(s, 0, 1, i == 0)
+	}
+
+	p.bindKeys()
+	p.SetInputCapture(p.keyboard)
+	p.StylesChanged(p.app.Styles)
+
+	return nil
+}
+
+// InCmdMode checks if prompt is active.
+func (*PromCharts) InCmdMode() bool {
+	return false
+}
+
+// StylesChanged notifies the skin changed.
+func (p *PromCharts) StylesChanged(s *config.Styles) {
+	p.SetBackgroundColor(s.Charts().BgColor.Color())
+	for _, sp := range p.charts {
+		sp.SetBackgroundColor(s.Charts().BgColor.Color())
+		sp.SetFocusColorNames(s.Table().BgColor.String(), s.Table().CursorBgColor.String())
+		if cc, ok := s.Charts().ResourceColors[sp.ID()]; ok {
+			sp.SetSeriesColors(cc.Colors()...)
+		} else {
+			sp.SetSeriesColors(s.Charts().DefaultChartColors.Colors()...)
+		}
+	}
+}
+
+func (p *PromCharts) bindKeys() {
+	p.actions.Merge(ui.NewKeyActionsFromMap(ui.KeyMap{
+		tcell.KeyEscape: ui.NewKeyAction("Back", p.app.PrevCmd, false).WithID("back"),
+	}))
+}
+
+func (p *PromCharts) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := p.actions.Get(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+// Start starts the view.
+func (p *PromCharts) Start() {
+	p.app.Styles.AddListener(p)
+}
+
+// Stop terminates the view.
+func (p *PromCharts) Stop() {
+	p.app.Styles.RemoveListener(p)
+}
+
+// Name returns the component name.
+func (*PromCharts) Name() string { return promChartsTitle }
+
+// Hints returns the view hints.
+func (p *PromCharts) Hints() model.MenuHints {
+	return p.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (*PromCharts) ExtraHints() map[string]string {
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func promChartValue(v float64) int64 {
+	if math.IsNaN(v) || math.IsInf(v, 0) || v < 0 {
+		return 0
+	}
+
+	return int64(math.Round(v * promChartScale))
+}
+
+func promLegend(h prom.History) string {
+	vv := h.Series.Values
+	if len(vv) == 0 {
+		return fmt.Sprintf(" %s %s ", h.Name, prom.MissingValue)
+	}
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range vv {
+		if !math.IsNaN(v) {
+			lo, hi = math.Min(lo, v), math.Max(hi, v)
+		}
+	}
+
+	return fmt.Sprintf(" %s "+h.Format+" (min "+h.Format+" / max "+h.Format+") ", h.Name, vv[len(vv)-1], lo, hi)
+}
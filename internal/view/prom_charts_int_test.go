@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"math"
+	"testing"
+
+	"github.com/derailed/k9s/internal/prom"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromChartValue(t *testing.T) {
+	uu := map[string]struct {
+		v float64
+		e int64
+	}{
+		"zero":     {},
+		"plain":    {v: 2, e: 2_000},
+		"fraction": {v: 0.0125, e: 13},
+		"nan":      {v: math.NaN()},
+		"inf":      {v: math.Inf(1)},
+		"negative": {v: -1},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, promChartValue(u.v))
+		})
+	}
+}
+
+func TestPromLegend(t *testing.T) {
+	uu := map[string]struct {
+		h prom.History
+		e string
+	}{
+		"empty": {
+			h: prom.History{Name: "rps", Format: "%.2f"},
+			e: " rps " + prom.MissingValue + " ",
+		},
+		"plain": {
+			h: prom.History{Name: "rps", Format: "%.2f", Series: prom.Series{Values: []float64{1, math.NaN(), 3, 2}}},
+			e: " rps 2.00 (min 1.00 / max 3.00) ",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, promLegend(u.h))
+		})
+	}
+}
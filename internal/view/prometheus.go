@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/port"
+	"github.com/derailed/k9s/internal/prom"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	promHistorySince = 1 * time.Hour
+	promHistoryStep  = 1 * time.Minute
+)
+
+// promDial tracks the active context Prometheus metrics.
+type promDial struct {
+	metrics *prom.Metrics
+	mx      sync.Mutex
+}
+
+func (p *promDial) reset() {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.metrics = nil
+}
+
+// promConfigured checks if Prometheus queries are configured for a given resource.
+func (a *App) promConfigured(gvr string) bool {
+	ct, err := a.Config.K9s.ActiveContext()
+
+	return err == nil && len(ct.Prometheus.QueriesFor(gvr)) > 0
+}
+
+// prometheus returns the Prometheus metrics for a given resource if configured.
+// The Prometheus service may be port-forwarded on first use.
+func (a *App) prometheus(gvr string) *prom.Metrics {
+	ct, err := a.Config.K9s.ActiveContext()
+	if err != nil || len(ct.Prometheus.QueriesFor(gvr)) == 0 {
+		return nil
+	}
+
+	a.prom.mx.Lock()
+	defer a.prom.mx.Unlock()
+	if a.prom.metrics != nil {
+		return a.prom.metrics
+	}
+	u := ct.Prometheus.URL
+	if u == "" {
+		if u, err = a.promForward(ct.PortForwardAddress, ct.Prometheus.PortForward); err != nil {
+			log.Warn().Err(err).Msgf("Prometheus port-forward failed")
+			return nil
+		}
+	}
+	a.prom.metrics = prom.NewMetrics(prom.NewClient(u, prom.QueryTimeout), ct.Prometheus.Queries)
+
+	return a.prom.metrics
+}
+
+// promForward port-forwards to a Prometheus service and returns its local url.
+func (a *App) promForward(addr string, spec *data.PromPortForward) (string, error) {
+	var svc dao.Service
+	svc.Init(a.factory, client.NewGVR("v1/services"))
+	path, err := svc.Pod(client.FQN(spec.Namespace, spec.Service))
+	if err != nil {
+		return "", err
+	}
+	lp := strconv.Itoa(spec.LocalPortOrDefault())
+	u := "http://" + net.JoinHostPort(addr, lp)
+	pt := port.NewPortTunnel(addr, "", lp, strconv.Itoa(spec.Port))
+	if _, ok := a.factory.ForwarderFor(dao.PortForwardID(path, pt.Container, pt.PortMap())); ok {
+		return u, nil
+	}
+	if !port.IsPortFree(pt) {
+		return "", fmt.Errorf("port %s is not available on host", lp)
+	}
+
+	pf := dao.NewPortForwarder(a.factory)
+	fwd, err := pf.Start(path, pt)
+	if err != nil {
+		return "", err
+	}
+	go func() {
+		a.factory.AddForwarder(pf)
+		pf.SetActive(true)
+		if err := fwd.ForwardPorts(); err != nil {
+			log.Warn().Err(err).Msgf("Prometheus port-forward %q ended", pf.ID())
+		}
+		a.factory.DeleteForwarder(pf.ID())
+		pf.SetActive(false)
+		a.prom.reset()
+	}()
+
+	return u, nil
+}
+
+func (b *Browser) promActions(aa *ui.KeyActions) {
+	if !b.app.promConfigured(b.GVR().String()) {
+		return
+	}
+	aa.Add(ui.KeyShiftG, ui.NewKeyAction("Metrics History", b.promHistoryCmd, true).WithID("metricsHistory"))
+}
+
+// promHistoryCmd charts the selected resource metrics history. Prometheus may need
+// to be port-forwarded first so the history is fetched off the ui thread.
+func (b *Browser) promHistoryCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	gvr := b.GVR().String()
+	b.app.Flash().Infof("Fetching %s metrics history...", path)
+	go func() {
+		hh, err := b.app.promHistory(gvr, path)
+		b.app.QueueUpdateDraw(func() {
+			if err != nil {
+				b.app.Flash().Err(err)
+				return
+			}
+			if err := b.app.inject(NewPromCharts(b.app, path, hh), false); err != nil {
+				b.app.Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+func (a *App) promHistory(gvr, path string) ([]prom.History, error) {
+	mx := a.prometheus(gvr)
+	if mx == nil {
+		return nil, errors.New("no Prometheus metrics available for this resource")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), prom.QueryTimeout)
+	defer cancel()
+
+	return mx.History(ctx, gvr, prom.ResourceKey(path), promHistorySince, promHistoryStep)
+}
@@ -208,9 +208,9 @@ func (p *Pulse) PulseFailed(err error) {
 
 func (p *Pulse) bindKeys() {
 	p.actions.Merge(ui.NewKeyActionsFromMap(ui.KeyMap{
-		tcell.KeyEnter:   ui.NewKeyAction("Goto", p.enterCmd, true),
-		tcell.KeyTab:     ui.NewKeyAction("Next", p.nextFocusCmd(1), true),
-		tcell.KeyBacktab: ui.NewKeyAction("Prev", p.nextFocusCmd(-1), true),
+		tcell.KeyEnter:   ui.NewKeyAction("Goto", p.enterCmd, true).WithID("goto"),
+		tcell.KeyTab:     ui.NewKeyAction("Next", p.nextFocusCmd(1), true).WithID("next"),
+		tcell.KeyBacktab: ui.NewKeyAction("Prev", p.nextFocusCmd(-1), true).WithID("prev"),
 	}))
 
 	for i, v := range p.charts {
@@ -274,6 +274,12 @@ func (p *Pulse) App() *App {
 // SetInstance sets specific resource instance.
 func (p *Pulse) SetInstance(string) {}
 
+// SetFieldSelector sets a resource field selector.
+func (p *Pulse) SetFieldSelector(string) {}
+
+// SetNamespace sets a view local namespace.
+func (*Pulse) SetNamespace(string) {}
+
 // SetEnvFn sets the custom environment function.
 func (p *Pulse) SetEnvFn(EnvFunc) {}
 
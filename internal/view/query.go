@@ -0,0 +1,238 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const saveQueryDialogKey = "save-query"
+
+// Query represents a saved queries view.
+type Query struct {
+	ResourceViewer
+}
+
+// NewQuery returns a new saved queries view.
+func NewQuery(gvr client.GVR) ResourceViewer {
+	q := Query{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	q.GetTable().SetSortCol("NAME", true)
+	q.AddBindKeysFn(q.bindKeys)
+	q.SetContextFn(q.queryContext)
+
+	return &q
+}
+
+// Init initializes the view.
+func (q *Query) Init(ctx context.Context) error {
+	if err := q.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	q.GetTable().GetModel().SetNamespace(client.NotNamespaced)
+
+	return nil
+}
+
+func (q *Query) queryContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyQueries, loadQueries(q.App()))
+}
+
+func (q *Query) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL)
+	aa.Bulk(ui.KeyMap{
+		tcell.KeyEnter: ui.NewKeyAction("Run", q.enterCmd, true).WithID("run"),
+		tcell.KeyCtrlD: ui.NewKeyAction("Delete", q.deleteCmd, true).WithID("delete"),
+		ui.KeyShiftC:   ui.NewKeyAction("Sort Command", q.GetTable().SortColCmd("COMMAND", true), false).WithID("sortCommand"),
+	})
+}
+
+func (q *Query) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if q.GetTable().CmdBuff().IsActive() {
+		return q.GetTable().activateCmd(evt)
+	}
+	name := q.GetTable().GetSelectedItem()
+	if name == "" {
+		return evt
+	}
+	query, ok := loadQueries(q.App()).Query[name]
+	if !ok {
+		q.App().Flash().Errf("No query named %q", name)
+		return nil
+	}
+	if err := runQuery(q.App(), query); err != nil {
+		dialog.ShowError(q.App().Styles.Dialog(), q.App().Content.Pages, err.Error())
+	}
+
+	return nil
+}
+
+func (q *Query) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := q.GetTable().GetSelectedItem()
+	if name == "" {
+		return evt
+	}
+	msg := fmt.Sprintf("Delete query %q?", name)
+	dialog.ShowConfirm(q.App().Styles.Dialog(), q.App().Content.Pages, "Confirm Delete", msg, func() {
+		if err := config.DeleteQuery(q.App().Config.ContextQueriesPath(), name); err != nil {
+			q.App().Flash().Err(err)
+			return
+		}
+		q.App().Flash().Infof("Query %q deleted", name)
+		q.Refresh()
+	}, func() {})
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func loadQueries(app *App) config.Queries {
+	qq := config.NewQueries()
+	if err := qq.Load(app.Config.ContextQueriesPath()); err != nil {
+		log.Warn().Err(err).Msg("Queries load failed")
+	}
+
+	return qq
+}
+
+// runQuery navigates to the query resource and applies its filters and sort order.
+func runQuery(app *App, q config.Query) error {
+	if err := app.command.run(cmd.NewInterpreter(q.Line()), "", true); err != nil {
+		return err
+	}
+	v, ok := app.Content.Top().(ResourceViewer)
+	if !ok {
+		return nil
+	}
+	switch {
+	case q.Filter == "" && q.Labels != "":
+		v.SetLabelFilter(cmd.ToLabels(q.Labels))
+	case q.Filter != "":
+		v.SetFilter(q.Filter)
+		if q.Labels != "" {
+			v.GetTable().GetModel().SetLabelFilter(q.Labels)
+		}
+	}
+	if name, asc, ok := q.SortCol(); ok {
+		v.GetTable().SetManualSortCol(name, asc)
+	}
+
+	return nil
+}
+
+func queryActions(r Runner, aa *ui.KeyActions) error {
+	qq := config.NewQueries()
+	if err := qq.Load(r.App().Config.ContextQueriesPath()); err != nil {
+		return err
+	}
+
+	var errs error
+	for name, q := range qq.Query {
+		if q.ShortCut == "" {
+			continue
+		}
+		key, err := asKey(q.ShortCut)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		if _, ok := aa.Get(key); ok {
+			errs = errors.Join(errs, fmt.Errorf("duplicate shortcut found for %q in query %q", q.ShortCut, name))
+			continue
+		}
+		desc := q.Description
+		if desc == "" {
+			desc = name
+		}
+		aa.Add(key, ui.NewKeyActionWithOpts(
+			desc,
+			queryCmd(r, q),
+			ui.ActionOpts{
+				Shared: true,
+				HotKey: true,
+			},
+		))
+	}
+
+	return errs
+}
+
+func queryCmd(r Runner, q config.Query) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if err := runQuery(r.App(), q); err != nil {
+			dialog.ShowError(r.App().Styles.Dialog(), r.App().Content.Pages, err.Error())
+		}
+		return nil
+	}
+}
+
+func showSaveQueryDialog(app *App, q config.Query) {
+	styles := app.Styles.Dialog()
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	var name string
+	f.AddInputField("Name:", "", 30, nil, func(s string) {
+		name = strings.TrimSpace(s)
+	})
+	f.AddInputField("Description:", "", 30, nil, func(s string) {
+		q.Description = strings.TrimSpace(s)
+	})
+	f.AddInputField("ShortCut:", "", 30, nil, func(s string) {
+		q.ShortCut = strings.TrimSpace(s)
+	})
+
+	dismiss := func() {
+		app.Content.RemovePage(saveQueryDialogKey)
+	}
+	f.AddButton("OK", func() {
+		if name == "" {
+			app.Flash().Err(errors.New("a query name is required"))
+			return
+		}
+		defer dismiss()
+		if err := config.SaveQuery(app.Config.ContextQueriesPath(), name, q); err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		app.Flash().Infof("Query %q saved", name)
+	})
+	f.AddButton("Cancel", dismiss)
+	for i := 0; i < 2; i++ {
+		if b := f.GetButton(i); b != nil {
+			b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+			b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+		}
+	}
+
+	m := tview.NewModalForm("<Save Query>", f)
+	m.SetText(fmt.Sprintf("Save query %q?", q.Line()))
+	m.SetDoneFunc(func(int, string) {
+		dismiss()
+	})
+	app.Content.AddPage(saveQueryDialogKey, m, false, false)
+	app.Content.ShowPage(saveQueryDialogKey)
+}
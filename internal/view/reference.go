@@ -42,8 +42,8 @@ func (r *Reference) bindKeys(aa *ui.KeyActions) {
 	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
 	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
 	aa.Bulk(ui.KeyMap{
-		tcell.KeyEnter: ui.NewKeyAction("Goto", r.gotoCmd, true),
-		ui.KeyShiftV:   ui.NewKeyAction("Sort GVR", r.GetTable().SortColCmd("GVR", true), false),
+		tcell.KeyEnter: ui.NewKeyAction("Goto", r.gotoCmd, true).WithID("goto"),
+		ui.KeyShiftV:   ui.NewKeyAction("Sort GVR", r.GetTable().SortColCmd("GVR", true), false).WithID("sortGVR"),
 	})
 }
 
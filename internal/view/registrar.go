@@ -14,6 +14,7 @@ func loadCustomViewers() MetaViewers {
 	appsViewers(m)
 	rbacViewers(m)
 	batchViewers(m)
+	autoscalingViewers(m)
 	crdViewers(m)
 	helmViewers(m)
 
@@ -90,6 +91,18 @@ func miscViewers(vv MetaViewers) {
 	vv[client.NewGVR("pulses")] = MetaViewer{
 		viewerFn: NewPulse,
 	}
+	vv[client.NewGVR("timelines")] = MetaViewer{
+		viewerFn: NewEventTimeline,
+	}
+	vv[client.NewGVR("rightsizings")] = MetaViewer{
+		viewerFn: NewRightSizing,
+	}
+	vv[client.NewGVR("diagnostics")] = MetaViewer{
+		viewerFn: NewDiagnostic,
+	}
+	vv[client.NewGVR("queries")] = MetaViewer{
+		viewerFn: NewQuery,
+	}
 	// !!BOZO!! Popeye
 	// vv[client.NewGVR("popeye")] = MetaViewer{
 	// 	viewerFn: NewPopeye,
@@ -153,6 +166,12 @@ func batchViewers(vv MetaViewers) {
 	}
 }
 
+func autoscalingViewers(vv MetaViewers) {
+	vv[client.NewGVR("autoscaling/v2/horizontalpodautoscalers")] = MetaViewer{
+		viewerFn: NewHPA,
+	}
+}
+
 func crdViewers(vv MetaViewers) {
 	vv[client.NewGVR("apiextensions.k8s.io/v1/customresourcedefinitions")] = MetaViewer{
 		viewerFn: NewCRD,
@@ -38,7 +38,7 @@ func (r *RestartExtender) bindKeys(aa *ui.KeyActions) {
 			Visible:   true,
 			Dangerous: true,
 		},
-	))
+	).WithID("restart"))
 }
 
 func (r *RestartExtender) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
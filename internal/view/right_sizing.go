@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// RightSizing represents containers resources recommendations view.
+type RightSizing struct {
+	ResourceViewer
+}
+
+// NewRightSizing returns a new right sizing view.
+func NewRightSizing(gvr client.GVR) ResourceViewer {
+	r := RightSizing{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	r.GetTable().SetColorerFn(render.RightSizing{}.ColorerFunc())
+	r.GetTable().SetSortCol("WORKLOAD", true)
+	r.AddBindKeysFn(r.bindKeys)
+	r.GetTable().SetEnterFn(r.patchCmd)
+
+	return &r
+}
+
+func (r *RightSizing) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, tcell.KeyCtrlD)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyX:      ui.NewKeyAction("Export Patch", r.exportCmd, true).WithID("exportPatch"),
+		ui.KeyShiftW: ui.NewKeyAction("Sort Workload", r.GetTable().SortColCmd("WORKLOAD", true), false).WithID("sortWorkload"),
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU-P95", r.GetTable().SortColCmd("CPU-P95", false), false).WithID("sortCPUP95"),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM-P95", r.GetTable().SortColCmd("MEM-P95", false), false).WithID("sortMEMP95"),
+	})
+}
+
+func (r *RightSizing) patchCmd(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	tokens, s := strings.Split(path, "/"), dao.Sampler()
+	if len(tokens) != 4 || s == nil {
+		return
+	}
+	uu := filterUsage(s.Usage(), func(u *dao.ContainerUsage) bool {
+		return u.Namespace == tokens[0] && u.Kind == tokens[1] && u.Workload == tokens[2]
+	})
+	patch, err := dao.RightSizingPatch(uu)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if patch == "" {
+		app.Flash().Warnf("No recommendations available yet for %s/%s", tokens[1], tokens[2])
+		return
+	}
+	title := "Right Sizing Patch"
+	if err := app.inject(NewDetails(app, title, client.FQN(tokens[0], tokens[2]), contentYAML, true).Update(patch), false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (r *RightSizing) exportCmd(evt *tcell.EventKey) *tcell.EventKey {
+	s := dao.Sampler()
+	if s == nil {
+		r.App().Flash().Err(errors.New("right sizing is disabled"))
+		return nil
+	}
+	ns := r.App().Config.ActiveNamespace()
+	uu := filterUsage(s.Usage(), func(u *dao.ContainerUsage) bool {
+		return client.IsAllNamespaces(ns) || u.Namespace == ns
+	})
+	patch, err := dao.RightSizingPatch(uu)
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+	if patch == "" {
+		r.App().Flash().Warn("No recommendations available yet")
+		return nil
+	}
+	path, err := saveYAML(r.App().Config.K9s.ContextScreenDumpDir(), "rightsizing", patch)
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+	r.App().Flash().Infof("Patch exported to %s", path)
+
+	return nil
+}
+
+func filterUsage(uu []dao.ContainerUsage, fn func(*dao.ContainerUsage) bool) []dao.ContainerUsage {
+	res := make([]dao.ContainerUsage, 0, len(uu))
+	for i := range uu {
+		if fn(&uu[i]) {
+			res = append(res, uu[i])
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+const revisionCol = "REVISION"
+
+// RolloutHistory represents a deployment rollout history view.
+type RolloutHistory struct {
+	ResourceViewer
+
+	path string
+}
+
+// NewRolloutHistory returns a new rollout history view for a given deployment.
+func NewRolloutHistory(path string) ResourceViewer {
+	r := RolloutHistory{
+		ResourceViewer: NewBrowser(client.NewGVR("rollout-history")),
+		path:           path,
+	}
+	r.GetTable().SetColorerFn(render.Rollout{}.ColorerFunc())
+	r.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	r.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	r.AddBindKeysFn(r.bindKeys)
+	r.SetContextFn(r.rolloutContext)
+	r.GetTable().SetEnterFn(r.diffCmd)
+
+	return &r
+}
+
+// Init initializes the view.
+func (r *RolloutHistory) Init(ctx context.Context) error {
+	if err := r.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	r.GetTable().SetSortCol(revisionCol, false)
+
+	return nil
+}
+
+func (r *RolloutHistory) rolloutContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyFQN, r.path)
+}
+
+func (r *RolloutHistory) bindKeys(aa *ui.KeyActions) {
+	if !r.App().Config.K9s.IsReadOnly() {
+		aa.Add(ui.KeyR, ui.NewKeyActionWithOpts("RollBackTo...", r.rollbackCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			},
+		).WithID("rollBackTo"))
+	}
+
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, tcell.KeyCtrlD)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftN: ui.NewKeyAction("Sort Revision", r.GetTable().SortColCmd(revisionCol, true), false).WithID("sortRevision"),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", r.GetTable().SortColCmd(statusCol, true), false).WithID("sortStatus"),
+		ui.KeyShiftA: ui.NewKeyAction("Sort Age", r.GetTable().SortColCmd(ageCol, true), false).WithID("sortAge"),
+	})
+}
+
+func (r *RolloutHistory) diffCmd(app *App, _ ui.Tabular, _ client.GVR, path string) {
+	var h dao.RolloutHistory
+	h.Init(app.factory, r.GVR())
+	diff, err := h.Diff(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if diff == "" {
+		app.Flash().Info("No pod template changes between revisions")
+		return
+	}
+	if err := app.inject(NewDetails(app, "Revision Diff", path, contentTXT, true).Update(diff), false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (r *RolloutHistory) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := r.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	fqn, rev, _ := strings.Cut(path, ":")
+	v, err := strconv.ParseInt(rev, 10, 64)
+	if err != nil {
+		r.App().Flash().Errf("Invalid revision %q", rev)
+		return nil
+	}
+
+	r.Stop()
+	defer r.Start()
+	msg := fmt.Sprintf("Rollback deployment [yellow::b]%s[-::-] to revision <[orangered::b]%s[-::-]>?", fqn, rev)
+	dialog.ShowConfirm(r.App().Styles.Dialog(), r.App().Content.Pages, "Confirm Rollback", msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), r.App().Conn().Config().CallTimeout())
+		defer cancel()
+		var dp dao.Deployment
+		dp.Init(r.App().factory, client.NewGVR("apps/v1/deployments"))
+		if err := dp.RollbackTo(ctx, fqn, v); err != nil {
+			r.App().Flash().Err(err)
+			return
+		}
+		r.App().Flash().Infof("Rollback to revision %s in progress for `%s...", rev, fqn)
+		r.Refresh()
+	}, func() {})
+
+	return nil
+}
@@ -35,10 +35,10 @@ func NewReplicaSet(gvr client.GVR) ResourceViewer {
 
 func (r *ReplicaSet) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
-		ui.KeyShiftD:   ui.NewKeyAction("Sort Desired", r.GetTable().SortColCmd("DESIRED", true), false),
-		ui.KeyShiftC:   ui.NewKeyAction("Sort Current", r.GetTable().SortColCmd("CURRENT", true), false),
-		ui.KeyShiftR:   ui.NewKeyAction("Sort Ready", r.GetTable().SortColCmd(readyCol, true), false),
-		tcell.KeyCtrlL: ui.NewKeyAction("Rollback", r.rollbackCmd, true),
+		ui.KeyShiftD:   ui.NewKeyAction("Sort Desired", r.GetTable().SortColCmd("DESIRED", true), false).WithID("sortDesired"),
+		ui.KeyShiftC:   ui.NewKeyAction("Sort Current", r.GetTable().SortColCmd("CURRENT", true), false).WithID("sortCurrent"),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Ready", r.GetTable().SortColCmd(readyCol, true), false).WithID("sortReady"),
+		tcell.KeyCtrlL: ui.NewKeyAction("Rollback", r.rollbackCmd, true).WithID("rollback"),
 	})
 }
 
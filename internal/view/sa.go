@@ -31,8 +31,8 @@ func NewServiceAccount(gvr client.GVR) ResourceViewer {
 
 func (s *ServiceAccount) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
-		ui.KeyU:        ui.NewKeyAction("UsedBy", s.refCmd, true),
-		tcell.KeyEnter: ui.NewKeyAction("Rules", s.policyCmd, true),
+		ui.KeyU:        ui.NewKeyAction("UsedBy", s.refCmd, true).WithID("usedBy"),
+		tcell.KeyEnter: ui.NewKeyAction("Rules", s.policyCmd, true).WithID("rules"),
 	})
 }
 
@@ -109,11 +109,17 @@ func (s *Sanitizer) ExtraHints() map[string]string {
 // SetInstance sets specific resource instance.
 func (s *Sanitizer) SetInstance(string) {}
 
+// SetFieldSelector sets a resource field selector.
+func (s *Sanitizer) SetFieldSelector(string) {}
+
+// SetNamespace sets a view local namespace.
+func (*Sanitizer) SetNamespace(string) {}
+
 func (s *Sanitizer) bindKeys() {
 	s.Actions().Bulk(ui.KeyMap{
-		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", s.activateCmd, false),
-		tcell.KeyEscape: ui.NewSharedKeyAction("Filter Reset", s.resetCmd, false),
-		tcell.KeyEnter:  ui.NewKeyAction("Goto", s.gotoCmd, true),
+		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", s.activateCmd, false).WithID("filterMode"),
+		tcell.KeyEscape: ui.NewSharedKeyAction("Filter Reset", s.resetCmd, false).WithID("filterReset"),
+		tcell.KeyEnter:  ui.NewKeyAction("Goto", s.gotoCmd, true).WithID("goto"),
 	})
 }
 
@@ -40,7 +40,7 @@ func (s *ScaleExtender) bindKeys(aa *ui.KeyActions) {
 			Visible:   true,
 			Dangerous: true,
 		},
-	))
+	).WithID("scale"))
 }
 
 func (s *ScaleExtender) scaleCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -4,6 +4,10 @@
 package view
 
 import (
+	"context"
+	"errors"
+	"os"
+
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
@@ -30,9 +34,15 @@ func NewSecret(gvr client.GVR) ResourceViewer {
 
 func (s *Secret) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
-		ui.KeyX: ui.NewKeyAction("Decode", s.decodeCmd, true),
-		ui.KeyU: ui.NewKeyAction("UsedBy", s.refCmd, true),
+		ui.KeyX: ui.NewKeyAction("Decode", s.decodeCmd, true).WithID("decode"),
+		ui.KeyU: ui.NewKeyAction("UsedBy", s.refCmd, true).WithID("usedBy"),
 	})
+	if !s.App().Config.K9s.IsReadOnly() {
+		aa.Add(ui.KeyShiftE, ui.NewKeyActionWithOpts("Edit Decoded", s.editDecodedCmd, ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}).WithID("editDecoded"))
+	}
 }
 
 func (s *Secret) refCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -70,3 +80,67 @@ func (s *Secret) decodeCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	return nil
 }
+
+func (s *Secret) editDecodedCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	s.Stop()
+	defer s.Start()
+	if err := editDecodedSecret(s.App(), s.GVR(), path); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func editDecodedSecret(app *App, gvr client.GVR, path string) error {
+	res, err := dao.AccessorFor(app.factory, gvr)
+	if err != nil {
+		return err
+	}
+	sec, ok := res.(*dao.Secret)
+	if !ok {
+		return errors.New("expecting a secret resource")
+	}
+
+	ctx := context.Background()
+	orig, raw, err := sec.ToEditYAML(ctx, path)
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp("", "k9s-secret-*.yaml")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+	if _, err := f.WriteString(raw); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	if !edit(app, shellOpts{clear: true, args: []string{f.Name()}}) {
+		return errors.New("failed to launch editor")
+	}
+	edited, err := os.ReadFile(f.Name())
+	if err != nil {
+		return err
+	}
+	if string(edited) == raw {
+		app.Flash().Info("Edit canceled, no changes made")
+		return nil
+	}
+	if err := sec.UpdateFromEditYAML(ctx, orig, string(edited)); err != nil {
+		return err
+	}
+	app.Flash().Infof("Secret %s updated successfully!", path)
+
+	return nil
+}
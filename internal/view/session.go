@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/rs/zerolog/log"
+)
+
+// saveSession records the active page stack when session restore is enabled.
+func saveSession(app *App) {
+	if !app.Config.K9s.RestoreSession {
+		return
+	}
+	path := app.Config.ContextSessionPath()
+	if path == "" {
+		return
+	}
+	if err := snapshotSession(app.Content).Save(path); err != nil {
+		log.Error().Err(err).Msgf("Session save failed: %q", path)
+	}
+}
+
+// restoreSession restores the context page stack from the last session.
+func restoreSession(app *App) error {
+	s := config.NewSession()
+	if err := s.Load(app.Config.ContextSessionPath()); err != nil {
+		return err
+	}
+	if s.IsEmpty() {
+		return errors.New("no session pages found")
+	}
+
+	for i, page := range s.Pages {
+		if i == 0 {
+			line := page.Command
+			if line == "" {
+				line = page.GVR
+			}
+			if err := app.command.run(cmd.NewInterpreter(line), "", true); err != nil {
+				return err
+			}
+		} else if err := pushSessionPage(app, page); err != nil {
+			log.Warn().Err(err).Msgf("Session page restore failed for %q", page.GVR)
+			return nil
+		}
+		v, ok := app.Content.Top().(ResourceViewer)
+		if !ok {
+			return nil
+		}
+		applySessionPage(v, page)
+	}
+
+	return nil
+}
+
+// snapshotSession records the resource views state of a page stack.
+func snapshotSession(p *PageStack) *config.Session {
+	s := config.NewSession()
+	for i, c := range p.Peek() {
+		v, ok := c.(ResourceViewer)
+		if !ok {
+			break
+		}
+		t := v.GetTable()
+		page := config.SessionPage{
+			GVR:      v.GVR().String(),
+			Path:     t.Path,
+			Labels:   t.GetModel().GetLabelFilter(),
+			Filter:   t.CmdBuff().GetText(),
+			Selected: t.SelectedItemID(),
+		}
+		if i == 0 {
+			page.Command = p.cmd
+		}
+		if ctx := t.GetContext(); ctx != nil {
+			page.Fields, _ = ctx.Value(internal.KeyFields).(string)
+		}
+		sc := t.SortColumn()
+		page.SetSortCol(sc.Name, sc.ASC)
+		s.Pages = append(s.Pages, page)
+	}
+
+	return s
+}
+
+func pushSessionPage(app *App, page config.SessionPage) error {
+	gvr := client.NewGVR(page.GVR)
+	mv := MetaViewer{viewerFn: NewBrowser}
+	if v, ok := customViewers[gvr]; ok {
+		mv = v
+	}
+	v := app.command.componentFor(gvr, "", &mv)
+	v.GetTable().Path = page.Path
+	v.SetFieldSelector(page.Fields)
+
+	return app.inject(v, false)
+}
+
+func applySessionPage(v ResourceViewer, page config.SessionPage) {
+	if page.Labels != "" {
+		v.GetTable().GetModel().SetLabelFilter(page.Labels)
+	}
+	if page.Filter != "" {
+		v.SetFilter(page.Filter)
+	}
+	if name, asc, ok := page.SortCol(); ok {
+		v.GetTable().SetManualSortCol(name, asc)
+	}
+	if page.Selected != "" {
+		v.GetTable().SelectItem(page.Selected)
+	}
+}
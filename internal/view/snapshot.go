@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/rs/zerolog/log"
+)
+
+const snapshotTimeout = 10 * time.Minute
+
+// snapshotCmd captures cluster or namespace resources into a snapshot archive.
+func (c *Command) snapshotCmd(p *cmd.Interpreter) error {
+	rr, cns, ok := p.SnapshotArgs()
+	if !ok {
+		return errors.New("invalid command. use `snapshot [res1,res2|*] [ns]`")
+	}
+	cfg := c.app.Config.K9s.Snapshot
+	spec := snapshot.Spec{
+		Namespace:      c.app.Config.ActiveNamespace(),
+		GVRs:           cfg.GVRs,
+		LogLines:       cfg.LogLines,
+		IncludeSecrets: cfg.IncludeSecrets,
+	}
+	if cns != "" {
+		spec.Namespace = cns
+	}
+	if len(rr) > 0 {
+		spec.GVRs = make([]string, 0, len(rr))
+		for _, r := range rr {
+			gvr, _, ok := c.alias.AsGVR(r)
+			if !ok {
+				return fmt.Errorf("invalid resource name: %q", r)
+			}
+			spec.GVRs = append(spec.GVRs, gvr.String())
+		}
+	}
+
+	dir := c.app.Config.K9s.ContextScreenDumpDir()
+	if err := ensureDir(dir); err != nil {
+		return err
+	}
+	ns := spec.Namespace
+	if client.IsAllNamespaces(ns) {
+		ns = client.NamespaceAll
+	}
+	name := fmt.Sprintf("%s-%s-%d.tar.gz", data.SanitizeFileName(c.app.Config.ActiveContextName()), ns, time.Now().Unix())
+	path := filepath.Join(dir, strings.ToLower(name))
+
+	c.app.Flash().Infof("Capturing snapshot for namespace %q...", ns)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), snapshotTimeout)
+		defer cancel()
+		sum, err := snapshot.Capture(ctx, c.app.Conn(), spec, path)
+		c.app.QueueUpdateDraw(func() {
+			if err != nil {
+				log.Error().Err(err).Msgf("Snapshot capture failed")
+				c.app.Flash().Errf("Snapshot failed: %s", err)
+				return
+			}
+			if len(sum.Skipped) > 0 {
+				c.app.Flash().Warnf("Snapshot saved to %s (%d objects, %d logs, %d resources skipped)", path, sum.Objects, sum.Logs, len(sum.Skipped))
+				return
+			}
+			c.app.Flash().Infof("Snapshot saved to %s (%d objects, %d logs)", path, sum.Objects, sum.Logs)
+		})
+	}()
+
+	return nil
+}
@@ -51,7 +51,7 @@ func (s *StatefulSet) logOptions(prev bool) (*dao.LogOptions, error) {
 }
 
 func (s *StatefulSet) bindKeys(aa *ui.KeyActions) {
-	aa.Add(ui.KeyShiftR, ui.NewKeyAction("Sort Ready", s.GetTable().SortColCmd(readyCol, true), false))
+	aa.Add(ui.KeyShiftR, ui.NewKeyAction("Sort Ready", s.GetTable().SortColCmd(readyCol, true), false).WithID("sortReady"))
 }
 
 func (s *StatefulSet) showPods(app *App, _ ui.Tabular, _ client.GVR, path string) {
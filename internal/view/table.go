@@ -187,16 +187,16 @@ func (t *Table) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 func (t *Table) bindKeys() {
 	t.Actions().Bulk(ui.KeyMap{
-		ui.KeyHelp:             ui.NewKeyAction("Help", t.App().helpCmd, true),
-		ui.KeySpace:            ui.NewSharedKeyAction("Mark", t.markCmd, false),
-		tcell.KeyCtrlSpace:     ui.NewSharedKeyAction("Mark Range", t.markSpanCmd, false),
-		tcell.KeyCtrlBackslash: ui.NewSharedKeyAction("Marks Clear", t.clearMarksCmd, false),
-		tcell.KeyCtrlS:         ui.NewSharedKeyAction("Save", t.saveCmd, false),
-		ui.KeySlash:            ui.NewSharedKeyAction("Filter Mode", t.activateCmd, false),
-		tcell.KeyCtrlZ:         ui.NewKeyAction("Toggle Faults", t.toggleFaultCmd, false),
-		tcell.KeyCtrlW:         ui.NewKeyAction("Toggle Wide", t.toggleWideCmd, false),
-		ui.KeyShiftN:           ui.NewKeyAction("Sort Name", t.SortColCmd(nameCol, true), false),
-		ui.KeyShiftA:           ui.NewKeyAction("Sort Age", t.SortColCmd(ageCol, true), false),
+		ui.KeyHelp:             ui.NewKeyAction("Help", t.App().helpCmd, true).WithID("help"),
+		ui.KeySpace:            ui.NewSharedKeyAction("Mark", t.markCmd, false).WithID("mark"),
+		tcell.KeyCtrlSpace:     ui.NewSharedKeyAction("Mark Range", t.markSpanCmd, false).WithID("markRange"),
+		tcell.KeyCtrlBackslash: ui.NewSharedKeyAction("Marks Clear", t.clearMarksCmd, false).WithID("marksClear"),
+		tcell.KeyCtrlS:         ui.NewSharedKeyAction("Save", t.saveCmd, false).WithID("save"),
+		ui.KeySlash:            ui.NewSharedKeyAction("Filter Mode", t.activateCmd, false).WithID("filterMode"),
+		tcell.KeyCtrlZ:         ui.NewKeyAction("Toggle Faults", t.toggleFaultCmd, false).WithID("toggleFaults"),
+		tcell.KeyCtrlW:         ui.NewKeyAction("Toggle Wide", t.toggleWideCmd, false).WithID("toggleWide"),
+		ui.KeyShiftN:           ui.NewKeyAction("Sort Name", t.SortColCmd(nameCol, true), false).WithID("sortName"),
+		ui.KeyShiftA:           ui.NewKeyAction("Sort Age", t.SortColCmd(ageCol, true), false).WithID("sortAge"),
 	})
 }
 
@@ -95,6 +95,12 @@ type ResourceViewer interface {
 
 	// SetInstance sets a parent FQN
 	SetInstance(string)
+
+	// SetFieldSelector sets a resource field selector.
+	SetFieldSelector(string)
+
+	// SetNamespace sets a view local namespace.
+	SetNamespace(string)
 }
 
 // LogViewer represents a log viewer.
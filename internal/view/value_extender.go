@@ -31,7 +31,7 @@ func NewValueExtender(r ResourceViewer) ResourceViewer {
 }
 
 func (v *ValueExtender) bindKeys(aa *ui.KeyActions) {
-	aa.Add(ui.KeyV, ui.NewKeyAction("Values", v.valuesCmd, true))
+	aa.Add(ui.KeyV, ui.NewKeyAction("Values", v.valuesCmd, true).WithID("values"))
 }
 
 func (v *ValueExtender) valuesCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -70,7 +70,7 @@ func showValues(ctx context.Context, app *App, path string, gvr client.GVR) {
 	}
 
 	v := NewLiveView(app, "Values", vm)
-	v.actions.Add(ui.KeyV, ui.NewKeyAction("Toggle All Values", toggleValuesCmd, true))
+	v.actions.Add(ui.KeyV, ui.NewKeyAction("Toggle All Values", toggleValuesCmd, true).WithID("toggleAllValues"))
 	if err := v.app.inject(v, false); err != nil {
 		v.app.Flash().Err(err)
 	}
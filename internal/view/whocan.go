@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+var whoCanVerbs = []string{
+	"get",
+	"list",
+	"watch",
+	"create",
+	"patch",
+	"update",
+	"delete",
+	"deletecollection",
+}
+
+// WhoCan presents a reverse RBAC viewer listing all subjects that can perform
+// a given verb on a resource.
+type WhoCan struct {
+	ResourceViewer
+
+	verb     string
+	gvr      client.GVR
+	path, ns string
+}
+
+// NewWhoCan returns a new viewer.
+func NewWhoCan(verb string, gvr client.GVR, path, ns string) *WhoCan {
+	w := WhoCan{
+		ResourceViewer: NewBrowser(client.NewGVR("whocan")),
+		verb:           verb,
+		gvr:            gvr,
+		path:           path,
+		ns:             ns,
+	}
+	w.AddBindKeysFn(w.bindKeys)
+	w.GetTable().SetSortCol("KIND", true)
+	w.SetContextFn(w.whoCanCtx)
+
+	return &w
+}
+
+func (w *WhoCan) whoCanCtx(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyVerb, w.verb)
+	ctx = context.WithValue(ctx, internal.KeyGVR, w.gvr)
+	ctx = context.WithValue(ctx, internal.KeyNamespace, client.CleanseNamespace(w.ns))
+
+	return context.WithValue(ctx, internal.KeyPath, w.path)
+}
+
+func (w *WhoCan) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftP, tcell.KeyCtrlSpace, ui.KeySpace, tcell.KeyCtrlD, ui.KeyE)
+	aa.Bulk(ui.KeyMap{
+		tcell.KeyEnter: ui.NewKeyAction("Rules", w.policyCmd, true).WithID("rules"),
+		ui.KeyShiftK:   ui.NewKeyAction("Sort Kind", w.GetTable().SortColCmd("KIND", true), false).WithID("sortKind"),
+		ui.KeyShiftS:   ui.NewKeyAction("Sort Scope", w.GetTable().SortColCmd("SCOPE", true), false).WithID("sortScope"),
+		ui.KeyShiftB:   ui.NewKeyAction("Sort Binding", w.GetTable().SortColCmd("BINDING", true), false).WithID("sortBinding"),
+	})
+}
+
+func (w *WhoCan) policyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := w.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	row := w.GetTable().GetSelectedRow(path)
+	if row == nil || len(row.Fields) < 2 {
+		return evt
+	}
+	if err := w.App().inject(NewPolicy(w.App(), row.Fields[1], row.Fields[0]), false); err != nil {
+		w.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func showWhoCan(app *App, gvr client.GVR, path string) {
+	ns, n := client.Namespaced(path)
+	dialog.ShowSelection(app.Styles.Dialog(), app.Content.Pages, "Who Can", whoCanVerbs, func(index int) {
+		if index < 0 {
+			return
+		}
+		if err := app.inject(NewWhoCan(whoCanVerbs[index], gvr, n, ns), false); err != nil {
+			app.Flash().Err(err)
+		}
+	})
+}
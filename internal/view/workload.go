@@ -42,12 +42,12 @@ func (w *Workload) bindDangerousKeys(aa *ui.KeyActions) {
 			ui.ActionOpts{
 				Visible:   true,
 				Dangerous: true,
-			}),
+			}).WithID("edit"),
 		tcell.KeyCtrlD: ui.NewKeyActionWithOpts("Delete", w.deleteCmd,
 			ui.ActionOpts{
 				Visible:   true,
 				Dangerous: true,
-			}),
+			}).WithID("delete"),
 	})
 }
 
@@ -57,12 +57,12 @@ func (w *Workload) bindKeys(aa *ui.KeyActions) {
 	}
 
 	aa.Bulk(ui.KeyMap{
-		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", w.GetTable().SortColCmd("KIND", true), false),
-		ui.KeyShiftS: ui.NewKeyAction("Sort Status", w.GetTable().SortColCmd(statusCol, true), false),
-		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", w.GetTable().SortColCmd("READY", true), false),
-		ui.KeyShiftA: ui.NewKeyAction("Sort Age", w.GetTable().SortColCmd(ageCol, true), false),
-		ui.KeyY:      ui.NewKeyAction(yamlAction, w.yamlCmd, true),
-		ui.KeyD:      ui.NewKeyAction("Describe", w.describeCmd, true),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", w.GetTable().SortColCmd("KIND", true), false).WithID("sortKind"),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", w.GetTable().SortColCmd(statusCol, true), false).WithID("sortStatus"),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", w.GetTable().SortColCmd("READY", true), false).WithID("sortReady"),
+		ui.KeyShiftA: ui.NewKeyAction("Sort Age", w.GetTable().SortColCmd(ageCol, true), false).WithID("sortAge"),
+		ui.KeyY:      ui.NewKeyAction(yamlAction, w.yamlCmd, true).WithID("yaml"),
+		ui.KeyD:      ui.NewKeyAction("Describe", w.describeCmd, true).WithID("describe"),
 	})
 }
 
@@ -0,0 +1,402 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tview"
+)
+
+// Workspace represents a collection of tabs, each holding split resource panes.
+type Workspace struct {
+	*tview.Flex
+
+	app    *App
+	ctx    context.Context
+	tabBar *tview.TextView
+	pages  *tview.Pages
+	tabs   []*Tab
+	active int
+	nextID int
+}
+
+// Tab represents a collection of split panes.
+type Tab struct {
+	*tview.Flex
+
+	id     string
+	split  string
+	panes  []*PageStack
+	active int
+}
+
+// NewWorkspace returns a new workspace.
+func NewWorkspace(app *App) *Workspace {
+	w := Workspace{
+		Flex:   tview.NewFlex().SetDirection(tview.FlexRow),
+		app:    app,
+		tabBar: tview.NewTextView(),
+		pages:  tview.NewPages(),
+	}
+	w.tabBar.SetDynamicColors(true)
+	w.tabBar.SetBorderPadding(0, 0, 1, 1)
+	w.AddItem(w.tabBar, 0, 0, false)
+	w.AddItem(w.pages, 0, 1, true)
+
+	return &w
+}
+
+// Init initializes the workspace with its initial pane.
+func (w *Workspace) Init(ctx context.Context, p *PageStack) {
+	w.ctx = ctx
+	w.addTab(p)
+	w.app.Styles.AddListener(w)
+}
+
+// StylesChanged notifies the skin changed.
+func (w *Workspace) StylesChanged(*config.Styles) {
+	w.refreshTabs()
+}
+
+// Focus delegates focus to the active pane.
+func (w *Workspace) Focus(delegate func(p tview.Primitive)) {
+	if len(w.tabs) == 0 {
+		w.Flex.Focus(delegate)
+		return
+	}
+	delegate(w.tabs[w.active])
+}
+
+// Split adds a new pane to the active tab and runs the given command in it.
+func (w *Workspace) Split(split, line string) error {
+	p, err := w.newPane()
+	if err != nil {
+		return err
+	}
+	line = w.paneLine(line)
+	t := w.tabs[w.active]
+	t.setSplit(split)
+	t.add(p)
+	w.activate(p)
+	if err := w.run(line); err != nil {
+		_ = w.Close()
+		return err
+	}
+
+	return nil
+}
+
+// NewTab adds a new tab and runs the given command in it.
+func (w *Workspace) NewTab(line string) error {
+	p, err := w.newPane()
+	if err != nil {
+		return err
+	}
+	line = w.paneLine(line)
+	w.addTab(p)
+	w.activateTab(len(w.tabs) - 1)
+	if err := w.run(line); err != nil {
+		_ = w.Close()
+		return err
+	}
+
+	return nil
+}
+
+// Close closes the active pane or the active tab if it has no other panes.
+func (w *Workspace) Close() error {
+	t := w.tabs[w.active]
+	if len(t.panes) == 1 {
+		return w.closeTab()
+	}
+	p := t.remove(t.active)
+	w.activate(t.panes[t.active])
+	p.close()
+
+	return nil
+}
+
+// NextPane activates the next pane in the active tab.
+func (w *Workspace) NextPane() {
+	t := w.tabs[w.active]
+	if len(t.panes) < 2 {
+		return
+	}
+	t.active = (t.active + 1) % len(t.panes)
+	w.activate(t.panes[t.active])
+}
+
+// NextTab activates the next tab.
+func (w *Workspace) NextTab() {
+	if len(w.tabs) < 2 {
+		return
+	}
+	w.activateTab((w.active + 1) % len(w.tabs))
+}
+
+// Layout returns the current workspace layout.
+func (w *Workspace) Layout() *config.Layout {
+	l := config.NewLayout()
+	l.ActiveTab = w.active
+	for _, t := range w.tabs {
+		lt := config.LayoutTab{
+			Split:      t.split,
+			ActivePane: t.active,
+			Panes:      make([]config.LayoutPane, 0, len(t.panes)),
+		}
+		for _, p := range t.panes {
+			lp := config.LayoutPane{Command: p.cmd}
+			if ns, ok := p.namespace(); ok {
+				lp.Namespace = ns
+			}
+			lt.Panes = append(lt.Panes, lp)
+		}
+		l.Tabs = append(l.Tabs, lt)
+	}
+
+	return l
+}
+
+// Restore replaces the current tabs and panes with the given layout. The
+// current tabs are kept if the new panes can not be initialized.
+func (w *Workspace) Restore(l *config.Layout) error {
+	if l.IsEmpty() {
+		return errors.New("no layout to restore")
+	}
+	tabs := nonEmptyTabs(l.Tabs)
+	if len(tabs) == 0 {
+		return errors.New("no layout panes to restore")
+	}
+	panes := make([][]*PageStack, 0, len(tabs))
+	for _, lt := range tabs {
+		pp := make([]*PageStack, 0, len(lt.Panes))
+		for range lt.Panes {
+			p, err := w.newPane()
+			if err != nil {
+				for _, cc := range append(panes, pp) {
+					closePanes(cc)
+				}
+				return err
+			}
+			pp = append(pp, p)
+		}
+		panes = append(panes, pp)
+	}
+
+	old := w.tabs
+	w.tabs, w.active = nil, 0
+	var errs error
+	for i, lt := range tabs {
+		for j, p := range panes[i] {
+			if j == 0 {
+				w.addTab(p)
+				w.activateTab(len(w.tabs) - 1)
+			} else {
+				t := w.tabs[len(w.tabs)-1]
+				t.setSplit(lt.Split)
+				t.add(p)
+				w.activate(p)
+			}
+			if err := w.run(paneLine(lt.Panes[j])); err != nil {
+				errs = errors.Join(errs, err)
+			}
+		}
+		if t := w.tabs[len(w.tabs)-1]; lt.ActivePane >= 0 && lt.ActivePane < len(t.panes) {
+			t.active = lt.ActivePane
+		}
+	}
+	w.activateTab(l.ActiveTab)
+
+	for _, t := range old {
+		w.pages.RemovePage(t.id)
+		closePanes(t.panes)
+	}
+
+	return errs
+}
+
+func (w *Workspace) newPane() (*PageStack, error) {
+	p := NewPageStack()
+	if err := p.Init(w.ctx); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (w *Workspace) addTab(p *PageStack) {
+	w.nextID++
+	t := newTab(fmt.Sprintf("tab-%d", w.nextID), p)
+	w.tabs = append(w.tabs, t)
+	w.pages.AddPage(t.id, t, true, false)
+}
+
+func (w *Workspace) closeTab() error {
+	if len(w.tabs) == 1 {
+		return errors.New("unable to close the last pane")
+	}
+	t := w.tabs[w.active]
+	w.tabs = append(w.tabs[:w.active], w.tabs[w.active+1:]...)
+	if w.active >= len(w.tabs) {
+		w.active = len(w.tabs) - 1
+	}
+	w.activateTab(w.active)
+	w.pages.RemovePage(t.id)
+	closePanes(t.panes)
+
+	return nil
+}
+
+func (w *Workspace) activateTab(i int) {
+	if i < 0 || i >= len(w.tabs) {
+		i = 0
+	}
+	w.active = i
+	t := w.tabs[i]
+	w.pages.SwitchToPage(t.id)
+	w.activate(t.panes[t.active])
+}
+
+// activate makes the given pane the application content.
+func (w *Workspace) activate(p *PageStack) {
+	a := w.app
+	if a.Content != p {
+		a.Content.Stack.RemoveListener(a.Crumbs())
+		a.Content.Stack.RemoveListener(a.Menu())
+		a.Content = p
+		a.Crumbs().Reset(p.Peek())
+		p.Stack.AddListener(a.Crumbs())
+		p.Stack.AddListener(a.Menu())
+	}
+	if p.cmd != "" {
+		a.Config.SetActiveView(p.cmd)
+	}
+	if top := p.Top(); top != nil {
+		a.SetFocus(top)
+	}
+	w.refreshTabs()
+}
+
+// run execs a pane command. Panes keep their own namespace.
+func (w *Workspace) run(line string) error {
+	return w.app.command.runIn(cmd.NewInterpreter(line), "", true, true)
+}
+
+// paneLine defaults a new pane command to the active view.
+func (w *Workspace) paneLine(line string) string {
+	if line == "" {
+		return w.app.Config.ActiveView()
+	}
+
+	return line
+}
+
+func (w *Workspace) refreshTabs() {
+	if len(w.tabs) < 2 {
+		w.ResizeItem(w.tabBar, 0, 0)
+		return
+	}
+	w.ResizeItem(w.tabBar, 1, 0)
+
+	styles := w.app.Styles
+	w.tabBar.SetBackgroundColor(styles.BgColor())
+	w.tabBar.Clear()
+	for i, t := range w.tabs {
+		bgColor := styles.Frame().Crumb.BgColor
+		if i == w.active {
+			bgColor = styles.Frame().Crumb.ActiveColor
+		}
+		fmt.Fprintf(w.tabBar, "[%s:%s:b] %d:%s [-:%s:-] ",
+			styles.Frame().Crumb.FgColor,
+			bgColor, i+1, t.title(),
+			styles.Body().BgColor)
+	}
+}
+
+func paneLine(lp config.LayoutPane) string {
+	if lp.Namespace == "" || cmd.NewInterpreter(lp.Command).HasNS() {
+		return lp.Command
+	}
+
+	return lp.Command + " " + lp.Namespace
+}
+
+func nonEmptyTabs(tt []config.LayoutTab) []config.LayoutTab {
+	ee := make([]config.LayoutTab, 0, len(tt))
+	for _, t := range tt {
+		if len(t.Panes) > 0 {
+			ee = append(ee, t)
+		}
+	}
+
+	return ee
+}
+
+func closePanes(pp []*PageStack) {
+	for _, p := range pp {
+		p.close()
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Tab...
+
+func newTab(id string, p *PageStack) *Tab {
+	t := Tab{
+		Flex: tview.NewFlex(),
+		id:   id,
+	}
+	t.add(p)
+
+	return &t
+}
+
+// Focus delegates focus to the active pane.
+func (t *Tab) Focus(delegate func(p tview.Primitive)) {
+	delegate(t.panes[t.active])
+}
+
+func (t *Tab) title() string {
+	p := t.panes[t.active]
+	if p.cmd != "" {
+		return strings.Fields(p.cmd)[0]
+	}
+	if top := p.Top(); top != nil {
+		return top.Name()
+	}
+
+	return "n/a"
+}
+
+func (t *Tab) setSplit(split string) {
+	t.split = split
+	if split == config.SplitVertical {
+		t.SetDirection(tview.FlexColumn)
+	} else {
+		t.SetDirection(tview.FlexRow)
+	}
+}
+
+func (t *Tab) add(p *PageStack) {
+	t.panes = append(t.panes, p)
+	t.active = len(t.panes) - 1
+	t.AddItem(p, 0, 1, false)
+}
+
+func (t *Tab) remove(i int) *PageStack {
+	p := t.panes[i]
+	t.RemoveItem(p)
+	t.panes = append(t.panes[:i], t.panes[i+1:]...)
+	if t.active >= len(t.panes) {
+		t.active = len(t.panes) - 1
+	}
+
+	return p
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaneLine(t *testing.T) {
+	uu := map[string]struct {
+		p config.LayoutPane
+		e string
+	}{
+		"plain": {
+			p: config.LayoutPane{Command: "pods"},
+			e: "pods",
+		},
+		"ns": {
+			p: config.LayoutPane{Command: "pods", Namespace: "ns1"},
+			e: "pods ns1",
+		},
+		"cmd-ns": {
+			p: config.LayoutPane{Command: "pods ns2", Namespace: "ns1"},
+			e: "pods ns2",
+		},
+		"filter": {
+			p: config.LayoutPane{Command: "pods /fred", Namespace: "ns1"},
+			e: "pods /fred ns1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, paneLine(u.p))
+		})
+	}
+}
+
+func TestTabPanes(t *testing.T) {
+	p1, p2, p3 := NewPageStack(), NewPageStack(), NewPageStack()
+	p1.cmd = "pods ns1"
+
+	tab := newTab("t1", p1)
+	assert.Equal(t, "pods", tab.title())
+
+	tab.setSplit(config.SplitVertical)
+	tab.add(p2)
+	tab.add(p3)
+	assert.Equal(t, config.SplitVertical, tab.split)
+	assert.Equal(t, 2, tab.active)
+	assert.Equal(t, p3, tab.ItemAt(2))
+
+	assert.Equal(t, p3, tab.remove(2))
+	assert.Equal(t, 1, tab.active)
+	assert.Equal(t, p1, tab.remove(0))
+	assert.Equal(t, 0, tab.active)
+	assert.Equal(t, []*PageStack{p2}, tab.panes)
+}
+
+func TestWorkspaceRestoreFailed(t *testing.T) {
+	p := NewPageStack()
+	w := Workspace{ctx: context.Background(), pages: tview.NewPages()}
+	w.addTab(p)
+
+	l := config.NewLayout()
+	l.Tabs = []config.LayoutTab{{Panes: []config.LayoutPane{{Command: "pods"}}}}
+	assert.Error(t, w.Restore(l))
+	assert.Len(t, w.tabs, 1)
+	assert.Equal(t, p, w.tabs[0].panes[0])
+	assert.True(t, w.pages.HasPage(w.tabs[0].id))
+}
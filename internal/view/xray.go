@@ -116,11 +116,17 @@ func (x *Xray) ExtraHints() map[string]string {
 // SetInstance sets specific resource instance.
 func (x *Xray) SetInstance(string) {}
 
+// SetFieldSelector sets a resource field selector.
+func (x *Xray) SetFieldSelector(string) {}
+
+// SetNamespace sets a view local namespace.
+func (*Xray) SetNamespace(string) {}
+
 func (x *Xray) bindKeys() {
 	x.Actions().Bulk(ui.KeyMap{
-		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", x.activateCmd, false),
-		tcell.KeyEscape: ui.NewSharedKeyAction("Filter Reset", x.resetCmd, false),
-		tcell.KeyEnter:  ui.NewKeyAction("Goto", x.gotoCmd, true),
+		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", x.activateCmd, false).WithID("filterMode"),
+		tcell.KeyEscape: ui.NewSharedKeyAction("Filter Reset", x.resetCmd, false).WithID("filterReset"),
+		tcell.KeyEnter:  ui.NewKeyAction("Goto", x.gotoCmd, true).WithID("goto"),
 	})
 }
 
@@ -162,15 +168,15 @@ func (x *Xray) refreshActions() {
 	}
 
 	if client.Can(x.meta.Verbs, "edit") {
-		aa.Add(ui.KeyE, ui.NewKeyAction("Edit", x.editCmd, true))
+		aa.Add(ui.KeyE, ui.NewKeyAction("Edit", x.editCmd, true).WithID("edit"))
 	}
 	if client.Can(x.meta.Verbs, "delete") {
-		aa.Add(tcell.KeyCtrlD, ui.NewKeyAction("Delete", x.deleteCmd, true))
+		aa.Add(tcell.KeyCtrlD, ui.NewKeyAction("Delete", x.deleteCmd, true).WithID("delete"))
 	}
 	if !dao.IsK9sMeta(x.meta) {
 		aa.Bulk(ui.KeyMap{
-			ui.KeyY: ui.NewKeyAction(yamlAction, x.viewCmd, true),
-			ui.KeyD: ui.NewKeyAction("Describe", x.describeCmd, true),
+			ui.KeyY: ui.NewKeyAction(yamlAction, x.viewCmd, true).WithID("yaml"),
+			ui.KeyD: ui.NewKeyAction("Describe", x.describeCmd, true).WithID("describe"),
 		})
 	}
 
@@ -180,16 +186,16 @@ func (x *Xray) refreshActions() {
 	case "containers":
 		x.Actions().Delete(tcell.KeyEnter)
 		aa.Bulk(ui.KeyMap{
-			ui.KeyS: ui.NewKeyAction("Shell", x.shellCmd, true),
-			ui.KeyL: ui.NewKeyAction("Logs", x.logsCmd(false), true),
-			ui.KeyP: ui.NewKeyAction("Logs Previous", x.logsCmd(true), true),
+			ui.KeyS: ui.NewKeyAction("Shell", x.shellCmd, true).WithID("shell"),
+			ui.KeyL: ui.NewKeyAction("Logs", x.logsCmd(false), true).WithID("logs"),
+			ui.KeyP: ui.NewKeyAction("Logs Previous", x.logsCmd(true), true).WithID("logsPrevious"),
 		})
 	case "v1/pods":
 		aa.Bulk(ui.KeyMap{
-			ui.KeyS: ui.NewKeyAction("Shell", x.shellCmd, true),
-			ui.KeyA: ui.NewKeyAction("Attach", x.attachCmd, true),
-			ui.KeyL: ui.NewKeyAction("Logs", x.logsCmd(false), true),
-			ui.KeyP: ui.NewKeyAction("Logs Previous", x.logsCmd(true), true),
+			ui.KeyS: ui.NewKeyAction("Shell", x.shellCmd, true).WithID("shell"),
+			ui.KeyA: ui.NewKeyAction("Attach", x.attachCmd, true).WithID("attach"),
+			ui.KeyL: ui.NewKeyAction("Logs", x.logsCmd(false), true).WithID("logs"),
+			ui.KeyP: ui.NewKeyAction("Logs Previous", x.logsCmd(true), true).WithID("logsPrevious"),
 		})
 	}
 	x.Actions().Merge(aa)
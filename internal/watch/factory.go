@@ -5,6 +5,7 @@ package watch
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -22,12 +23,27 @@ import (
 const (
 	defaultResync   = 10 * time.Minute
 	defaultWaitTime = 250 * time.Millisecond
+
+	// cacheSizeSamples tracks the number of objects sampled to estimate a cache size.
+	cacheSizeSamples = 20
 )
 
+// InformerInfo represents an informer state.
+type InformerInfo struct {
+	GVR             string
+	Namespace       string
+	Synced          bool
+	Items           int
+	CacheBytes      int64
+	ResourceVersion string
+}
+
 // Factory tracks various resource informers.
 type Factory struct {
 	factories  map[string]di.DynamicSharedInformerFactory
+	informers  map[string]map[string]informers.GenericInformer
 	client     client.Connection
+	large      *largeResources
 	stopChan   chan struct{}
 	forwarders Forwarders
 	mx         sync.RWMutex
@@ -38,6 +54,8 @@ func NewFactory(client client.Connection) *Factory {
 	return &Factory{
 		client:     client,
 		factories:  make(map[string]di.DynamicSharedInformerFactory),
+		informers:  make(map[string]map[string]informers.GenericInformer),
+		large:      newLargeResources(),
 		forwarders: NewForwarders(),
 	}
 }
@@ -67,11 +85,18 @@ func (f *Factory) Terminate() {
 	for k := range f.factories {
 		delete(f.factories, k)
 	}
+	for k := range f.informers {
+		delete(f.informers, k)
+	}
+	f.large.reset()
 	f.forwarders.DeleteAll()
 }
 
 // List returns a resource collection.
 func (f *Factory) List(gvr, ns string, wait bool, labels labels.Selector) ([]runtime.Object, error) {
+	if f.IsLarge(gvr, ns) {
+		return f.listChunks(gvr, ns, labels)
+	}
 	inf, err := f.CanForResource(ns, gvr, client.ListAccess)
 	if err != nil {
 		return nil, err
@@ -110,6 +135,9 @@ func (f *Factory) HasSynced(gvr, ns string) (bool, error) {
 // Get retrieves a given resource.
 func (f *Factory) Get(gvr, fqn string, wait bool, sel labels.Selector) (runtime.Object, error) {
 	ns, n := namespaced(fqn)
+	if f.IsLarge(gvr, ns) {
+		return f.getDirect(gvr, ns, n)
+	}
 	inf, err := f.CanForResource(ns, gvr, []string{client.GetVerb})
 	if err != nil {
 		return nil, err
@@ -177,6 +205,14 @@ func (f *Factory) SetActiveNS(ns string) error {
 	if f.isClusterWide() {
 		return nil
 	}
+	if client.IsMultiNamespace(ns) {
+		for _, n := range client.Namespaces(ns) {
+			if _, err := f.ensureFactory(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	_, err := f.ensureFactory(ns)
 	return err
 }
@@ -214,13 +250,71 @@ func (f *Factory) ForResource(ns, gvr string) (informers.GenericInformer, error)
 		return inf, nil
 	}
 
-	f.mx.RLock()
-	defer f.mx.RUnlock()
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if client.IsClusterWide(ns) {
+		ns = client.BlankNamespace
+	}
+	if _, ok := f.informers[ns]; !ok {
+		f.informers[ns] = make(map[string]informers.GenericInformer)
+	}
+	f.informers[ns][gvr] = inf
 	fact.Start(f.stopChan)
 
 	return inf, nil
 }
 
+// Informers returns the state of all active informers.
+// Cache sizes are estimated from a sample of the cached objects.
+func (f *Factory) Informers() []InformerInfo {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	ii := make([]InformerInfo, 0, len(f.informers))
+	for ns, infs := range f.informers {
+		for gvr, inf := range infs {
+			oo := inf.Informer().GetStore().List()
+			ii = append(ii, InformerInfo{
+				GVR:             gvr,
+				Namespace:       ns,
+				Synced:          inf.Informer().HasSynced(),
+				Items:           len(oo),
+				CacheBytes:      estimateSize(oo),
+				ResourceVersion: inf.Informer().LastSyncResourceVersion(),
+			})
+		}
+	}
+	sort.Slice(ii, func(i, j int) bool {
+		if ii[i].GVR == ii[j].GVR {
+			return ii[i].Namespace < ii[j].Namespace
+		}
+		return ii[i].GVR < ii[j].GVR
+	})
+
+	return ii
+}
+
+func estimateSize(oo []interface{}) int64 {
+	n := min(len(oo), cacheSizeSamples)
+	if n == 0 {
+		return 0
+	}
+	var size int64
+	for _, o := range oo[:n] {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		bb, err := u.MarshalJSON()
+		if err != nil {
+			continue
+		}
+		size += int64(len(bb))
+	}
+
+	return size * int64(len(oo)) / int64(n)
+}
+
 func (f *Factory) ensureFactory(ns string) (di.DynamicSharedInformerFactory, error) {
 	if client.IsClusterWide(ns) {
 		ns = client.BlankNamespace
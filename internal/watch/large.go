@@ -0,0 +1,251 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package watch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/metadata"
+)
+
+// largeResources tracks resources too large to be cached by informers.
+type largeResources struct {
+	cfg   config.LargeResources
+	sizes map[string]bool
+	mx    sync.RWMutex
+}
+
+func newLargeResources() *largeResources {
+	return &largeResources{
+		sizes: make(map[string]bool),
+	}
+}
+
+func (l *largeResources) config() config.LargeResources {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	return l.cfg
+}
+
+func (l *largeResources) set(cfg config.LargeResources) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.cfg, l.sizes = cfg, make(map[string]bool)
+}
+
+func (l *largeResources) reset() {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.sizes = make(map[string]bool)
+}
+
+func (l *largeResources) get(key string) (bool, bool) {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	large, ok := l.sizes[key]
+
+	return large, ok
+}
+
+func (l *largeResources) put(key string, large bool) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.sizes[key] = large
+}
+
+// SetLargeResources configures the adaptive listing of very large resources.
+func (f *Factory) SetLargeResources(cfg config.LargeResources) {
+	f.large.set(cfg)
+}
+
+// PageSize returns the chunked listing page size.
+func (f *Factory) PageSize() int {
+	return f.large.config().PageSize
+}
+
+// Resync returns how often large resources are listed.
+func (f *Factory) Resync() time.Duration {
+	return f.large.config().Resync()
+}
+
+// IsLarge checks if a resource was sized as too large to be cached by informers.
+// Resources not yet sized are deemed small.
+func (f *Factory) IsLarge(gvr, ns string) bool {
+	large, _ := f.sizeOf(gvr, ns)
+
+	return large
+}
+
+// IsSized checks if a resource size is known.
+func (f *Factory) IsSized(gvr, ns string) bool {
+	_, ok := f.sizeOf(gvr, ns)
+
+	return ok
+}
+
+// SizeResource probes a resource size using metadata only requests. Sizes are
+// probed once per session. Failed probes are not recorded and retried on the next call.
+func (f *Factory) SizeResource(gvr, ns string) (bool, error) {
+	if large, ok := f.sizeOf(gvr, ns); ok {
+		return large, nil
+	}
+	cfg := f.large.config()
+	var large bool
+	for _, n := range largeNamespaces(ns) {
+		key := largeKey(gvr, n)
+		if l, ok := f.large.get(key); ok {
+			large = large || l
+			continue
+		}
+		c, err := f.countResource(gvr, n, cfg.MaxItems)
+		if err != nil {
+			return false, err
+		}
+		l := c > cfg.MaxItems
+		if l {
+			log.Info().Msgf("Resource %q in namespace %q holds %d+ items. Using chunked listing", gvr, n, c)
+		}
+		f.large.put(key, l)
+		large = large || l
+	}
+
+	return large, nil
+}
+
+// sizeOf returns a resource cached size verdict and whether it is known.
+func (f *Factory) sizeOf(gvr, ns string) (bool, bool) {
+	cfg := f.large.config()
+	if !cfg.Enable {
+		return false, true
+	}
+	if cfg.IsForced(gvr) {
+		return true, true
+	}
+	known := true
+	for _, n := range largeNamespaces(ns) {
+		large, ok := f.large.get(largeKey(gvr, n))
+		if large {
+			return true, true
+		}
+		known = known && ok
+	}
+
+	return false, known
+}
+
+func largeNamespaces(ns string) []string {
+	if client.IsMultiNamespace(ns) {
+		return client.Namespaces(ns)
+	}
+	if client.IsClusterWide(ns) {
+		return []string{client.BlankNamespace}
+	}
+
+	return []string{ns}
+}
+
+func largeKey(gvr, ns string) string {
+	return gvr + "|" + ns
+}
+
+// countResource counts a resource items up to maxItems+1 using metadata only lists.
+func (f *Factory) countResource(gvr, ns string, maxItems int) (int, error) {
+	cfg, err := f.client.RestConfig()
+	if err != nil {
+		return 0, err
+	}
+	mc, err := metadata.NewForConfig(cfg)
+	if err != nil {
+		return 0, err
+	}
+	var lister metadata.ResourceInterface = mc.Resource(toGVR(gvr))
+	if ns != client.BlankNamespace {
+		lister = mc.Resource(toGVR(gvr)).Namespace(ns)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), f.client.Config().CallTimeout())
+	defer cancel()
+	ll, err := lister.List(ctx, metav1.ListOptions{Limit: 1})
+	if err != nil {
+		return 0, err
+	}
+	if ll.RemainingItemCount != nil {
+		return len(ll.Items) + int(*ll.RemainingItemCount), nil
+	}
+	if ll.Continue == "" {
+		return len(ll.Items), nil
+	}
+	// Remaining items count is not always available, so fallback to paging up to maxItems.
+	if ll, err = lister.List(ctx, metav1.ListOptions{Limit: int64(maxItems)}); err != nil {
+		return 0, err
+	}
+	n := len(ll.Items)
+	if ll.Continue != "" {
+		n++
+	}
+
+	return n, nil
+}
+
+// listChunks lists a resource directly from the api server in pages.
+func (f *Factory) listChunks(gvr, ns string, sel labels.Selector) ([]runtime.Object, error) {
+	dial, err := f.client.DynDial()
+	if err != nil {
+		return nil, err
+	}
+	var res dynamic.ResourceInterface = dial.Resource(toGVR(gvr))
+	if !client.IsClusterWide(ns) {
+		res = dial.Resource(toGVR(gvr)).Namespace(ns)
+	}
+	opts := metav1.ListOptions{Limit: int64(f.PageSize())}
+	if sel != nil {
+		opts.LabelSelector = sel.String()
+	}
+
+	var oo []runtime.Object
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), f.client.Config().CallTimeout())
+		ll, err := res.List(ctx, opts)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		for i := range ll.Items {
+			oo = append(oo, &ll.Items[i])
+		}
+		if opts.Continue = ll.GetContinue(); opts.Continue == "" {
+			return oo, nil
+		}
+	}
+}
+
+// getDirect retrieves a resource directly from the api server.
+func (f *Factory) getDirect(gvr, ns, n string) (runtime.Object, error) {
+	dial, err := f.client.DynDial()
+	if err != nil {
+		return nil, err
+	}
+	var res dynamic.ResourceInterface = dial.Resource(toGVR(gvr))
+	if !client.IsClusterScoped(ns) && ns != client.BlankNamespace {
+		res = dial.Resource(toGVR(gvr)).Namespace(ns)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), f.client.Config().CallTimeout())
+	defer cancel()
+
+	return res.Get(ctx, n, metav1.GetOptions{})
+}
@@ -0,0 +1,268 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package watch_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/dynamic"
+	restclient "k8s.io/client-go/rest"
+)
+
+func TestFactorySizeResource(t *testing.T) {
+	uu := map[string]struct {
+		cfg       config.LargeResources
+		items     int
+		remaining bool
+		ns        string
+		large     bool
+		probes    int
+	}{
+		"disabled": {
+			cfg:   config.LargeResources{MaxItems: 2},
+			items: 10,
+			ns:    "ns1",
+		},
+		"forced": {
+			cfg:   config.LargeResources{Enable: true, MaxItems: 2, GVRs: []string{"v1/pods"}},
+			ns:    "ns1",
+			large: true,
+		},
+		"small": {
+			cfg:       config.LargeResources{Enable: true, MaxItems: 5},
+			items:     5,
+			remaining: true,
+			ns:        "ns1",
+			probes:    1,
+		},
+		"large": {
+			cfg:       config.LargeResources{Enable: true, MaxItems: 5},
+			items:     6,
+			remaining: true,
+			ns:        "ns1",
+			large:     true,
+			probes:    1,
+		},
+		"noRemainingCount": {
+			cfg:    config.LargeResources{Enable: true, MaxItems: 5},
+			items:  6,
+			ns:     "ns1",
+			large:  true,
+			probes: 2,
+		},
+		"allNamespaces": {
+			cfg:       config.LargeResources{Enable: true, MaxItems: 5},
+			items:     6,
+			remaining: true,
+			ns:        client.NamespaceAll,
+			large:     true,
+			probes:    1,
+		},
+		"multiNamespaces": {
+			cfg:       config.LargeResources{Enable: true, MaxItems: 5},
+			items:     3,
+			remaining: true,
+			ns:        "ns1,ns2",
+			probes:    2,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			srv := newListServer(t, u.items, u.remaining)
+			f := watch.NewFactory(newRestConn(srv.URL))
+			f.SetLargeResources(u.cfg)
+
+			large, err := f.SizeResource("v1/pods", u.ns)
+			require.NoError(t, err)
+			assert.Equal(t, u.large, large)
+			assert.True(t, f.IsSized("v1/pods", u.ns))
+			assert.Equal(t, u.large, f.IsLarge("v1/pods", u.ns))
+			assert.Equal(t, u.probes, srv.count())
+
+			// Sizes are probed once.
+			_, err = f.SizeResource("v1/pods", u.ns)
+			require.NoError(t, err)
+			assert.Equal(t, u.probes, srv.count())
+		})
+	}
+}
+
+func TestFactorySizeResourceFailed(t *testing.T) {
+	srv := newListServer(t, 10, true)
+	srv.setFailed(true)
+	f := watch.NewFactory(newRestConn(srv.URL))
+	f.SetLargeResources(config.LargeResources{Enable: true, MaxItems: 5})
+
+	assert.False(t, f.IsSized("v1/pods", "ns1"))
+	_, err := f.SizeResource("v1/pods", "ns1")
+	assert.Error(t, err)
+	assert.False(t, f.IsSized("v1/pods", "ns1"))
+	assert.False(t, f.IsLarge("v1/pods", "ns1"))
+
+	srv.setFailed(false)
+	large, err := f.SizeResource("v1/pods", "ns1")
+	require.NoError(t, err)
+	assert.True(t, large)
+	assert.True(t, f.IsLarge("v1/pods", "ns1"))
+	assert.Equal(t, 2, srv.count())
+
+	f.Terminate()
+	assert.False(t, f.IsSized("v1/pods", "ns1"))
+}
+
+func TestFactoryListLarge(t *testing.T) {
+	srv := newListServer(t, 5, true)
+	f := watch.NewFactory(newRestConn(srv.URL))
+	f.SetLargeResources(config.LargeResources{Enable: true, MaxItems: 2, PageSize: 2, GVRs: []string{"v1/pods"}})
+
+	oo, err := f.List("v1/pods", "ns1", false, labels.Everything())
+	require.NoError(t, err)
+	assert.Len(t, oo, 5)
+	assert.Equal(t, 3, srv.count())
+
+	o, err := f.Get("v1/pods", "ns1/p1", false, labels.Everything())
+	require.NoError(t, err)
+	assert.NotNil(t, o)
+	assert.Equal(t, 4, srv.count())
+}
+
+func TestFactoryResync(t *testing.T) {
+	f := watch.NewFactory(newRestConn(""))
+	f.SetLargeResources(config.LargeResources{PageSize: 10, ResyncInterval: 60})
+
+	assert.Equal(t, 10, f.PageSize())
+	assert.Equal(t, time.Minute, f.Resync())
+}
+
+// Helpers...
+
+type listServer struct {
+	*httptest.Server
+
+	items     int
+	remaining bool
+	failed    bool
+	calls     int
+	mx        sync.Mutex
+}
+
+// newListServer serves pods metadata and objects lists honoring limits and continue tokens.
+func newListServer(t *testing.T, items int, remaining bool) *listServer {
+	s := listServer{items: items, remaining: remaining}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+
+	return &s
+}
+
+func (s *listServer) serve(w http.ResponseWriter, r *http.Request) {
+	s.mx.Lock()
+	s.calls++
+	failed, items, remaining := s.failed, s.items, s.remaining
+	s.mx.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if failed {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","code":500}`))
+		return
+	}
+	if tt := strings.Split(strings.Trim(r.URL.Path, "/"), "/"); len(tt) == 6 {
+		_ = json.NewEncoder(w).Encode(pod(tt[5]))
+		return
+	}
+
+	start, _ := strconv.Atoi(r.URL.Query().Get("continue"))
+	end := items
+	if l, _ := strconv.Atoi(r.URL.Query().Get("limit")); l > 0 {
+		end = min(start+l, items)
+	}
+	oo := make([]map[string]interface{}, 0, end-start)
+	for i := start; i < end; i++ {
+		oo = append(oo, pod(fmt.Sprintf("p%d", i)))
+	}
+	meta := map[string]interface{}{}
+	if end < items {
+		meta["continue"] = strconv.Itoa(end)
+		if remaining {
+			meta["remainingItemCount"] = items - end
+		}
+	}
+	kind, apiVersion := "PodList", "v1"
+	if strings.Contains(r.Header.Get("Accept"), "as=PartialObjectMetadataList") {
+		kind, apiVersion = "PartialObjectMetadataList", "meta.k8s.io/v1"
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"kind":       kind,
+		"apiVersion": apiVersion,
+		"metadata":   meta,
+		"items":      oo,
+	})
+}
+
+func (s *listServer) setFailed(b bool) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.failed = b
+}
+
+func (s *listServer) count() int {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return s.calls
+}
+
+func pod(n string) map[string]interface{} {
+	return map[string]interface{}{
+		"kind":       "Pod",
+		"apiVersion": "v1",
+		"metadata":   map[string]interface{}{"name": n, "namespace": "ns1"},
+	}
+}
+
+type restConn struct {
+	client.Connection
+
+	url string
+}
+
+func newRestConn(url string) *restConn {
+	return &restConn{url: url}
+}
+
+func (c *restConn) Config() *client.Config {
+	return client.NewConfig(&genericclioptions.ConfigFlags{})
+}
+
+func (c *restConn) RestConfig() (*restclient.Config, error) {
+	return &restclient.Config{Host: c.url}, nil
+}
+
+func (c *restConn) DynDial() (dynamic.Interface, error) {
+	cfg, err := c.RestConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return dynamic.NewForConfig(cfg)
+}